@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/client"
+	"github.com/arphillips06/TI4-stats/config"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	"github.com/gin-gonic/gin"
+)
+
+// TestAPIThroughClient plays the start of a game against a real server
+// through the generated client.
+func TestAPIThroughClient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	dbtest.Run(t, func(t *testing.T) {
+		cfg := allFeatures()
+		config.Use(cfg)
+		srv := httptest.NewServer(newRouter(cfg))
+		defer srv.Close()
+		c := client.New(srv.URL)
+		ctx := context.Background()
+
+		decks := true
+		created, err := c.CreateGame(ctx, &client.CreateGameInput{
+			WinningPoints:     10,
+			UseObjectiveDecks: &decks,
+			Players: []client.PlayerInput{
+				{Name: "Alice", Faction: "Arborec", New: true},
+				{Name: "Bob", Faction: "Federation of Sol", New: true},
+				{Name: "Carol", Faction: "Emirates of Hacan", New: true},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateGame: %v", err)
+		}
+		if len(created.Revealed) == 0 {
+			t.Fatal("no objectives were revealed at setup")
+		}
+		game, err := c.GetGameByID(ctx, created.Game.ID)
+		if err != nil {
+			t.Fatalf("GetGameByID: %v", err)
+		}
+		if len(game.Players) != 3 {
+			t.Fatalf("game has %d players, want 3", len(game.Players))
+		}
+		alice := game.Players[0].PlayerID
+		objective := created.Revealed[0].ObjectiveID
+
+		// Build the stats snapshot so the score below has to invalidate it.
+		if _, err := c.GetStatsOverview(ctx); err != nil {
+			t.Fatalf("GetStatsOverview: %v", err)
+		}
+
+		score := &client.ScoreRequest{GameID: game.ID, PlayerID: alice, ObjectiveID: objective}
+		scored, err := c.AddScore(ctx, game.ID, score)
+		if err != nil {
+			t.Fatalf("AddScore: %v", err)
+		}
+		if scored.TotalPoints != 1 {
+			t.Errorf("Alice has %d points after scoring, want 1", scored.TotalPoints)
+		}
+		// Imperial allows a second public objective this round, but not the
+		// same one again.
+		score.Override = "imperial"
+		if _, err := c.AddScore(ctx, game.ID, score); client.StatusOf(err) != http.StatusForbidden {
+			t.Errorf("scoring the same objective twice: got %v, want a 403", err)
+		}
+
+		summary, err := c.GetScoreSummary(ctx, game.ID)
+		if err != nil {
+			t.Fatalf("GetScoreSummary: %v", err)
+		}
+		for _, s := range summary {
+			if want := map[bool]int{true: 1, false: 0}[s.PlayerID == alice]; s.Points != want {
+				t.Errorf("%s has %d points, want %d", s.PlayerName, s.Points, want)
+			}
+		}
+
+		round, err := c.AdvanceRound(ctx, game.ID)
+		if err != nil {
+			t.Fatalf("AdvanceRound: %v", err)
+		}
+		if round.CurrentRound != 2 {
+			t.Errorf("current round is %d after advancing, want 2", round.CurrentRound)
+		}
+
+		if _, err := c.GetGameByID(ctx, game.ID+1000); client.StatusOf(err) != http.StatusNotFound {
+			t.Errorf("getting a game that does not exist: got %v, want a 404", err)
+		}
+		overview, err := c.GetStatsOverview(ctx)
+		if err != nil {
+			t.Fatalf("GetStatsOverview: %v", err)
+		}
+		if overview.TotalGames != 1 || overview.ObjectiveStats["publicScored"] != 1 {
+			t.Errorf("overview counts %d games and %d public objectives scored, want the running game's 1 and 1",
+				overview.TotalGames, overview.ObjectiveStats["publicScored"])
+		}
+	})
+}
+
+// TestAdminRoutesOffByDefault checks that the unauthenticated /admin routes
+// are only mounted when features.admin is turned on.
+func TestAdminRoutesOffByDefault(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, on := range []bool{false, true} {
+		cfg := config.Default()
+		cfg.Features.Admin = on
+		rec := httptest.NewRecorder()
+		newRouter(cfg).ServeHTTP(rec, httptest.NewRequest("GET", "/api/v1/admin/backups", nil))
+		if got := rec.Code != http.StatusNotFound; got != on {
+			t.Errorf("admin %v: GET /api/v1/admin/backups = %d", on, rec.Code)
+		}
+	}
+}
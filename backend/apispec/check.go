@@ -0,0 +1,264 @@
+// Package apispec checks the generated OpenAPI document against the routes
+// the server actually registers, so the annotations on the handlers cannot
+// drift from the router.
+package apispec
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Problem is one difference between the spec and the router.
+type Problem struct {
+	Method string
+	Path   string
+	Msg    string
+}
+
+func (p Problem) String() string {
+	if p.Method == "" {
+		return fmt.Sprintf("%s: %s", p.Path, p.Msg)
+	}
+	return fmt.Sprintf("%s %s: %s", p.Method, p.Path, p.Msg)
+}
+
+// Spec is the part of a Swagger 2.0 document the checks read.
+type Spec struct {
+	Paths       map[string]map[string]Operation `json:"paths"`
+	Definitions map[string]Schema               `json:"definitions"`
+}
+
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Tags        []string            `json:"tags"`
+	Produces    []string            `json:"produces"`
+	Parameters  []Parameter         `json:"parameters"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Type        string  `json:"type"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref                  string            `json:"$ref"`
+	Type                 string            `json:"type"`
+	Format               string            `json:"format"`
+	Items                *Schema           `json:"items"`
+	Properties           map[string]Schema `json:"properties"`
+	AdditionalProperties json.RawMessage   `json:"additionalProperties"`
+	AllOf                []Schema          `json:"allOf"`
+	Enum                 []any             `json:"enum"`
+	Description          string            `json:"description"`
+	Nullable             bool              `json:"x-nullable"` // from pointer fields
+}
+
+// Values returns the schema of a map's values, or nil when the schema is
+// not a map or its values may be anything.
+func (s Schema) Values() *Schema {
+	if len(s.AdditionalProperties) == 0 {
+		return nil
+	}
+	var v Schema
+	if json.Unmarshal(s.AdditionalProperties, &v) != nil {
+		return nil
+	}
+	return &v
+}
+
+// RefName is the definition a $ref points at.
+func (s Schema) RefName() string {
+	return strings.TrimPrefix(s.Ref, "#/definitions/")
+}
+
+// Parse reads a Swagger 2.0 JSON document.
+func Parse(doc []byte) (*Spec, error) {
+	var s Spec
+	if err := json.Unmarshal(doc, &s); err != nil {
+		return nil, fmt.Errorf("parse spec: %w", err)
+	}
+	return &s, nil
+}
+
+// Ignored are routes that serve files rather than the JSON API.
+var Ignored = []string{"/", "/static/*filepath", "/swagger/*any"}
+
+var (
+	ginParam  = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+	specParam = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+)
+
+// SpecPath turns a gin route path into the form the spec uses.
+func SpecPath(p string) string {
+	return ginParam.ReplaceAllString(p, "{$1}")
+}
+
+// Check compares spec with routes. Every route must be documented with the
+// same path parameters and every documented operation must be routed. Each
+// operation needs an operationId and a success response whose schema is a
+// named type rather than a free-form object.
+func Check(spec *Spec, routes gin.RoutesInfo) []Problem {
+	var problems []Problem
+
+	routed := make(map[string]bool)
+	for _, r := range routes {
+		if r.Method == "HEAD" || slices.Contains(Ignored, r.Path) {
+			continue
+		}
+		path := SpecPath(r.Path)
+		method := strings.ToLower(r.Method)
+		routed[method+" "+path] = true
+
+		op, ok := spec.Paths[path][method]
+		if !ok {
+			problems = append(problems, Problem{r.Method, path, "route is not in the spec"})
+			continue
+		}
+		problems = append(problems, checkParams(r.Method, path, op)...)
+	}
+
+	ids := make(map[string]string)
+	for _, path := range sortedKeys(spec.Paths) {
+		for _, method := range sortedKeys(spec.Paths[path]) {
+			op := spec.Paths[path][method]
+			upper := strings.ToUpper(method)
+			if !routed[method+" "+path] {
+				problems = append(problems, Problem{upper, path, "documented but not routed"})
+				continue
+			}
+			if op.OperationID == "" {
+				problems = append(problems, Problem{upper, path, "no operationId (add @ID)"})
+			} else if prev, dup := ids[op.OperationID]; dup {
+				problems = append(problems, Problem{upper, path, fmt.Sprintf("operationId %s is also used by %s", op.OperationID, prev)})
+			} else {
+				ids[op.OperationID] = upper + " " + path
+			}
+			problems = append(problems, checkSuccess(spec, upper, path, op)...)
+		}
+	}
+	return problems
+}
+
+func checkParams(method, path string, op Operation) []Problem {
+	want := make(map[string]bool)
+	for _, m := range specParam.FindAllStringSubmatch(path, -1) {
+		want[m[1]] = true
+	}
+
+	var problems []Problem
+	have := make(map[string]bool)
+	for _, p := range op.Parameters {
+		if p.In != "path" {
+			continue
+		}
+		have[p.Name] = true
+		if !want[p.Name] {
+			problems = append(problems, Problem{method, path, fmt.Sprintf("path parameter %q is not in the route", p.Name)})
+		}
+	}
+	for _, name := range sortedKeys(want) {
+		if !have[name] {
+			problems = append(problems, Problem{method, path, fmt.Sprintf("path parameter %q is not documented", name)})
+		}
+	}
+	return problems
+}
+
+func checkSuccess(spec *Spec, method, path string, op Operation) []Problem {
+	var found bool
+	var problems []Problem
+	for _, code := range sortedKeys(op.Responses) {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		found = true
+		resp := op.Responses[code]
+		if resp.Schema == nil {
+			// File downloads and 204s have no JSON body.
+			continue
+		}
+		if why := loose(spec, *resp.Schema, map[string]bool{}); why != "" {
+			problems = append(problems, Problem{method, path, fmt.Sprintf("%s response %s", code, why)})
+		}
+	}
+	if !found {
+		problems = append(problems, Problem{method, path, "no success response"})
+	}
+	return problems
+}
+
+// loose explains why s does not describe its JSON shape, or returns "" when
+// it does. Maps are fine as long as their values are typed.
+func loose(spec *Spec, s Schema, seen map[string]bool) string {
+	switch {
+	case s.Ref != "":
+		name := s.RefName()
+		def, ok := spec.Definitions[name]
+		if !ok {
+			return fmt.Sprintf("refers to missing definition %s", name)
+		}
+		if seen[name] {
+			return ""
+		}
+		seen[name] = true
+		if why := loose(spec, def, seen); why != "" {
+			return fmt.Sprintf("%s: %s", name, why)
+		}
+		return ""
+	case len(s.AllOf) > 0:
+		for _, sub := range s.AllOf {
+			if why := loose(spec, sub, seen); why != "" {
+				return why
+			}
+		}
+		return ""
+	case s.Type == "array":
+		if s.Items == nil {
+			return "is an array of unknown items"
+		}
+		return loose(spec, *s.Items, seen)
+	case s.Type == "object" || (s.Type == "" && s.Properties != nil):
+		if v := s.Values(); v != nil {
+			return loose(spec, *v, seen)
+		}
+		if len(s.Properties) == 0 {
+			return "is a free-form object"
+		}
+		for _, name := range sortedKeys(s.Properties) {
+			if why := loose(spec, s.Properties[name], seen); why != "" {
+				return fmt.Sprintf("field %s %s", name, why)
+			}
+		}
+		return ""
+	case s.Type == "":
+		return "has no type"
+	}
+	return ""
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+// Package client is a Go client for the TI4 stats API. The types and one
+// method per operation are generated from docs/swagger.json into
+// client_gen.go; regenerate after changing the API:
+//
+//	go generate . ./client
+//
+// Operations that return a file return its bytes. Errors from the server
+// are returned as *Error.
+package client
+
+//go:generate go run ../cmd/clientgen -spec ../docs/swagger.json -out client_gen.go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type Client struct {
+	BaseURL string
+	HTTP    *http.Client
+	// ClientID is sent as X-Client-ID so the audit log shows where
+	// changes came from.
+	ClientID string
+}
+
+// New returns a client for the server at baseURL, such as
+// http://127.0.0.1:8080.
+func New(baseURL string) *Client {
+	return &Client{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		HTTP:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Error is an error response from the server. Fields lists the invalid
+// fields of a 422 response.
+type Error struct {
+	Status  int
+	Message string
+	Fields  []FieldError
+	Body    []byte
+}
+
+func (e *Error) Error() string {
+	msg := e.Message
+	if len(e.Fields) > 0 {
+		parts := make([]string, len(e.Fields))
+		for i, f := range e.Fields {
+			parts[i] = f.Field + " " + f.Message
+		}
+		msg += ": " + strings.Join(parts, "; ")
+	}
+	return fmt.Sprintf("%s (HTTP %d)", msg, e.Status)
+}
+
+// StatusOf returns the HTTP status of an *Error, or 0 for other errors.
+func StatusOf(err error) int {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Status
+	}
+	return 0
+}
+
+// Raw sends a request and returns the response body undecoded. It is used
+// by the generated methods and by callers that print the JSON as is.
+func (c *Client) Raw(ctx context.Context, method, path string, query url.Values, body any) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+	u := c.BaseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.ClientID != "" {
+		req.Header.Set("X-Client-ID", c.ClientID)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return raw, newError(resp.StatusCode, raw)
+	}
+	return raw, nil
+}
+
+func newError(status int, raw []byte) *Error {
+	var e struct {
+		Error   string       `json:"error"`
+		Message string       `json:"message"`
+		Fields  []FieldError `json:"fields"`
+	}
+	msg := strings.TrimSpace(string(raw))
+	if json.Unmarshal(raw, &e) == nil {
+		if e.Error != "" {
+			msg = e.Error
+		} else if e.Message != "" {
+			msg = e.Message
+		}
+	}
+	if msg == "" {
+		msg = http.StatusText(status)
+	}
+	return &Error{Status: status, Message: msg, Fields: e.Fields, Body: raw}
+}
+
+// do sends a request and decodes the response into out, if not nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	raw, err := c.Raw(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("decode %s %s: %w", method, path, err)
+	}
+	return nil
+}
@@ -0,0 +1,1791 @@
+// Code generated by clientgen from docs/swagger.json. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type Badge struct {
+	Holders []Holder `json:"holders"`
+	Key     string   `json:"key"`
+	Label   string   `json:"label"`
+	Status  string   `json:"status"`
+	Value   int      `json:"value"`
+}
+
+type BadgeList struct {
+	Count int     `json:"Count"`
+	Value []Badge `json:"value"`
+}
+
+type Holder struct {
+	GameID   int `json:"game_id"`
+	PlayerID int `json:"player_id"`
+	RoundID  int `json:"round_id"`
+}
+
+type RelicRequest struct {
+	GameID   int `json:"game_id"`
+	PlayerID int `json:"player_id"`
+}
+
+type ShardRequest struct {
+	GameID      int `json:"game_id"`
+	NewHolderID int `json:"new_holder_id"`
+}
+
+type ImportGamesRequest struct {
+	Games []ImportGame `json:"games"`
+}
+
+type AdvanceRoundResponse struct {
+	CurrentRound  int    `json:"current_round"`
+	Message       string `json:"message"`  // "round_advanced" or "Game Ended"
+	Revealed      string `json:"revealed"` // stage of the objective revealed
+	Round         int    `json:"round"`
+	TotalRevealed int    `json:"totalRevealed"`
+	WinnerID      *int   `json:"winner_id"`
+}
+
+type AgendaResolution struct {
+	ForVotes []int  `json:"for_votes"`
+	GameID   int    `json:"game_id"`
+	Result   string `json:"result"`   // empty records the agenda with no effect
+	RoundID  int    `json:"round_id"` // 0 for the current round
+}
+
+type AssignObjectiveRequest struct {
+	GameID      int `json:"game_id"`
+	ObjectiveID int `json:"objective_id"`
+	RoundID     int `json:"round_id"` // round number
+}
+
+type AssignPlayerInput struct {
+	Faction  string `json:"faction"`
+	GameID   int    `json:"game_id"`
+	PlayerID int    `json:"player_id"`
+}
+
+type AssignSpeakerRequest struct {
+	GameID    int  `json:"game_id"`
+	IsInitial bool `json:"is_initial"` // optional logic flag
+	PlayerID  int  `json:"player_id"`
+	RoundID   int  `json:"round_id"` // round number
+}
+
+type AuditLog struct {
+	Action      string           `json:"action"`
+	After       string           `json:"after"`
+	Before      string           `json:"before"`
+	ClientID    string           `json:"client_id"`
+	ClientIp    string           `json:"client_ip"`
+	CreatedAt   time.Time        `json:"created_at"`
+	Entity      string           `json:"entity"`
+	EntityID    int              `json:"entity_id"`
+	GameID      *int             `json:"game_id"`
+	ID          int              `json:"id"`
+	Method      string           `json:"method"`
+	Path        string           `json:"path"`
+	Payload     string           `json:"payload"`
+	Reason      string           `json:"reason"`
+	Route       string           `json:"route"`
+	RowsChanged map[string]int64 `json:"rows_changed"`
+	Status      int              `json:"status"`
+	UserAgent   string           `json:"user_agent"`
+}
+
+type Backup struct {
+	CreatedAt time.Time `json:"created_at"`
+	File      string    `json:"file"`
+	Removed   []string  `json:"removed"` // older backups deleted by rotation
+	Size      int       `json:"size"`
+}
+
+type CardEffect struct {
+	Description   string `json:"description"`
+	Homebrew      bool   `json:"homebrew"` // Homebrew cards are added through the API and are never overwritten by the seeded catalogue.
+	ID            int    `json:"id"`
+	Kind          string `json:"kind"`
+	Name          string `json:"name"`
+	VictoryPoints int    `json:"victory_points"` // VictoryPoints is the usual award; it is negative for cards that take points away and can be overridden when the card is recorded.
+}
+
+type CardEffectRequest struct {
+	Card     string `json:"card"`
+	PlayerID int    `json:"player_id"`
+	Points   *int   `json:"points"`   // defaults to the card's victory_points
+	RoundID  int    `json:"round_id"` // defaults to the current round
+}
+
+type CardEffectStats struct {
+	Card        string `json:"card"`
+	Games       int    `json:"games"`
+	NetPoints   int    `json:"net_points"`
+	TimesPlayed int    `json:"times_played"`
+}
+
+type ClassifiedDocumentLeaksRequest struct {
+	GameID      int `json:"game_id"`
+	ObjectiveID int `json:"objective_id"`
+	PlayerID    int `json:"player_id"`
+	RoundID     int `json:"round_id"` // 0 for the current round
+}
+
+type ClockStats struct {
+	Phases         []PhaseTimeStat   `json:"phases"`
+	RoundLengths   []RoundLengthStat `json:"round_lengths"`
+	SlowestPlayers []PlayerTurnStat  `json:"slowest_players"`
+}
+
+type CorrectGamePlayerRequest struct {
+	Faction string `json:"faction"`
+	Reason  string `json:"reason"`
+}
+
+type CorrectScoreRequest struct {
+	PlayerID *int   `json:"player_id"`
+	Points   *int   `json:"points"`
+	Reason   string `json:"reason"`
+	Round    *int   `json:"round"` // round number within the game
+}
+
+type CreateGameInput struct {
+	Location          string        `json:"location"`
+	Notes             string        `json:"notes"`
+	Players           []PlayerInput `json:"players"`
+	SpeakerID         *int          `json:"speaker_id"`
+	Title             string        `json:"title"`
+	TrackPhases       *bool         `json:"track_phases"` // start round 1 in the strategy phase
+	UseObjectiveDecks *bool         `json:"use_objective_decks"`
+	UseRandomSpeaker  *bool         `json:"use_random_speaker"`
+	WinningPoints     int           `json:"winning_points"` // 0 uses the configured default
+}
+
+type CreateGameResponse struct {
+	Game     Game            `json:"game"`
+	Revealed []GameObjective `json:"revealed"` // objectives revealed at setup
+}
+
+type DeleteGameResponse struct {
+	GameID int    `json:"game_id"`
+	Status string `json:"status"`
+}
+
+type ExistsResponse struct {
+	Exists bool `json:"exists"`
+}
+
+type Faction struct {
+	Aliases     []string `json:"aliases"` // Aliases are other spellings accepted when a faction is entered by hand or imported, such as "Hacan" or "L1".
+	Code        string   `json:"code"`
+	Commodities int      `json:"commodities"`
+	Expansion   string   `json:"expansion"`
+	HomeSystem  string   `json:"home_system"`
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+}
+
+type FactionAggregateStats struct {
+	Faction           string     `json:"faction"`
+	TotalPlays        int        `json:"totalPlays"`
+	TotalPointsScored int        `json:"totalPointsScored"`
+	VpHistogram       []VPBucket `json:"vpHistogram"`
+	WonCount          int        `json:"wonCount"`
+}
+
+type FactionPlayWinStat struct {
+	PlayRate    float64 `json:"playRate"`
+	PlayedCount int     `json:"playedCount"`
+	WinCount    int     `json:"winCount"`
+	WinRate     float64 `json:"winRate"`
+}
+
+type FactionPlayerStats struct {
+	Faction           string `json:"faction"`
+	PlayedCount       int    `json:"playedCount"`
+	Player            string `json:"player"`
+	TotalPointsScored int    `json:"totalPointsScored"`
+	WonCount          int    `json:"wonCount"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type Game struct {
+	Partial            bool                `json:"Partial"`
+	SpeakerAssignments []SpeakerAssignment `json:"SpeakerAssignments"`
+	StartingSpeakerID  *int                `json:"StartingSpeakerID"`
+	CreatedAt          time.Time           `json:"created_at"`
+	CurrentRound       int                 `json:"current_round"`
+	FinishedAt         *time.Time          `json:"finished_at"`
+	GameNumber         int                 `json:"game_number"`
+	GameObjectives     []GameObjective     `json:"game_objectives"`
+	HouseRules         []string            `json:"house_rules"` // HouseRules and TemplateID are set on games created from a template.
+	ID                 int                 `json:"id"`
+	Location           string              `json:"location"`
+	Notes              string              `json:"notes"`
+	Players            []GamePlayer        `json:"players"`
+	Rounds             []Round             `json:"rounds"`
+	Speaker            *Player             `json:"speaker"`
+	SpeakerID          *int                `json:"speaker_id"`
+	TemplateID         *int                `json:"template_id"`
+	Title              string              `json:"title"`
+	UseObjectiveDecks  bool                `json:"use_objective_decks"`
+	Winner             Player              `json:"winner"`
+	WinnerID           *int                `json:"winner_id"`
+	WinningPoints      int                 `json:"winning_points"`
+}
+
+type GameClock struct {
+	Players        []PlayerClock `json:"players"`
+	Round          int           `json:"round"`
+	RoundStartedAt *time.Time    `json:"round_started_at"`
+	Running        *Turn         `json:"running"`
+}
+
+type GameDetailResponse struct {
+	ScoresByObjective  map[string][]ScoreDTO `json:"ScoresByObjective"`
+	AllScores          []ScoreDTO            `json:"all_scores"`
+	CurrentRound       int                   `json:"current_round"`
+	CustodiansPlayerID *int                  `json:"custodiansPlayerId"`
+	FinishedAt         *time.Time            `json:"finished_at"`
+	GameNumber         int                   `json:"game_number"`
+	HouseRules         []string              `json:"house_rules"`
+	ID                 int                   `json:"id"`
+	Location           string                `json:"location"`
+	Notes              string                `json:"notes"`
+	Objectives         []GameObjective       `json:"objectives"`
+	Players            []GamePlayer          `json:"players"`
+	Relics             []RelicHoldingDTO     `json:"relics"`
+	Rounds             []Round               `json:"rounds"`
+	Scores             []PlayerScoreSummary  `json:"scores"`
+	SpeakerID          *int                  `json:"speaker_id"`
+	SpeakerName        string                `json:"speaker_name"`
+	Support            []SupportHoldingDTO   `json:"support"`
+	TemplateID         *int                  `json:"template_id"`
+	Title              string                `json:"title"`
+	UseObjectiveDecks  bool                  `json:"use_objective_decks"`
+	VictoryPath        *VictoryPathSummary   `json:"victory_path"`
+	Winner             *Player               `json:"winner"`
+	WinningPoints      int                   `json:"winning_points"`
+}
+
+type GameDurationStat struct {
+	Duration   string    `json:"duration"`
+	GameID     int       `json:"game_id"`
+	GameNumber int       `json:"game_number"`
+	RoundCount int       `json:"round_count"`
+	Seconds    int       `json:"seconds"`
+	StartedAt  time.Time `json:"started_at"`
+}
+
+type GameFromTemplateRequest struct {
+	Location string               `json:"location"`
+	Notes    string               `json:"notes"`
+	Players  []GameTemplatePlayer `json:"players"`
+	Title    string               `json:"title"`
+}
+
+type GameLengthCategoryStats struct {
+	AverageGameTime  string           `json:"average_game_time"`
+	AverageRoundTime string           `json:"average_round_time"`
+	LongestByRounds  GameDurationStat `json:"longest_by_rounds"`
+	LongestByTime    GameDurationStat `json:"longest_by_time"`
+	ShortestByRounds GameDurationStat `json:"shortest_by_rounds"`
+	ShortestByTime   GameDurationStat `json:"shortest_by_time"`
+}
+
+type GameLengthStats struct {
+	All         GameLengthCategoryStats `json:"all"`
+	FourPlayer  GameLengthCategoryStats `json:"four_player"`
+	ThreePlayer GameLengthCategoryStats `json:"three_player"`
+}
+
+type GameObjective struct {
+	GameID      int       `json:"GameID"`
+	ID          int       `json:"ID"`
+	IsCDL       bool      `json:"IsCDL"`
+	Objective   Objective `json:"Objective"`
+	ObjectiveID int       `json:"ObjectiveID"`
+	Position    int       `json:"Position"`
+	Revealed    bool      `json:"Revealed"`
+	Round       Round     `json:"Round"`
+	RoundID     int       `json:"RoundID"`
+	Stage       string    `json:"Stage"`
+}
+
+type GamePlayer struct {
+	Eliminated bool     `json:"Eliminated"`
+	Faction    string   `json:"Faction"` // canonical faction name, kept for grouping in stats
+	FactionID  *int     `json:"FactionID"`
+	GameID     int      `json:"GameID"`
+	ID         int      `json:"ID"`
+	Player     Player   `json:"Player"`
+	PlayerID   int      `json:"PlayerID"`
+	Won        bool     `json:"Won"`
+	FactionRef *Faction `json:"faction_ref"`
+}
+
+type GameSession struct {
+	CreatedAt time.Time     `json:"created_at"`
+	EndedAt   *time.Time    `json:"ended_at"`
+	GameID    int           `json:"game_id"`
+	ID        int           `json:"id"`
+	Location  string        `json:"location"`
+	Notes     string        `json:"notes"`
+	Rsvps     []SessionRSVP `json:"rsvps"`
+	StartedAt *time.Time    `json:"started_at"`
+	StartsAt  *time.Time    `json:"starts_at"` // when it is scheduled for
+}
+
+type GameSessions struct {
+	Active        string        `json:"active"`
+	ActiveSeconds int           `json:"active_seconds"`
+	Running       *GameSession  `json:"running"`
+	Sessions      []GameSession `json:"sessions"`
+}
+
+type GameTemplate struct {
+	CreatedAt         time.Time            `json:"created_at"`
+	Description       string               `json:"description"`
+	FactionPool       []string             `json:"faction_pool"` // FactionPool is what players without a faction draw from; empty means every faction in the catalogue.
+	HouseRules        []string             `json:"house_rules"`  // HouseRules are copied onto each game made from the template, e.g. "no_custodians" or "14_point_stage_ii".
+	ID                int                  `json:"id"`
+	Name              string               `json:"name"`
+	Players           []GameTemplatePlayer `json:"players"`
+	SpeakerMode       string               `json:"speaker_mode"` // defaults to random
+	TrackPhases       bool                 `json:"track_phases"`
+	UpdatedAt         time.Time            `json:"updated_at"`
+	UseObjectiveDecks bool                 `json:"use_objective_decks"`
+	WinningPoints     int                  `json:"winning_points"`
+}
+
+type GameTemplatePlayer struct {
+	Faction string `json:"faction"`
+	Name    string `json:"name"`
+}
+
+type ImportError struct {
+	Game    string `json:"game"`
+	Message string `json:"message"`
+	Player  string `json:"player"`
+	Row     int    `json:"row"`
+}
+
+type ImportGame struct {
+	Date          string         `json:"date"` // YYYY-MM-DD or RFC 3339
+	Game          string         `json:"game"` // groups CSV rows; optional in JSON
+	Location      string         `json:"location"`
+	Notes         string         `json:"notes"`
+	Players       []ImportPlayer `json:"players"`
+	Rounds        int            `json:"rounds"`
+	Title         string         `json:"title"`
+	WinningPoints int            `json:"winning_points"`
+}
+
+type ImportPlayer struct {
+	Faction     string `json:"faction"`
+	FinalPoints *int   `json:"final_points"`
+	Name        string `json:"name"`
+	RoundPoints []int  `json:"round_points"` // points scored in each round, optional
+	Won         bool   `json:"won"`
+}
+
+type ImportResult struct {
+	Errors   []ImportError  `json:"errors"`
+	Imported []ImportedGame `json:"imported"`
+}
+
+type ImportedGame struct {
+	Game       string `json:"game"`
+	GameID     int    `json:"game_id"`
+	GameNumber int    `json:"game_number"`
+	Partial    bool   `json:"partial"`
+	Row        int    `json:"row"`
+}
+
+type IncentiveProgramRequest struct {
+	GameID  int    `json:"game_id"`
+	Outcome string `json:"outcome"`
+}
+
+type MergePlayerRequest struct {
+	IntoPlayerID int `json:"into_player_id"`
+}
+
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+type Objective struct {
+	ID          int    `json:"ID"`
+	Description string `json:"description"`
+	Name        string `json:"name"`
+	Phase       string `json:"phase"`
+	Points      int    `json:"points"`
+	Stage       string `json:"stage"`
+	Type        string `json:"type"`
+}
+
+type ObjectiveDifficultyResponse struct {
+	Filters     map[string]string        `json:"filters"`      // Echoed filters used to compute the response. Example: {"stage":"I","minAppearances":"5","minOpportunities":"0"}
+	GeneratedAt time.Time                `json:"generated_at"` // Timestamp when these stats were generated.
+	Rows        []ObjectiveDifficultyRow `json:"rows"`         // Rows of per-objective difficulty metrics, sorted by difficulty desc then adj_rate asc.
+}
+
+type ObjectiveDifficultyRow struct {
+	AdjRate       float64 `json:"adj_rate"`      // Bayesian-adjusted scoring rate (alpha=2, beta=5).
+	Appearances   int     `json:"appearances"`   // Number of distinct games in which this objective appeared (was revealed).
+	AvgRound      float64 `json:"avg_round"`     // Average round number when this objective was first scored in a game.
+	Difficulty    float64 `json:"difficulty"`    // Difficulty as 1 - RawRate (higher is harder).
+	MedianRound   float64 `json:"median_round"`  // Median round number when this objective was first scored in a game.
+	Name          string  `json:"name"`          // Objective name as stored in the objectives table.
+	ObjectiveID   int     `json:"objective_id"`  // Database ID of the objective.
+	Opportunities int     `json:"opportunities"` // Total number of player-opportunities to score this objective (sum of players in games where the objective appeared).
+	Phase         string  `json:"phase"`         // Phase in which the objective is scored (e.g., "Status").
+	RawRate       float64 `json:"raw_rate"`      // Raw scoring rate S/O.
+	Scores        int     `json:"scores"`        // Total number of times players actually scored this objective.
+	Stage         string  `json:"stage"`         // Objective stage. Allowed values depend on your dataset; commonly "I", "II" (public objectives).
+	WilsonHi      float64 `json:"wilson_hi"`     // Wilson score interval (upper bound, 95% CI).
+	WilsonLo      float64 `json:"wilson_lo"`     // Wilson score interval (lower bound, 95% CI).
+}
+
+type ObjectiveMeta struct {
+	AverageRound  float64 `json:"averageRound"`
+	Name          string  `json:"name"`
+	ScoredPercent float64 `json:"scoredPercent"`
+	TimesAppeared int     `json:"timesAppeared"`
+	TimesScored   int     `json:"timesScored"`
+	Type          string  `json:"type"`
+}
+
+type ObjectiveScoreSummary struct {
+	Name        string   `json:"name"`
+	ObjectiveID int      `json:"objective_id"`
+	ScoredBy    []string `json:"scored_by"` // player names
+	Stage       string   `json:"stage"`
+}
+
+type ObjectiveStats struct {
+	AppearanceRate         float64 `json:"appearanceRate"`
+	AppearedCount          int     `json:"appearedCount"`
+	ScoredCount            int     `json:"scoredCount"`
+	ScoredWhenAppearedRate float64 `json:"scoredWhenAppearedRate"`
+	Type                   string  `json:"type"` // "public" or "secret"
+}
+
+type PhaseRequest struct {
+	Phase string `json:"phase"` // empty moves to the next phase
+}
+
+type PhaseState struct {
+	CustodiansClaimed bool   `json:"custodians_claimed"`
+	Next              string `json:"next"` // empty when the round should be advanced next
+	Phase             string `json:"phase"`
+	Round             int    `json:"round"`
+}
+
+type PhaseTimeStat struct {
+	AveragePerRound        string `json:"average_per_round"`
+	AveragePerRoundSeconds int    `json:"average_per_round_seconds"`
+	AverageTurnSeconds     int    `json:"average_turn_seconds"`
+	Phase                  string `json:"phase"`
+	TotalSeconds           int    `json:"total_seconds"`
+	Turns                  int    `json:"turns"`
+}
+
+type Player struct {
+	Active  bool          `json:"Active"` // retired players are left off leaderboards
+	Aliases []PlayerAlias `json:"Aliases"`
+	ID      int           `json:"ID"`
+	Name    string        `json:"Name"`
+}
+
+type PlayerAlias struct {
+	Alias string `json:"Alias"` // stored lower case
+}
+
+type PlayerAliasRequest struct {
+	Alias string `json:"alias"`
+}
+
+type PlayerAveragePoints struct {
+	AveragePoints float64 `json:"averagePoints"`
+	GamesPlayed   int     `json:"gamesPlayed"`
+	Player        string  `json:"player"`
+	Stdev         float64 `json:"stdev"`
+	TotalPoints   float64 `json:"totalPoints"`
+}
+
+type PlayerClock struct {
+	Passed   bool   `json:"passed"` // passed in the current round
+	Player   string `json:"player"`
+	PlayerID int    `json:"player_id"`
+	Seconds  int    `json:"seconds"`
+	Turns    int    `json:"turns"`
+}
+
+type PlayerFactionStats struct {
+	Factions map[string]int `json:"factions"`
+	Player   string         `json:"player"`
+}
+
+type PlayerGamesResponse struct {
+	Games  []GamePlayer `json:"games"`
+	Player string       `json:"player"`
+}
+
+type PlayerInput struct {
+	Faction string `json:"Faction"`
+	ID      string `json:"ID"`
+	Name    string `json:"Name"`
+	New     bool   `json:"New"` // create the player even if the name is close to an existing one
+}
+
+type PlayerMostCommonFinish struct {
+	Count      int    `json:"count"`
+	Player     string `json:"player"`
+	Position   int    `json:"position"`
+	TotalGames int    `json:"totalGames"`
+}
+
+type PlayerPointStdev struct {
+	Player string  `json:"player"`
+	Stdev  float64 `json:"stdev"`
+}
+
+type PlayerScoreSummary struct {
+	PlayerID   int    `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	Points     int    `json:"points"`
+}
+
+type PlayerSupportStats struct {
+	Given         int    `json:"given"`
+	HeldAtGameEnd int    `json:"held_at_game_end"`
+	Player        string `json:"player"`
+	Received      int    `json:"received"`
+}
+
+type PlayerTurnStat struct {
+	AverageTurn        string `json:"average_turn"`
+	AverageTurnSeconds int    `json:"average_turn_seconds"`
+	Player             string `json:"player"`
+	TotalSeconds       int    `json:"total_seconds"`
+	Turns              int    `json:"turns"`
+}
+
+type PlayerVPBreakdown struct {
+	Faction    string              `json:"faction"`
+	PlayerID   int                 `json:"player_id"`
+	PlayerName string              `json:"player_name"`
+	Sources    []VPSourceBreakdown `json:"sources"`
+	Total      int                 `json:"total"`
+}
+
+type PlayerWinRate struct {
+	GamesPlayed int     `json:"gamesPlayed"`
+	GamesWon    int     `json:"gamesWon"`
+	Player      string  `json:"player"`
+	WinRate     float64 `json:"winRate"`
+}
+
+type PointRequest struct {
+	GameID   int `json:"game_id"`
+	PlayerID int `json:"player_id"`
+	RoundID  int `json:"round_id"` // 0 for the current round
+}
+
+type PoliticalCensureRequest struct {
+	Gained   bool `json:"gained"`
+	GameID   int  `json:"game_id"`
+	PlayerID int  `json:"player_id"`
+	RoundID  int  `json:"round_id"` // 0 for the current round
+}
+
+type RSVPRequest struct {
+	PlayerID int    `json:"player_id"`
+	Status   string `json:"status"`
+}
+
+type ReasonRequest struct {
+	Reason string `json:"reason"`
+}
+
+type RecomputeResultRequest struct {
+	Reason   string `json:"reason"`
+	WinnerID *int   `json:"winner_id"` // overrides the winner worked out from scores
+}
+
+type Relic struct {
+	Description        string `json:"description"`
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	PointsFollowHolder bool   `json:"points_follow_holder"` // PointsFollowHolder means the points are lost with the relic and move with it on transfer (Shard of the Throne). Otherwise they are kept.
+	SecretCapModifier  int    `json:"secret_cap_modifier"`  // SecretCapModifier is added to the holder's secret objective limit.
+	Transferable       bool   `json:"transferable"`
+	VictoryPoints      int    `json:"victory_points"` // VictoryPoints is awarded when the relic is gained.
+}
+
+type RelicActionRequest struct {
+	Action     string `json:"action"`
+	PlayerID   int    `json:"player_id"` // gaining or losing player
+	Relic      string `json:"relic"`
+	ToPlayerID int    `json:"to_player_id"` // transfer target
+}
+
+type RelicHoldingDTO struct {
+	GainedAt   time.Time  `json:"gained_at"`
+	LostAt     *time.Time `json:"lost_at"`
+	PlayerID   int        `json:"player_id"`
+	PlayerName string     `json:"player_name"`
+	Relic      string     `json:"relic"`
+	RelicID    int        `json:"relic_id"`
+	RoundID    int        `json:"round_id"`
+}
+
+type RenamePlayerRequest struct {
+	KeepAlias bool   `json:"keep_alias"` // keep the old name as an alias
+	Name      string `json:"name"`
+}
+
+type Round struct {
+	EndedAt   *time.Time `json:"EndedAt"`
+	GameID    int        `json:"GameID"`
+	ID        int        `json:"ID"`
+	Number    int        `json:"Number"`
+	Phase     string     `json:"Phase"` // empty unless the game tracks phases
+	Scores    []Score    `json:"Scores"`
+	StartedAt *time.Time `json:"StartedAt"` // nil for rounds recorded before rounds were timed
+}
+
+type RoundLengthStat struct {
+	Average        string `json:"average"`
+	AverageSeconds int    `json:"average_seconds"`
+	Games          int    `json:"games"`
+	Round          int    `json:"round"`
+}
+
+type RoundScore struct {
+	Player string `json:"player"`
+	Points int    `json:"points"`
+	Round  int    `json:"round"`
+	Source string `json:"source"`
+}
+
+type RoundScoresGroup struct {
+	Round  int          `json:"round"`
+	Scores []RoundScore `json:"scores"`
+}
+
+type ScheduleSessionRequest struct {
+	Location string    `json:"location"` // defaults to the game's location
+	Notes    string    `json:"notes"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+type Score struct {
+	AgendaTitle      string      `json:"AgendaTitle"`
+	CardTitle        string      `json:"CardTitle"` // action card or promissory note that awarded the points
+	GameID           int         `json:"GameID"`
+	ID               int         `json:"ID"`
+	Objective        Objective   `json:"Objective"`
+	ObjectiveID      int         `json:"ObjectiveID"`
+	OriginallySecret bool        `json:"OriginallySecret"`
+	Override         string      `json:"Override"` // see ScoreOverrideImperial; empty for a normal score
+	OverrideReason   string      `json:"OverrideReason"`
+	Phase            string      `json:"Phase"` // phase of the round when scored
+	Player           Player      `json:"Player"`
+	PlayerID         int         `json:"PlayerID"`
+	Points           int         `json:"Points"`
+	RelicTitle       string      `json:"RelicTitle"`
+	Round            Round       `json:"Round"`
+	RoundID          int         `json:"RoundID"`
+	Type             ScoreSource `json:"Type"` // see ScoreSources
+	CreatedAt        time.Time   `json:"created_at"`
+}
+
+type ScoreDTO struct {
+	AgendaTitle      string      `json:"agenda_title"`
+	CardTitle        string      `json:"card_title"`
+	CreatedAt        time.Time   `json:"created_at"`
+	GameID           int         `json:"game_id"`
+	ID               int         `json:"id"`
+	ObjectiveID      int         `json:"objective_id"`
+	OriginallySecret bool        `json:"originally_secret"`
+	Override         string      `json:"override"`
+	OverrideReason   string      `json:"override_reason"`
+	Phase            string      `json:"phase"`
+	PlayerID         int         `json:"player_id"`
+	Points           int         `json:"points"`
+	RelicTitle       string      `json:"relic_title"`
+	RoundID          int         `json:"round_id"`
+	Type             ScoreSource `json:"type"`
+}
+
+type ScoreRequest struct {
+	GameID         int    `json:"game_id"`
+	ObjectiveID    int    `json:"objective_id"`
+	Override       string `json:"override"` // Override scores a public objective beyond the one per status phase: "imperial" for the Imperial strategy card, "other" with a reason.
+	OverrideReason string `json:"override_reason"`
+	PlayerID       int    `json:"player_id"`
+}
+
+type ScoreResponse struct {
+	Message     string `json:"message"` // "Score added" or "Game finished"
+	Objective   string `json:"objective"`
+	Points      int    `json:"points"`
+	Round       int    `json:"round"`
+	TotalPoints int    `json:"total_points"`
+	Winner      *int   `json:"winner"`
+}
+
+type ScoreSource string
+
+type SecretObjectiveRate struct {
+	Player          string  `json:"player"`
+	SecretAppeared  int     `json:"secretAppeared"`
+	SecretScoreRate float64 `json:"secretScoreRate"`
+	SecretScored    int     `json:"secretScored"`
+}
+
+type SeedOfEmpireResolution struct {
+	GameID  int    `json:"game_id"`
+	Result  string `json:"result"`
+	RoundID int    `json:"round_id"` // 0 for the current round
+}
+
+type SessionRSVP struct {
+	ID        int       `json:"id"`
+	Player    string    `json:"player"`
+	PlayerID  int       `json:"player_id"`
+	SessionID int       `json:"session_id"`
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type SpeakerAssignment struct {
+	Game     Game   `json:"Game"`
+	GameID   int    `json:"GameID"`
+	ID       int    `json:"ID"`
+	Player   Player `json:"Player"`
+	PlayerID int    `json:"PlayerID"`
+	Round    Round  `json:"Round"`
+	RoundID  int    `json:"RoundID"`
+}
+
+type SpeakerResponse struct {
+	SpeakerID   int    `json:"speaker_id"`
+	SpeakerName string `json:"speaker_name"`
+}
+
+type SupportActionRequest struct {
+	Action   string `json:"action"`
+	HolderID int    `json:"holder_id"`
+	OwnerID  int    `json:"owner_id"`  // give needs both sides; return needs either, which the service checks.
+	PlayerID int    `json:"player_id"` // eliminated player
+}
+
+type SupportForTheThroneRequest struct {
+	Action  string `json:"action"`
+	OwnerID int    `json:"owner_id"`
+	RoundID int    `json:"round_id"`
+}
+
+type SupportHoldingDTO struct {
+	EndReason  string     `json:"end_reason"`
+	EndedAt    *time.Time `json:"ended_at"`
+	GivenAt    time.Time  `json:"given_at"`
+	HolderID   int        `json:"holder_id"`
+	HolderName string     `json:"holder_name"`
+	OwnerID    *int       `json:"owner_id"`
+	OwnerName  string     `json:"owner_name"`
+	RoundID    int        `json:"round_id"`
+}
+
+type SupportPairStats struct {
+	HeldAtEnd  int    `json:"held_at_end"`
+	Holder     string `json:"holder"`
+	Owner      string `json:"owner"`
+	Returned   int    `json:"returned"`
+	TimesGiven int    `json:"times_given"`
+}
+
+type SupportStats struct {
+	Pairs   []SupportPairStats   `json:"pairs"`
+	Players []PlayerSupportStats `json:"players"`
+}
+
+type Turn struct {
+	EndedAt   *time.Time `json:"ended_at"`
+	GameID    int        `json:"game_id"`
+	ID        int        `json:"id"`
+	Passed    bool       `json:"passed"`
+	Phase     string     `json:"phase"`
+	PlayerID  int        `json:"player_id"`
+	RoundID   int        `json:"round_id"`
+	StartedAt time.Time  `json:"started_at"`
+}
+
+type TurnRequest struct {
+	Phase    string `json:"phase"` // defaults to the round's phase, or action
+	PlayerID int    `json:"player_id"`
+}
+
+type UnknownPlayerResponse struct {
+	Error       string   `json:"error"`
+	Player      string   `json:"player"`
+	Suggestions []string `json:"suggestions"`
+}
+
+type UpcomingSession struct {
+	GameNumber int         `json:"game_number"`
+	GameTitle  string      `json:"game_title"`
+	Session    GameSession `json:"session"`
+}
+
+type VPBucket struct {
+	Count int `json:"count"`
+	VP    int `json:"vp"`
+}
+
+type VPProvenance struct {
+	Points int    `json:"points"`
+	Round  int    `json:"round"`
+	Title  string `json:"title"`
+}
+
+type VPSourceBreakdown struct {
+	Items  []VPProvenance `json:"items"`
+	Label  string         `json:"label"`
+	Points int            `json:"points"`
+	Source ScoreSource    `json:"source"`
+}
+
+type ValidationErrorResponse struct {
+	Error  string       `json:"error"`
+	Fields []FieldError `json:"fields"`
+}
+
+type VictoryPath struct {
+	ActionCard   int `json:"action_card"`
+	Agenda       int `json:"agenda"`
+	Custodians   int `json:"custodians"`
+	Imperial     int `json:"imperial"`
+	Relics       int `json:"relics"`
+	Secrets      int `json:"secrets"`
+	Stage1       int `json:"stage1"`
+	Stage2scored int `json:"stage2scored"`
+	Support      int `json:"support"`
+}
+
+type VictoryPathSummary struct {
+	Frequency         int         `json:"frequency"`
+	Path              VictoryPath `json:"path"`
+	UniquenessPercent int         `json:"uniqueness_percent"`
+}
+
+type PlayerCustodiansStats struct {
+	CustodiansTaken         int    `json:"custodians_taken"`
+	CustodiansWinPercentage int    `json:"custodians_win_percentage"`
+	CustodiansWins          int    `json:"custodians_wins"`
+	GamesPlayed             int    `json:"games_played"`
+	GamesWon                int    `json:"games_won"`
+	PlayerID                int    `json:"player_id"`
+	PlayerName              string `json:"player_name"`
+}
+
+type StatsOverview struct {
+	AverageGameRounds          float64                              `json:"averageGameRounds"`
+	AveragePlayerPoints        float64                              `json:"averagePlayerPoints"`
+	CommonVictoryPaths         map[string]int                       `json:"commonVictoryPaths"`
+	CustodiansStats            []PlayerCustodiansStats              `json:"custodiansStats"`
+	FactionAggregateStats      []FactionAggregateStats              `json:"factionAggregateStats"`
+	FactionObjectiveStats      map[string]map[string]ObjectiveStats `json:"factionObjectiveStats"`
+	FactionPlayWinDistribution map[string]FactionPlayWinStat        `json:"factionPlayWinDistribution"`
+	FactionPlayerStats         []FactionPlayerStats                 `json:"factionPlayerStats"`
+	GameLengthDistribution     map[string]int                       `json:"gameLengthDistribution"`
+	GameLengthStats            GameLengthStats                      `json:"gameLengthStats"`
+	GamesPlayedByFaction       map[string]int                       `json:"gamesPlayedByFaction"`
+	GamesWonByFaction          map[string]int                       `json:"gamesWonByFaction"`
+	GeneratedAt                time.Time                            `json:"generatedAt"`
+	MostPlayedFaction          string                               `json:"mostPlayedFaction"`
+	MostVictoriousFaction      string                               `json:"mostVictoriousFaction"`
+	ObjectiveAppearanceStats   map[string]ObjectiveStats            `json:"objectiveAppearanceStats"`
+	ObjectiveFrequency         map[string]int                       `json:"objectiveFrequency"`
+	ObjectiveMetaStats         []ObjectiveMeta                      `json:"objectiveMetaStats"`
+	ObjectiveStats             map[string]int                       `json:"objectiveStats"`
+	PlayerAveragePoints        []PlayerAveragePoints                `json:"playerAveragePoints"`
+	PlayerMostCommonFinishes   []PlayerMostCommonFinish             `json:"playerMostCommonFinishes"`
+	PlayerPointStdevs          []PlayerPointStdev                   `json:"playerPointStdevs"`
+	PlayerWinRates             []PlayerWinRate                      `json:"playerWinRates"`
+	PointSpreadDistribution    map[string]int                       `json:"pointSpreadDistribution"`
+	PublicObjectiveFrequency   map[string]int                       `json:"publicObjectiveFrequency"`
+	PublicSecretFrequency      map[string]int                       `json:"publicSecretFrequency"`
+	SecretObjectiveRates       []SecretObjectiveRate                `json:"secretObjectiveRates"`
+	TopFactionsPerPlayer       []PlayerFactionStats                 `json:"topFactionsPerPlayer"`
+	TotalGames                 int                                  `json:"totalGames"`
+	TotalUniquePlayers         int                                  `json:"totalUniquePlayers"`
+	WinRateByFaction           map[string]float64                   `json:"winRateByFaction"`
+}
+
+// AddPlayerAlias calls POST /api/v1/players/{id}/aliases: Add a player alias
+func (c *Client) AddPlayerAlias(ctx context.Context, id int, body *PlayerAliasRequest) (*Player, error) {
+	var out Player
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/players/%d/aliases", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddScore calls POST /api/v1/games/{id}/scores: Score an objective
+func (c *Client) AddScore(ctx context.Context, id int, body *ScoreRequest) (*ScoreResponse, error) {
+	var out ScoreResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/scores", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdvanceRound calls POST /api/v1/games/{id}/rounds: Advance game round
+func (c *Client) AdvanceRound(ctx context.Context, id int) (*AdvanceRoundResponse, error) {
+	var out AdvanceRoundResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/rounds", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AssignObjective calls POST /api/v1/games/{id}/objectives: Manually assign a public objective to a round
+func (c *Client) AssignObjective(ctx context.Context, id int, body *AssignObjectiveRequest) (*MessageResponse, error) {
+	var out MessageResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/objectives", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AssignPlayerToGame calls POST /api/v1/games/{id}/players: Assign player to game
+func (c *Client) AssignPlayerToGame(ctx context.Context, id int, body *AssignPlayerInput) (*GamePlayer, error) {
+	var out GamePlayer
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/players", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelSession calls DELETE /api/v1/games/{id}/sessions/{session_id}: Cancel a scheduled session
+func (c *Client) CancelSession(ctx context.Context, id int, sessionID int) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("/api/v1/games/%d/sessions/%d", id, sessionID), nil, nil, nil)
+}
+
+// CorrectGamePlayer calls POST /api/v1/admin/games/{id}/players/{player_id}: Correct a player's faction
+func (c *Client) CorrectGamePlayer(ctx context.Context, id int, playerID int, body *CorrectGamePlayerRequest) (*GamePlayer, error) {
+	var out GamePlayer
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/admin/games/%d/players/%d", id, playerID), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CorrectScore calls POST /api/v1/admin/scores/{id}: Correct a score
+func (c *Client) CorrectScore(ctx context.Context, id int, body *CorrectScoreRequest) (*Score, error) {
+	var out Score
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/admin/scores/%d", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateBackup calls POST /api/v1/admin/backup: Back up the database
+func (c *Client) CreateBackup(ctx context.Context) (*Backup, error) {
+	var out Backup
+	if err := c.do(ctx, "POST", "/api/v1/admin/backup", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCardEffect calls POST /api/v1/card-effects: Add a homebrew card effect
+func (c *Client) CreateCardEffect(ctx context.Context, body *CardEffect) (*CardEffect, error) {
+	var out CardEffect
+	if err := c.do(ctx, "POST", "/api/v1/card-effects", nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGame calls POST /api/v1/games: Create a new game
+func (c *Client) CreateGame(ctx context.Context, body *CreateGameInput) (*CreateGameResponse, error) {
+	var out CreateGameResponse
+	if err := c.do(ctx, "POST", "/api/v1/games", nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGameFromTemplate calls POST /api/v1/games/from-template/{id}: Create a game from a template
+func (c *Client) CreateGameFromTemplate(ctx context.Context, id int, body *GameFromTemplateRequest) (*CreateGameResponse, error) {
+	var out CreateGameResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/from-template/%d", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGameTemplate calls POST /api/v1/templates: Save a game template
+func (c *Client) CreateGameTemplate(ctx context.Context, body *GameTemplate) (*GameTemplate, error) {
+	var out GameTemplate
+	if err := c.do(ctx, "POST", "/api/v1/templates", nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreatePlayer calls POST /api/v1/players: Create player
+func (c *Client) CreatePlayer(ctx context.Context, body *Player) (*Player, error) {
+	var out Player
+	if err := c.do(ctx, "POST", "/api/v1/players", nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGameHandler calls DELETE /api/v1/games/{id}: Delete a game
+func (c *Client) DeleteGameHandler(ctx context.Context, id int) (*DeleteGameResponse, error) {
+	var out DeleteGameResponse
+	if err := c.do(ctx, "DELETE", fmt.Sprintf("/api/v1/games/%d", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGameTemplate calls DELETE /api/v1/templates/{id}: Delete a game template
+func (c *Client) DeleteGameTemplate(ctx context.Context, id int) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("/api/v1/templates/%d", id), nil, nil, nil)
+}
+
+// DeleteScore calls DELETE /api/v1/games/{id}/scores: Delete a scored objective
+func (c *Client) DeleteScore(ctx context.Context, id int, body *ScoreRequest) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("/api/v1/games/%d/scores", id), nil, body, nil)
+}
+
+// EndTurn calls POST /api/v1/games/{id}/turns/end: End the running turn
+func (c *Client) EndTurn(ctx context.Context, id int) (*GameClock, error) {
+	var out GameClock
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/turns/end", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportGamesCSV calls GET /api/v1/export/games.csv: Export games as CSV
+func (c *Client) ExportGamesCSV(ctx context.Context) ([]byte, error) {
+	return c.Raw(ctx, "GET", "/api/v1/export/games.csv", nil, nil)
+}
+
+// ExportScoresCSV calls GET /api/v1/export/scores.csv: Export scores as CSV
+func (c *Client) ExportScoresCSV(ctx context.Context) ([]byte, error) {
+	return c.Raw(ctx, "GET", "/api/v1/export/scores.csv", nil, nil)
+}
+
+// ExportStatsCSV calls GET /api/v1/export/stats.csv: Export stats as CSV
+func (c *Client) ExportStatsCSV(ctx context.Context) ([]byte, error) {
+	return c.Raw(ctx, "GET", "/api/v1/export/stats.csv", nil, nil)
+}
+
+// ExportUpcomingSessionsICal calls GET /api/v1/sessions/upcoming.ics: Upcoming sessions as iCalendar
+func (c *Client) ExportUpcomingSessionsICal(ctx context.Context) ([]byte, error) {
+	return c.Raw(ctx, "GET", "/api/v1/sessions/upcoming.ics", nil, nil)
+}
+
+// ExportWorkbook calls GET /api/v1/export/workbook.xlsx: Export everything as a spreadsheet
+func (c *Client) ExportWorkbook(ctx context.Context) ([]byte, error) {
+	return c.Raw(ctx, "GET", "/api/v1/export/workbook.xlsx", nil, nil)
+}
+
+// GetAllPublicObjectives calls GET /api/v1/objectives/public: List public objectives
+func (c *Client) GetAllPublicObjectives(ctx context.Context) ([]Objective, error) {
+	var out []Objective
+	err := c.do(ctx, "GET", "/api/v1/objectives/public", nil, nil, &out)
+	return out, err
+}
+
+// GetAllSecretObjectives calls GET /api/v1/objectives/secret: List secret objectives
+func (c *Client) GetAllSecretObjectives(ctx context.Context) ([]Objective, error) {
+	var out []Objective
+	err := c.do(ctx, "GET", "/api/v1/objectives/secret", nil, nil, &out)
+	return out, err
+}
+
+// GetCardEffectStats calls GET /api/v1/stats/card-effects: Card effect stats
+func (c *Client) GetCardEffectStats(ctx context.Context) ([]CardEffectStats, error) {
+	var out []CardEffectStats
+	err := c.do(ctx, "GET", "/api/v1/stats/card-effects", nil, nil, &out)
+	return out, err
+}
+
+// GetClockStats calls GET /api/v1/stats/clock: Game clock stats
+func (c *Client) GetClockStats(ctx context.Context) (*ClockStats, error) {
+	var out ClockStats
+	if err := c.do(ctx, "GET", "/api/v1/stats/clock", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetFactionsParams are the query parameters of GetFactions. Zero values are left out.
+type GetFactionsParams struct {
+	Expansion string // Only factions from this expansion (base, pok, codex)
+}
+
+func (p *GetFactionsParams) values() url.Values {
+	if p == nil {
+		return nil
+	}
+	q := url.Values{}
+	if p.Expansion != "" {
+		q.Set("expansion", fmt.Sprint(p.Expansion))
+	}
+	return q
+}
+
+// GetFactions calls GET /api/v1/factions: List factions
+func (c *Client) GetFactions(ctx context.Context, params *GetFactionsParams) ([]Faction, error) {
+	var out []Faction
+	err := c.do(ctx, "GET", "/api/v1/factions", params.values(), nil, &out)
+	return out, err
+}
+
+// GetGameAchievements calls GET /api/v1/games/{id}/achievements: Get achievements for a game
+func (c *Client) GetGameAchievements(ctx context.Context, id int) (*BadgeList, error) {
+	var out BadgeList
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/achievements", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGameAuditParams are the query parameters of GetGameAudit. Zero values are left out.
+type GetGameAuditParams struct {
+	Page     int // Page number
+	PageSize int // Page size (max 200)
+}
+
+func (p *GetGameAuditParams) values() url.Values {
+	if p == nil {
+		return nil
+	}
+	q := url.Values{}
+	if p.Page != 0 {
+		q.Set("page", fmt.Sprint(p.Page))
+	}
+	if p.PageSize != 0 {
+		q.Set("page_size", fmt.Sprint(p.PageSize))
+	}
+	return q
+}
+
+// GetGameAudit calls GET /api/v1/games/{id}/audit: Game audit trail
+func (c *Client) GetGameAudit(ctx context.Context, id int, params *GetGameAuditParams) ([]AuditLog, error) {
+	var out []AuditLog
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/audit", id), params.values(), nil, &out)
+	return out, err
+}
+
+// GetGameByID calls GET /api/v1/games/{id}: Get game detail
+func (c *Client) GetGameByID(ctx context.Context, id int) (*GameDetailResponse, error) {
+	var out GameDetailResponse
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGameClock calls GET /api/v1/games/{id}/clock: Game clock
+func (c *Client) GetGameClock(ctx context.Context, id int) (*GameClock, error) {
+	var out GameClock
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/clock", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGameExists calls GET /api/v1/games/{id}/exists: Check game exists
+func (c *Client) GetGameExists(ctx context.Context, id int) (*ExistsResponse, error) {
+	var out ExistsResponse
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/exists", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGameObjectives calls GET /api/v1/games/{id}/objectives: Get game public objectives
+func (c *Client) GetGameObjectives(ctx context.Context, id int) ([]GameObjective, error) {
+	var out []GameObjective
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/objectives", id), nil, nil, &out)
+	return out, err
+}
+
+// GetGameRelics calls GET /api/v1/games/{id}/relics: Relic holdings in a game
+func (c *Client) GetGameRelics(ctx context.Context, id int) ([]RelicHoldingDTO, error) {
+	var out []RelicHoldingDTO
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/relics", id), nil, nil, &out)
+	return out, err
+}
+
+// GetGameSessions calls GET /api/v1/games/{id}/sessions: Game sessions
+func (c *Client) GetGameSessions(ctx context.Context, id int) (*GameSessions, error) {
+	var out GameSessions
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/sessions", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGameSupport calls GET /api/v1/games/{id}/support: Support for the Throne in a game
+func (c *Client) GetGameSupport(ctx context.Context, id int) ([]SupportHoldingDTO, error) {
+	var out []SupportHoldingDTO
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/support", id), nil, nil, &out)
+	return out, err
+}
+
+// GetGameTemplate calls GET /api/v1/templates/{id}: Game template
+func (c *Client) GetGameTemplate(ctx context.Context, id int) (*GameTemplate, error) {
+	var out GameTemplate
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/templates/%d", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGlobalAchievements calls GET /api/v1/achievements: Global achievements (records)
+func (c *Client) GetGlobalAchievements(ctx context.Context) (*BadgeList, error) {
+	var out BadgeList
+	if err := c.do(ctx, "GET", "/api/v1/achievements", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetObjectiveDifficultyParams are the query parameters of GetObjectiveDifficulty. Zero values are left out.
+type GetObjectiveDifficultyParams struct {
+	Stage            string // Filter by stage (I, II, secret, or all)
+	MinAppearances   int    // Minimum appearances required to include
+	MinOpportunities int    // Minimum scoring opportunities required
+}
+
+func (p *GetObjectiveDifficultyParams) values() url.Values {
+	if p == nil {
+		return nil
+	}
+	q := url.Values{}
+	if p.Stage != "" {
+		q.Set("stage", fmt.Sprint(p.Stage))
+	}
+	if p.MinAppearances != 0 {
+		q.Set("minAppearances", fmt.Sprint(p.MinAppearances))
+	}
+	if p.MinOpportunities != 0 {
+		q.Set("minOpportunities", fmt.Sprint(p.MinOpportunities))
+	}
+	return q
+}
+
+// GetObjectiveDifficulty calls GET /api/v1/stats/objectives/difficulty: Get objective difficulty
+func (c *Client) GetObjectiveDifficulty(ctx context.Context, params *GetObjectiveDifficultyParams) (*ObjectiveDifficultyResponse, error) {
+	var out ObjectiveDifficultyResponse
+	if err := c.do(ctx, "GET", "/api/v1/stats/objectives/difficulty", params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetObjectiveScoreSummary calls GET /api/v1/games/{id}/objectives/scores: Objective score summary for a game
+func (c *Client) GetObjectiveScoreSummary(ctx context.Context, id int) ([]ObjectiveScoreSummary, error) {
+	var out []ObjectiveScoreSummary
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/objectives/scores", id), nil, nil, &out)
+	return out, err
+}
+
+// GetPhase calls GET /api/v1/games/{id}/phase: Current phase
+func (c *Client) GetPhase(ctx context.Context, id int) (*PhaseState, error) {
+	var out PhaseState
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/phase", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPlayer calls GET /api/v1/players/{id}: Get a player
+func (c *Client) GetPlayer(ctx context.Context, id int) (*Player, error) {
+	var out Player
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/players/%d", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPlayerGamesParams are the query parameters of GetPlayerGames. Zero values are left out.
+type GetPlayerGamesParams struct {
+	Page     int    // Page number
+	PageSize int    // Page size (max 200)
+	Sort     string // Comma separated sort keys, prefix with - for descending (game_id, game_number, created_at, finished_at, faction, won)
+	Fields   string // Comma separated JSON fields to return for each game
+}
+
+func (p *GetPlayerGamesParams) values() url.Values {
+	if p == nil {
+		return nil
+	}
+	q := url.Values{}
+	if p.Page != 0 {
+		q.Set("page", fmt.Sprint(p.Page))
+	}
+	if p.PageSize != 0 {
+		q.Set("page_size", fmt.Sprint(p.PageSize))
+	}
+	if p.Sort != "" {
+		q.Set("sort", fmt.Sprint(p.Sort))
+	}
+	if p.Fields != "" {
+		q.Set("fields", fmt.Sprint(p.Fields))
+	}
+	return q
+}
+
+// GetPlayerGames calls GET /api/v1/players/{id}/games: Get a player's games
+func (c *Client) GetPlayerGames(ctx context.Context, id int, params *GetPlayerGamesParams) (*PlayerGamesResponse, error) {
+	var out PlayerGamesResponse
+	if err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/players/%d/games", id), params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetScoreSummary calls GET /api/v1/games/{id}/scores/summary: Points per player in a game
+func (c *Client) GetScoreSummary(ctx context.Context, id int) ([]PlayerScoreSummary, error) {
+	var out []PlayerScoreSummary
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/scores/summary", id), nil, nil, &out)
+	return out, err
+}
+
+// GetScoresByRound calls GET /api/v1/games/{id}/scores/by-round: Scores in a game by round
+func (c *Client) GetScoresByRound(ctx context.Context, id int) ([]RoundScoresGroup, error) {
+	var out []RoundScoresGroup
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/scores/by-round", id), nil, nil, &out)
+	return out, err
+}
+
+// GetStatsOverview calls GET /api/v1/stats/overview: Stats overview
+func (c *Client) GetStatsOverview(ctx context.Context) (*StatsOverview, error) {
+	var out StatsOverview
+	if err := c.do(ctx, "GET", "/api/v1/stats/overview", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSupportStats calls GET /api/v1/stats/support: Support for the Throne stats
+func (c *Client) GetSupportStats(ctx context.Context) (*SupportStats, error) {
+	var out SupportStats
+	if err := c.do(ctx, "GET", "/api/v1/stats/support", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUpcomingSessions calls GET /api/v1/sessions/upcoming: Upcoming sessions
+func (c *Client) GetUpcomingSessions(ctx context.Context) ([]UpcomingSession, error) {
+	var out []UpcomingSession
+	err := c.do(ctx, "GET", "/api/v1/sessions/upcoming", nil, nil, &out)
+	return out, err
+}
+
+// GetVPBreakdown calls GET /api/v1/games/{id}/vp-breakdown: Victory points by source for a game
+func (c *Client) GetVPBreakdown(ctx context.Context, id int) ([]PlayerVPBreakdown, error) {
+	var out []PlayerVPBreakdown
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/vp-breakdown", id), nil, nil, &out)
+	return out, err
+}
+
+// HandleClassifiedDocumentLeaks calls POST /api/v1/games/{id}/agendas/classified-document-leaks: Apply "Classified Document Leaks"
+func (c *Client) HandleClassifiedDocumentLeaks(ctx context.Context, id int, body *ClassifiedDocumentLeaksRequest) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/agendas/classified-document-leaks", id), nil, body, nil)
+}
+
+// HandleCrownRelic calls POST /api/v1/games/{id}/relics/crown: Apply "Crown of Emphidia"
+func (c *Client) HandleCrownRelic(ctx context.Context, id int, body *RelicRequest) (*MessageResponse, error) {
+	var out MessageResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/relics/crown", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HandleIncentiveProgram calls POST /api/v1/games/{id}/agendas/incentive-program: Apply "Incentive Program"
+func (c *Client) HandleIncentiveProgram(ctx context.Context, id int, body *IncentiveProgramRequest) (*MessageResponse, error) {
+	var out MessageResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/agendas/incentive-program", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HandleLatvinaRelic calls POST /api/v1/games/{id}/relics/latvina: Apply "Book of Latvinia"
+func (c *Client) HandleLatvinaRelic(ctx context.Context, id int, body *RelicRequest) (*MessageResponse, error) {
+	var out MessageResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/relics/latvina", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HandleObsidianRelic calls POST /api/v1/games/{id}/relics/obsidian: Apply "The Obsidian"
+func (c *Client) HandleObsidianRelic(ctx context.Context, id int, body *RelicRequest) (*MessageResponse, error) {
+	var out MessageResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/relics/obsidian", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HandlePoliticalCensure calls POST /api/v1/games/{id}/agendas/political-censure: Apply "Political Censure" agenda
+func (c *Client) HandlePoliticalCensure(ctx context.Context, id int, body *PoliticalCensureRequest) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/agendas/political-censure", id), nil, body, nil)
+}
+
+// HandleRelicAction calls POST /api/v1/games/{id}/relics: Gain, lose or transfer a relic
+func (c *Client) HandleRelicAction(ctx context.Context, id int, body *RelicActionRequest) ([]RelicHoldingDTO, error) {
+	var out []RelicHoldingDTO
+	err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/relics", id), nil, body, &out)
+	return out, err
+}
+
+// HandleSeedOfEmpire calls POST /api/v1/games/{id}/agendas/seed-of-empire: Apply "Seed of an Empire" agenda
+func (c *Client) HandleSeedOfEmpire(ctx context.Context, id int, body *SeedOfEmpireResolution) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/agendas/seed-of-empire", id), nil, body, nil)
+}
+
+// HandleShardRelic calls POST /api/v1/games/{id}/relics/shard: Update "Shard of the Throne" holder
+func (c *Client) HandleShardRelic(ctx context.Context, id int, body *ShardRequest) (*MessageResponse, error) {
+	var out MessageResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/relics/shard", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HandleSupportAction calls POST /api/v1/games/{id}/support: Give, return or eliminate for Support for the Throne
+func (c *Client) HandleSupportAction(ctx context.Context, id int, body *SupportActionRequest) ([]SupportHoldingDTO, error) {
+	var out []SupportHoldingDTO
+	err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/support", id), nil, body, &out)
+	return out, err
+}
+
+// ImportGames calls POST /api/v1/import/games: Import historical games
+func (c *Client) ImportGames(ctx context.Context, body *ImportGamesRequest) (*ImportResult, error) {
+	var out ImportResult
+	if err := c.do(ctx, "POST", "/api/v1/import/games", nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBackups calls GET /api/v1/admin/backups: List backups
+func (c *Client) ListBackups(ctx context.Context) ([]Backup, error) {
+	var out []Backup
+	err := c.do(ctx, "GET", "/api/v1/admin/backups", nil, nil, &out)
+	return out, err
+}
+
+// ListCardEffects calls GET /api/v1/card-effects: Card effect registry
+func (c *Client) ListCardEffects(ctx context.Context) ([]CardEffect, error) {
+	var out []CardEffect
+	err := c.do(ctx, "GET", "/api/v1/card-effects", nil, nil, &out)
+	return out, err
+}
+
+// ListGameTemplates calls GET /api/v1/templates: Game templates
+func (c *Client) ListGameTemplates(ctx context.Context) ([]GameTemplate, error) {
+	var out []GameTemplate
+	err := c.do(ctx, "GET", "/api/v1/templates", nil, nil, &out)
+	return out, err
+}
+
+// ListGamesParams are the query parameters of ListGames. Zero values are left out.
+type ListGamesParams struct {
+	Search   string // Search query (e.g., 'w:Alice -p:Bob rounds<=6')
+	Page     int    // Page number
+	PageSize int    // Page size (max 200)
+	Sort     string // Comma separated sort keys, prefix with - for descending (id, game_number, created_at, finished_at, winning_points, current_round)
+	Fields   string // Comma separated JSON fields to return (e.g. id,game_number,winner)
+}
+
+func (p *ListGamesParams) values() url.Values {
+	if p == nil {
+		return nil
+	}
+	q := url.Values{}
+	if p.Search != "" {
+		q.Set("search", fmt.Sprint(p.Search))
+	}
+	if p.Page != 0 {
+		q.Set("page", fmt.Sprint(p.Page))
+	}
+	if p.PageSize != 0 {
+		q.Set("page_size", fmt.Sprint(p.PageSize))
+	}
+	if p.Sort != "" {
+		q.Set("sort", fmt.Sprint(p.Sort))
+	}
+	if p.Fields != "" {
+		q.Set("fields", fmt.Sprint(p.Fields))
+	}
+	return q
+}
+
+// ListGames calls GET /api/v1/games: List games
+func (c *Client) ListGames(ctx context.Context, params *ListGamesParams) ([]Game, error) {
+	var out []Game
+	err := c.do(ctx, "GET", "/api/v1/games", params.values(), nil, &out)
+	return out, err
+}
+
+// ListPlayersParams are the query parameters of ListPlayers. Zero values are left out.
+type ListPlayersParams struct {
+	Page     int    // Page number
+	PageSize int    // Page size (max 200)
+	Sort     string // Comma separated sort keys, prefix with - for descending (id, name)
+	Fields   string // Comma separated JSON fields to return (e.g. ID,Name)
+}
+
+func (p *ListPlayersParams) values() url.Values {
+	if p == nil {
+		return nil
+	}
+	q := url.Values{}
+	if p.Page != 0 {
+		q.Set("page", fmt.Sprint(p.Page))
+	}
+	if p.PageSize != 0 {
+		q.Set("page_size", fmt.Sprint(p.PageSize))
+	}
+	if p.Sort != "" {
+		q.Set("sort", fmt.Sprint(p.Sort))
+	}
+	if p.Fields != "" {
+		q.Set("fields", fmt.Sprint(p.Fields))
+	}
+	return q
+}
+
+// ListPlayers calls GET /api/v1/players: List players
+func (c *Client) ListPlayers(ctx context.Context, params *ListPlayersParams) ([]Player, error) {
+	var out []Player
+	err := c.do(ctx, "GET", "/api/v1/players", params.values(), nil, &out)
+	return out, err
+}
+
+// ListPlayersInGame calls GET /api/v1/games/{id}/players: List players in a game
+func (c *Client) ListPlayersInGame(ctx context.Context, id int) ([]GamePlayer, error) {
+	var out []GamePlayer
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/v1/games/%d/players", id), nil, nil, &out)
+	return out, err
+}
+
+// ListRelics calls GET /api/v1/relics: List relics
+func (c *Client) ListRelics(ctx context.Context) ([]Relic, error) {
+	var out []Relic
+	err := c.do(ctx, "GET", "/api/v1/relics", nil, nil, &out)
+	return out, err
+}
+
+// MergePlayer calls POST /api/v1/players/{id}/merge: Merge a duplicate player
+func (c *Client) MergePlayer(ctx context.Context, id int, body *MergePlayerRequest) (*Player, error) {
+	var out Player
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/players/%d/merge", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PassTurn calls POST /api/v1/games/{id}/turns/pass: Pass
+func (c *Client) PassTurn(ctx context.Context, id int, body *TurnRequest) (*GameClock, error) {
+	var out GameClock
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/turns/pass", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseGame calls POST /api/v1/games/{id}/sessions/pause: Pause a game
+func (c *Client) PauseGame(ctx context.Context, id int) (*GameSession, error) {
+	var out GameSession
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/sessions/pause", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PostAssignSpeaker calls POST /api/v1/games/{id}/speaker: Assign speaker
+func (c *Client) PostAssignSpeaker(ctx context.Context, id int, body *AssignSpeakerRequest) (*MessageResponse, error) {
+	var out MessageResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/speaker", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RandomiseSpeaker calls POST /api/v1/games/{id}/speaker/random: Randomise speaker
+func (c *Client) RandomiseSpeaker(ctx context.Context, id int) (*SpeakerResponse, error) {
+	var out SpeakerResponse
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/speaker/random", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReactivatePlayer calls POST /api/v1/players/{id}/reactivate: Reactivate a retired player
+func (c *Client) ReactivatePlayer(ctx context.Context, id int) (*Player, error) {
+	var out Player
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/players/%d/reactivate", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RecomputeGameResult calls POST /api/v1/admin/games/{id}/recompute: Recompute a game's result
+func (c *Client) RecomputeGameResult(ctx context.Context, id int, body *RecomputeResultRequest) (*Game, error) {
+	var out Game
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/admin/games/%d/recompute", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RecordCardEffect calls POST /api/v1/games/{id}/card-effects: Record a card effect in a game
+func (c *Client) RecordCardEffect(ctx context.Context, id int, body *CardEffectRequest) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/card-effects", id), nil, body, nil)
+}
+
+// RemovePlayerAlias calls DELETE /api/v1/players/{id}/aliases/{alias}: Remove a player alias
+func (c *Client) RemovePlayerAlias(ctx context.Context, id int, alias string) (*Player, error) {
+	var out Player
+	if err := c.do(ctx, "DELETE", fmt.Sprintf("/api/v1/players/%d/aliases/%s", id, url.PathEscape(alias)), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RenamePlayer calls POST /api/v1/players/{id}/rename: Rename a player
+func (c *Client) RenamePlayer(ctx context.Context, id int, body *RenamePlayerRequest) (*Player, error) {
+	var out Player
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/players/%d/rename", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReopenGame calls POST /api/v1/admin/games/{id}/reopen: Reopen a finished game
+func (c *Client) ReopenGame(ctx context.Context, id int, body *ReasonRequest) (*Game, error) {
+	var out Game
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/admin/games/%d/reopen", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResolveMutinyAgenda calls POST /api/v1/games/{id}/agendas/mutiny: Apply "Mutiny" agenda
+func (c *Client) ResolveMutinyAgenda(ctx context.Context, id int, body *AgendaResolution) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/agendas/mutiny", id), nil, body, nil)
+}
+
+// RespondToSession calls POST /api/v1/games/{id}/sessions/{session_id}/rsvps: RSVP to a session
+func (c *Client) RespondToSession(ctx context.Context, id int, sessionID int, body *RSVPRequest) (*GameSession, error) {
+	var out GameSession
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/sessions/%d/rsvps", id, sessionID), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResumeGame calls POST /api/v1/games/{id}/sessions/resume: Resume a game
+func (c *Client) ResumeGame(ctx context.Context, id int) (*GameSession, error) {
+	var out GameSession
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/sessions/resume", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RetirePlayer calls POST /api/v1/players/{id}/retire: Retire a player
+func (c *Client) RetirePlayer(ctx context.Context, id int) (*Player, error) {
+	var out Player
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/players/%d/retire", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SFTT calls POST /api/v1/games/{id}/support/{player_id}: Support for the Throne
+func (c *Client) SFTT(ctx context.Context, id int, playerID int, body *SupportForTheThroneRequest) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/support/%d", id, playerID), nil, body, nil)
+}
+
+// ScheduleSession calls POST /api/v1/games/{id}/sessions: Schedule a session
+func (c *Client) ScheduleSession(ctx context.Context, id int, body *ScheduleSessionRequest) (*GameSession, error) {
+	var out GameSession
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/sessions", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ScoreImperialPoint calls POST /api/v1/games/{id}/scores/imperial: Score Imperial point
+func (c *Client) ScoreImperialPoint(ctx context.Context, id int, body *PointRequest) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/scores/imperial", id), nil, body, nil)
+}
+
+// ScoreImperialRiderPoint calls POST /api/v1/games/{id}/scores/imperial-rider: Score Imperial Rider point
+func (c *Client) ScoreImperialRiderPoint(ctx context.Context, id int, body *PointRequest) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/scores/imperial-rider", id), nil, body, nil)
+}
+
+// ScoreMecatolPoint calls POST /api/v1/games/{id}/scores/mecatol: Score Custodians (Mecatol) point
+func (c *Client) ScoreMecatolPoint(ctx context.Context, id int, body *PointRequest) error {
+	return c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/scores/mecatol", id), nil, body, nil)
+}
+
+// SetPhase calls POST /api/v1/games/{id}/phase: Change phase
+func (c *Client) SetPhase(ctx context.Context, id int, body *PhaseRequest) (*PhaseState, error) {
+	var out PhaseState
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/phase", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartTurn calls POST /api/v1/games/{id}/turns/start: Start a player's turn
+func (c *Client) StartTurn(ctx context.Context, id int, body *TurnRequest) (*GameClock, error) {
+	var out GameClock
+	if err := c.do(ctx, "POST", fmt.Sprintf("/api/v1/games/%d/turns/start", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGameTemplate calls PUT /api/v1/templates/{id}: Replace a game template
+func (c *Client) UpdateGameTemplate(ctx context.Context, id int, body *GameTemplate) (*GameTemplate, error) {
+	var out GameTemplate
+	if err := c.do(ctx, "PUT", fmt.Sprintf("/api/v1/templates/%d", id), nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
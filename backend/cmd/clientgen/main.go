@@ -0,0 +1,384 @@
+// Command clientgen writes the typed Go client in package client from the
+// Swagger document that swag generates from the handler annotations.
+//
+//	go run ./cmd/clientgen -spec docs/swagger.json -out client/client_gen.go
+//
+// Every definition becomes a struct and every operation a method named
+// after its operationId. Pointer fields are marked x-nullable in the spec
+// and stay pointers in the client.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/arphillips06/TI4-stats/apispec"
+)
+
+func main() {
+	specPath := flag.String("spec", "docs/swagger.json", "Swagger 2.0 JSON document")
+	out := flag.String("out", "client/client_gen.go", "file to write")
+	pkg := flag.String("pkg", "client", "package name")
+	flag.Parse()
+
+	doc, err := os.ReadFile(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	spec, err := apispec.Parse(doc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := &generator{spec: spec, imports: map[string]bool{}}
+	src, err := g.file(*pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	spec    *apispec.Spec
+	body    bytes.Buffer
+	imports map[string]bool
+}
+
+func (g *generator) p(format string, args ...any) {
+	fmt.Fprintf(&g.body, format+"\n", args...)
+}
+
+func (g *generator) file(pkg string) ([]byte, error) {
+	for _, name := range sortedKeys(g.spec.Definitions) {
+		g.definition(name, g.spec.Definitions[name])
+	}
+	for _, op := range g.operations() {
+		g.operation(op)
+	}
+
+	var head bytes.Buffer
+	fmt.Fprintf(&head, "// Code generated by clientgen from docs/swagger.json. DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg)
+	for _, imp := range sortedKeys(g.imports) {
+		fmt.Fprintf(&head, "\t%q\n", imp)
+	}
+	head.WriteString(")\n\n")
+	head.Write(g.body.Bytes())
+
+	src, err := format.Source(head.Bytes())
+	if err != nil {
+		return head.Bytes(), fmt.Errorf("format generated code: %w", err)
+	}
+	return src, nil
+}
+
+func (g *generator) definition(name string, s apispec.Schema) {
+	typ := typeName(name)
+	comment(&g.body, s.Description)
+	if len(s.Properties) == 0 {
+		g.p("type %s %s\n", typ, g.goType(s))
+		return
+	}
+
+	g.p("type %s struct {", typ)
+	used := map[string]bool{}
+	for _, prop := range sortedKeys(s.Properties) {
+		field := s.Properties[prop]
+		name := exported(prop)
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+
+		typ := g.goType(field)
+		if field.Nullable && !strings.HasPrefix(typ, "[]") && !strings.HasPrefix(typ, "map[") {
+			typ = "*" + typ
+		}
+		if field.Description != "" {
+			g.p("\t%s %s `json:\"%s\"` // %s", name, typ, prop, oneLine(field.Description))
+		} else {
+			g.p("\t%s %s `json:\"%s\"`", name, typ, prop)
+		}
+	}
+	g.p("}\n")
+}
+
+func (g *generator) goType(s apispec.Schema) string {
+	switch {
+	case s.Ref != "":
+		return typeName(s.RefName())
+	case len(s.AllOf) == 1:
+		return g.goType(s.AllOf[0])
+	}
+	switch s.Type {
+	case "array":
+		if s.Items == nil {
+			break
+		}
+		return "[]" + g.goType(*s.Items)
+	case "object":
+		if v := s.Values(); v != nil {
+			return "map[string]" + g.goType(*v)
+		}
+	case "string":
+		if s.Format == "date-time" {
+			g.imports["time"] = true
+			return "time.Time"
+		}
+		return "string"
+	case "integer":
+		if s.Format == "int64" {
+			return "int64"
+		}
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "file":
+		return "[]byte"
+	}
+	g.imports["encoding/json"] = true
+	return "json.RawMessage"
+}
+
+type operation struct {
+	method, path string
+	apispec.Operation
+}
+
+func (g *generator) operations() []operation {
+	var ops []operation
+	for path, methods := range g.spec.Paths {
+		for method, op := range methods {
+			ops = append(ops, operation{strings.ToUpper(method), path, op})
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].OperationID < ops[j].OperationID })
+	return ops
+}
+
+var pathParam = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+func (g *generator) operation(op operation) {
+	if op.OperationID == "" {
+		log.Fatalf("%s %s has no operationId", op.method, op.path)
+	}
+	name := exported(op.OperationID)
+	g.imports["context"] = true
+
+	params := []string{"ctx context.Context"}
+	byName := map[string]apispec.Parameter{}
+	var body *apispec.Parameter
+	var query []apispec.Parameter
+	for i, p := range op.Parameters {
+		switch p.In {
+		case "path":
+			byName[p.Name] = p
+		case "body":
+			body = &op.Parameters[i]
+		case "query":
+			query = append(query, p)
+		}
+	}
+
+	// Path parameters come in the order they appear in the path.
+	pathExpr := fmt.Sprintf("%q", op.path)
+	if names := pathParam.FindAllStringSubmatch(op.path, -1); len(names) > 0 {
+		g.imports["fmt"] = true
+		format := op.path
+		var args []string
+		for _, m := range names {
+			p := byName[m[1]]
+			arg := unexported(m[1])
+			if p.Type == "integer" {
+				params = append(params, arg+" int")
+				format = strings.Replace(format, m[0], "%d", 1)
+				args = append(args, arg)
+			} else {
+				g.imports["net/url"] = true
+				params = append(params, arg+" string")
+				format = strings.Replace(format, m[0], "%s", 1)
+				args = append(args, "url.PathEscape("+arg+")")
+			}
+		}
+		pathExpr = fmt.Sprintf("fmt.Sprintf(%q, %s)", format, strings.Join(args, ", "))
+	}
+
+	bodyArg := "nil"
+	if body != nil && body.Schema != nil {
+		typ := g.goType(*body.Schema)
+		if body.Schema.Ref != "" {
+			typ = "*" + typ
+		}
+		params = append(params, "body "+typ)
+		bodyArg = "body"
+	}
+
+	queryArg := "nil"
+	if len(query) > 0 {
+		g.imports["net/url"] = true
+		g.queryParams(name, query)
+		params = append(params, "params *"+name+"Params")
+		queryArg = "params.values()"
+	}
+
+	result, raw := g.result(op)
+
+	comment(&g.body, fmt.Sprintf("%s calls %s %s: %s", name, op.method, op.path, oneLine(op.Summary)))
+	switch {
+	case result == "":
+		g.p("func (c *Client) %s(%s) error {", name, strings.Join(params, ", "))
+		g.p("\treturn c.do(ctx, %q, %s, %s, %s, nil)", op.method, pathExpr, queryArg, bodyArg)
+	case raw:
+		g.p("func (c *Client) %s(%s) (%s, error) {", name, strings.Join(params, ", "), result)
+		g.p("\treturn c.Raw(ctx, %q, %s, %s, %s)", op.method, pathExpr, queryArg, bodyArg)
+	case strings.HasPrefix(result, "*"):
+		g.p("func (c *Client) %s(%s) (%s, error) {", name, strings.Join(params, ", "), result)
+		g.p("\tvar out %s", result[1:])
+		g.p("\tif err := c.do(ctx, %q, %s, %s, %s, &out); err != nil {", op.method, pathExpr, queryArg, bodyArg)
+		g.p("\t\treturn nil, err")
+		g.p("\t}")
+		g.p("\treturn &out, nil")
+	default:
+		g.p("func (c *Client) %s(%s) (%s, error) {", name, strings.Join(params, ", "), result)
+		g.p("\tvar out %s", result)
+		g.p("\terr := c.do(ctx, %q, %s, %s, %s, &out)", op.method, pathExpr, queryArg, bodyArg)
+		g.p("\treturn out, err")
+	}
+	g.p("}\n")
+}
+
+// result is the Go type of the first success response with a body, and
+// whether it is a file returned as raw bytes.
+func (g *generator) result(op operation) (string, bool) {
+	for _, code := range sortedKeys(op.Responses) {
+		resp := op.Responses[code]
+		if !strings.HasPrefix(code, "2") || resp.Schema == nil {
+			continue
+		}
+		if resp.Schema.Type == "file" {
+			return "[]byte", true
+		}
+		typ := g.goType(*resp.Schema)
+		if resp.Schema.Ref != "" {
+			typ = "*" + typ
+		}
+		return typ, false
+	}
+	return "", false
+}
+
+func (g *generator) queryParams(name string, query []apispec.Parameter) {
+	g.p("// %sParams are the query parameters of %s. Zero values are left out.", name, name)
+	g.p("type %sParams struct {", name)
+	for _, p := range query {
+		typ := g.goType(apispec.Schema{Type: p.Type})
+		if p.Description != "" {
+			g.p("\t%s %s // %s", exported(p.Name), typ, oneLine(p.Description))
+		} else {
+			g.p("\t%s %s", exported(p.Name), typ)
+		}
+	}
+	g.p("}\n")
+
+	g.imports["fmt"] = true
+	g.p("func (p *%sParams) values() url.Values {", name)
+	g.p("\tif p == nil {")
+	g.p("\t\treturn nil")
+	g.p("\t}")
+	g.p("\tq := url.Values{}")
+	for _, p := range query {
+		field := "p." + exported(p.Name)
+		zero := `""`
+		switch p.Type {
+		case "integer", "number":
+			zero = "0"
+		case "boolean":
+			zero = "false"
+		}
+		g.p("\tif %s != %s {", field, zero)
+		g.p("\t\tq.Set(%q, fmt.Sprint(%s))", p.Name, field)
+		g.p("\t}")
+	}
+	g.p("\treturn q")
+	g.p("}\n")
+}
+
+// typeName turns a definition such as models.GameDetailResponse into the
+// client's type name.
+func typeName(def string) string {
+	if i := strings.LastIndex(def, "."); i >= 0 {
+		def = def[i+1:]
+	}
+	return exported(def)
+}
+
+var initialisms = map[string]string{"id": "ID", "url": "URL", "vp": "VP", "csv": "CSV", "api": "API"}
+
+// exported turns a JSON or parameter name into an exported Go name:
+// game_id becomes GameID and custodiansPlayerId CustodiansPlayerID.
+func exported(s string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }) {
+		if up, ok := initialisms[strings.ToLower(part)]; ok {
+			b.WriteString(up)
+			continue
+		}
+		if strings.HasSuffix(part, "Id") {
+			part = strings.TrimSuffix(part, "Id") + "ID"
+		}
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+func unexported(s string) string {
+	name := exported(s)
+	for prefix, up := range initialisms {
+		if strings.HasPrefix(name, up) {
+			return prefix + name[len(up):]
+		}
+	}
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func comment(w *bytes.Buffer, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(w, "// %s\n", strings.TrimSpace(line))
+	}
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/client"
+)
+
+// clientID is sent as X-Client-ID so the audit log shows changes made from
+// the terminal.
+const clientID = "ti4ctl"
+
+func newClient(base string) *client.Client {
+	c := client.New(base)
+	c.ClientID = clientID
+	return c
+}
+
+// findPlayer matches name against the players of a game by player ID or
+// case-insensitive name.
+func findPlayer(players []client.GamePlayer, name string) (client.GamePlayer, error) {
+	name = strings.TrimSpace(name)
+	if id, err := strconv.Atoi(name); err == nil {
+		for _, gp := range players {
+			if gp.PlayerID == id {
+				return gp, nil
+			}
+		}
+	}
+	for _, gp := range players {
+		if strings.EqualFold(gp.Player.Name, name) {
+			return gp, nil
+		}
+	}
+	names := make([]string, len(players))
+	for i, gp := range players {
+		names[i] = gp.Player.Name
+	}
+	return client.GamePlayer{}, fmt.Errorf("no player %q in this game (players: %s)", name, strings.Join(names, ", "))
+}
+
+// objectives returns every public and secret objective.
+func objectives(ctx context.Context, c *client.Client) ([]client.Objective, error) {
+	public, err := c.GetAllPublicObjectives(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := c.GetAllSecretObjectives(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(public, secret...), nil
+}
+
+// findObjective matches name exactly, ignoring case, or else as the only
+// objective whose name contains it.
+func findObjective(all []client.Objective, name string) (client.Objective, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	var matches []client.Objective
+	for _, o := range all {
+		lower := strings.ToLower(o.Name)
+		if lower == name {
+			return o, nil
+		}
+		if strings.Contains(lower, name) {
+			matches = append(matches, o)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return client.Objective{}, fmt.Errorf("no objective matches %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		var names []string
+		for _, o := range matches[:min(len(matches), 5)] {
+			names = append(names, o.Name)
+		}
+		if len(matches) > 5 {
+			names = append(names, "...")
+		}
+		return client.Objective{}, fmt.Errorf("%q matches %d objectives: %s", name, len(matches), strings.Join(names, ", "))
+	}
+}
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/client"
+)
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return usageError(fmt.Sprintf("%s: %v", fs.Name(), err))
+	}
+	return nil
+}
+
+// optionalArg returns the single optional positional argument.
+func optionalArg(cmd string, args []string) (string, error) {
+	switch len(args) {
+	case 0:
+		return "", nil
+	case 1:
+		return args[0], nil
+	default:
+		return "", usageError(fmt.Sprintf("%s takes at most one argument", cmd))
+	}
+}
+
+// parsePlayers reads name:faction pairs separated by commas.
+func parsePlayers(s string) ([]client.PlayerInput, error) {
+	var players []client.PlayerInput
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, faction, ok := strings.Cut(pair, ":")
+		name, faction = strings.TrimSpace(name), strings.TrimSpace(faction)
+		if !ok || name == "" || faction == "" {
+			return nil, usageError(fmt.Sprintf("player %q must be name:faction", pair))
+		}
+		players = append(players, client.PlayerInput{Name: name, Faction: faction})
+	}
+	if len(players) < 3 {
+		return nil, usageError("-players needs at least 3 name:faction pairs")
+	}
+	return players, nil
+}
+
+// parseSeats reads template seats: names separated by commas, each with
+// an optional :faction.
+func parseSeats(s string) []client.GameTemplatePlayer {
+	var seats []client.GameTemplatePlayer
+	for _, pair := range strings.Split(s, ",") {
+		name, faction, _ := strings.Cut(pair, ":")
+		if name = strings.TrimSpace(name); name != "" {
+			seats = append(seats, client.GameTemplatePlayer{Name: name, Faction: strings.TrimSpace(faction)})
+		}
+	}
+	return seats
+}
+
+func gameNew(ctx context.Context, c *client.Client, out *printer, args []string) error {
+	fs := newFlagSet("game new")
+	players := fs.String("players", "", "comma separated name:faction pairs")
+	points := fs.Int("points", 0, "points to win, 10 or 14 (default: server setting)")
+	title := fs.String("title", "", "game title")
+	location := fs.String("location", "", "where the game is played")
+	template := fs.Int("template", 0, "create the game from this template; -players then replaces its seats and may leave out factions")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var (
+		resp *client.CreateGameResponse
+		err  error
+	)
+	if *template > 0 {
+		if *points != 0 {
+			return usageError("-points comes from the template")
+		}
+		req := client.GameFromTemplateRequest{Players: parseSeats(*players), Title: *title, Location: *location}
+		resp, err = c.CreateGameFromTemplate(ctx, *template, &req)
+	} else {
+		input := client.CreateGameInput{WinningPoints: *points, Title: *title, Location: *location}
+		if input.Players, err = parsePlayers(*players); err != nil {
+			return err
+		}
+		resp, err = c.CreateGame(ctx, &input)
+	}
+	if err != nil {
+		return err
+	}
+	if err := saveCurrentGame(resp.Game.ID); err != nil {
+		return fmt.Errorf("game %d created but not saved as the current game: %w", resp.Game.ID, err)
+	}
+	if out.raw(resp) {
+		return nil
+	}
+
+	out.line("Created game %d (game #%d, %d points). It is now the current game.", resp.Game.ID, resp.Game.GameNumber, resp.Game.WinningPoints)
+	game, err := c.GetGameByID(ctx, resp.Game.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+	printPlayers(out, game)
+	if len(resp.Revealed) > 0 {
+		fmt.Println()
+		rows := make([][]string, len(resp.Revealed))
+		for i, o := range resp.Revealed {
+			rows[i] = []string{o.Objective.Stage, o.Objective.Name}
+		}
+		out.table([]string{"STAGE", "REVEALED"}, rows)
+	}
+	return nil
+}
+
+func gameUse(ctx context.Context, c *client.Client, out *printer, args []string) error {
+	if len(args) != 1 {
+		return usageError("game use takes a game ID")
+	}
+	id, err := gameID(globals{}, args[0])
+	if err != nil {
+		return err
+	}
+	game, err := c.GetGameByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := saveCurrentGame(id); err != nil {
+		return err
+	}
+	out.line("Game %d (game #%d) is now the current game.", game.ID, game.GameNumber)
+	return nil
+}
+
+func gameShow(ctx context.Context, c *client.Client, out *printer, g globals, args []string) error {
+	arg, err := optionalArg("game show", args)
+	if err != nil {
+		return err
+	}
+	id, err := gameID(g, arg)
+	if err != nil {
+		return err
+	}
+	game, err := c.GetGameByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if out.raw(game) {
+		return nil
+	}
+
+	heading := fmt.Sprintf("Game %d (#%d)", game.ID, game.GameNumber)
+	if game.Title != "" {
+		heading += " " + strconv.Quote(game.Title)
+	}
+	out.line("%s", heading)
+	status := fmt.Sprintf("round %d", game.CurrentRound)
+	if game.FinishedAt != nil {
+		status = "finished " + game.FinishedAt.Local().Format("2006-01-02 15:04")
+		if game.Winner != nil && game.Winner.Name != "" {
+			status += ", won by " + game.Winner.Name
+		}
+	}
+	out.line("%s, playing to %d points, speaker %s", status, game.WinningPoints, orDash(game.SpeakerName))
+	fmt.Println()
+	printPlayers(out, game)
+
+	names := map[int]string{}
+	for _, gp := range game.Players {
+		names[gp.PlayerID] = gp.Player.Name
+	}
+	var rows [][]string
+	for _, o := range game.Objectives {
+		if !o.Revealed {
+			continue
+		}
+		var scoredBy []string
+		for _, s := range game.ScoresByObjective[strconv.Itoa(o.ObjectiveID)] {
+			scoredBy = append(scoredBy, names[s.PlayerID])
+		}
+		sort.Strings(scoredBy)
+		rows = append(rows, []string{o.Objective.Stage, o.Objective.Name, orDash(strings.Join(scoredBy, ", "))})
+	}
+	if len(rows) > 0 {
+		fmt.Println()
+		out.table([]string{"STAGE", "OBJECTIVE", "SCORED BY"}, rows)
+	}
+	return nil
+}
+
+// printPlayers prints the players of a game with their points, highest
+// first.
+func printPlayers(out *printer, game *client.GameDetailResponse) {
+	points := map[int]int{}
+	for _, s := range game.Scores {
+		points[s.PlayerID] = s.Points
+	}
+	players := append([]client.GamePlayer(nil), game.Players...)
+	sort.SliceStable(players, func(i, j int) bool {
+		return points[players[i].PlayerID] > points[players[j].PlayerID]
+	})
+	rows := make([][]string, len(players))
+	for i, gp := range players {
+		note := ""
+		switch {
+		case gp.Won:
+			note = "winner"
+		case gp.Eliminated:
+			note = "eliminated"
+		}
+		rows[i] = []string{strconv.Itoa(gp.PlayerID), gp.Player.Name, gp.Faction, strconv.Itoa(points[gp.PlayerID]), note}
+	}
+	out.table([]string{"ID", "PLAYER", "FACTION", "VP", "STATUS"}, rows)
+}
+
+func gameList(ctx context.Context, c *client.Client, out *printer, args []string) error {
+	fs := newFlagSet("game list")
+	n := fs.Int("n", 10, "number of games")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	games, err := c.ListGames(ctx, &client.ListGamesParams{Sort: "-id", PageSize: *n})
+	if err != nil {
+		return err
+	}
+	if out.raw(games) {
+		return nil
+	}
+	rows := make([][]string, len(games))
+	for i, g := range games {
+		finished := "-"
+		if g.FinishedAt != nil {
+			finished = g.FinishedAt.Local().Format("2006-01-02")
+		}
+		rows[i] = []string{
+			strconv.Itoa(g.ID), strconv.Itoa(g.GameNumber), orDash(g.Title),
+			strconv.Itoa(g.CurrentRound), finished, orDash(g.Winner.Name),
+		}
+	}
+	out.table([]string{"ID", "NUMBER", "TITLE", "ROUND", "FINISHED", "WINNER"}, rows)
+	return nil
+}
+
+func score(ctx context.Context, c *client.Client, out *printer, g globals, args []string) error {
+	fs := newFlagSet("score")
+	imperial := fs.Bool("imperial", false, "scored with the Imperial strategy card")
+	reason := fs.String("override", "", "score another public objective this round, giving the reason")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	args = fs.Args()
+	var gameArg, player, objective string
+	switch len(args) {
+	case 2:
+		player, objective = args[0], args[1]
+	case 3:
+		gameArg, player, objective = args[0], args[1], args[2]
+	default:
+		return usageError(`score takes [game] <player> "<objective>"`)
+	}
+	id, err := gameID(g, gameArg)
+	if err != nil {
+		return err
+	}
+	game, err := c.GetGameByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	gp, err := findPlayer(game.Players, player)
+	if err != nil {
+		return err
+	}
+	all, err := objectives(ctx, c)
+	if err != nil {
+		return err
+	}
+	obj, err := findObjective(all, objective)
+	if err != nil {
+		return err
+	}
+
+	req := client.ScoreRequest{PlayerID: gp.PlayerID, ObjectiveID: obj.ID}
+	switch {
+	case *imperial:
+		req.Override = "imperial"
+	case *reason != "":
+		req.Override, req.OverrideReason = "other", *reason
+	}
+	resp, err := c.AddScore(ctx, id, &req)
+	if err != nil {
+		return err
+	}
+	if out.raw(resp) {
+		return nil
+	}
+	out.line("%s scored %s in round %d and is on %d VP.", gp.Player.Name, obj.Name, resp.Round, resp.TotalPoints)
+	return nil
+}
+
+func roundAdvance(ctx context.Context, c *client.Client, out *printer, g globals, args []string) error {
+	arg, err := optionalArg("round advance", args)
+	if err != nil {
+		return err
+	}
+	id, err := gameID(g, arg)
+	if err != nil {
+		return err
+	}
+	resp, err := c.AdvanceRound(ctx, id)
+	if err != nil {
+		return err
+	}
+	if out.raw(resp) {
+		return nil
+	}
+	if resp.CurrentRound == 0 {
+		out.line("Game %d ended after round %d.", id, resp.Round)
+		return nil
+	}
+	msg := fmt.Sprintf("Game %d is in round %d.", id, resp.CurrentRound)
+	if resp.Revealed != "" {
+		msg += fmt.Sprintf(" A stage %s objective was revealed.", resp.Revealed)
+	}
+	out.line("%s", msg)
+	return nil
+}
+
+func speakerSet(ctx context.Context, c *client.Client, out *printer, g globals, args []string) error {
+	if len(args) != 1 {
+		return usageError("speaker set takes a player")
+	}
+	id, err := gameID(g, "")
+	if err != nil {
+		return err
+	}
+	game, err := c.GetGameByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	gp, err := findPlayer(game.Players, args[0])
+	if err != nil {
+		return err
+	}
+	// The speaker endpoint takes the game player row and the round number.
+	resp, err := c.PostAssignSpeaker(ctx, id, &client.AssignSpeakerRequest{PlayerID: gp.ID, RoundID: game.CurrentRound})
+	if err != nil {
+		return err
+	}
+	if out.raw(resp) {
+		return nil
+	}
+	out.line("%s is the speaker in round %d.", gp.Player.Name, game.CurrentRound)
+	return nil
+}
+
+func relicShard(ctx context.Context, c *client.Client, out *printer, g globals, args []string) error {
+	if len(args) != 1 {
+		return usageError("relic shard takes a player")
+	}
+	id, err := gameID(g, "")
+	if err != nil {
+		return err
+	}
+	game, err := c.GetGameByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	gp, err := findPlayer(game.Players, args[0])
+	if err != nil {
+		return err
+	}
+	resp, err := c.HandleShardRelic(ctx, id, &client.ShardRequest{NewHolderID: gp.PlayerID})
+	if err != nil {
+		return err
+	}
+	if out.raw(resp) {
+		return nil
+	}
+	out.line("%s holds the Shard of the Throne.", gp.Player.Name)
+	return nil
+}
+
+func statsOverview(ctx context.Context, c *client.Client, out *printer) error {
+	overview, err := c.GetStatsOverview(ctx)
+	if err != nil {
+		return err
+	}
+	if out.raw(overview) {
+		return nil
+	}
+
+	out.table([]string{"GAMES", "PLAYERS", "AVG ROUNDS", "AVG POINTS", "MOST PLAYED", "MOST WINS"}, [][]string{{
+		strconv.Itoa(overview.TotalGames),
+		strconv.Itoa(overview.TotalUniquePlayers),
+		fmt.Sprintf("%.1f", overview.AverageGameRounds),
+		fmt.Sprintf("%.1f", overview.AveragePlayerPoints),
+		orDash(overview.MostPlayedFaction),
+		orDash(overview.MostVictoriousFaction),
+	}})
+
+	players := overview.PlayerWinRates
+	sort.SliceStable(players, func(i, j int) bool {
+		if players[i].WinRate != players[j].WinRate {
+			return players[i].WinRate > players[j].WinRate
+		}
+		return players[i].Player < players[j].Player
+	})
+	rows := make([][]string, len(players))
+	for i, p := range players {
+		rows[i] = []string{p.Player, strconv.Itoa(p.GamesPlayed), strconv.Itoa(p.GamesWon), pct(p.WinRate)}
+	}
+	fmt.Println()
+	out.table([]string{"PLAYER", "PLAYED", "WON", "WIN RATE"}, rows)
+
+	factions := make([]string, 0, len(overview.FactionPlayWinDistribution))
+	for f := range overview.FactionPlayWinDistribution {
+		factions = append(factions, f)
+	}
+	sort.Slice(factions, func(i, j int) bool {
+		a, b := overview.FactionPlayWinDistribution[factions[i]], overview.FactionPlayWinDistribution[factions[j]]
+		if a.PlayedCount != b.PlayedCount {
+			return a.PlayedCount > b.PlayedCount
+		}
+		return factions[i] < factions[j]
+	})
+	rows = make([][]string, len(factions))
+	for i, f := range factions {
+		s := overview.FactionPlayWinDistribution[f]
+		winRate := 0.0
+		if s.PlayedCount > 0 {
+			winRate = float64(s.WinCount) / float64(s.PlayedCount) * 100
+		}
+		rows[i] = []string{f, strconv.Itoa(s.PlayedCount), strconv.Itoa(s.WinCount), pct(winRate)}
+	}
+	fmt.Println()
+	out.table([]string{"FACTION", "PLAYED", "WON", "WIN RATE"}, rows)
+	return nil
+}
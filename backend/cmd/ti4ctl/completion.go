@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/arphillips06/TI4-stats/client"
+)
+
+// Completion scripts call "ti4ctl __complete players|objectives" for the
+// names in the current game, so they stay in step with the server.
+const bashCompletion = `# ti4ctl bash completion. Load with: source <(ti4ctl completion bash)
+_ti4ctl() {
+    local cur prev words cword
+    _init_completion 2>/dev/null || {
+        cur=${COMP_WORDS[COMP_CWORD]}; prev=${COMP_WORDS[COMP_CWORD-1]}
+        words=("${COMP_WORDS[@]}"); cword=$COMP_CWORD
+    }
+    local i cmd="" sub="" n=0
+    for ((i = 1; i < cword; i++)); do
+        case ${words[i]} in
+            -server|-game) ((i++)) ;;
+            -*) ;;
+            *) if [[ -z $cmd ]]; then cmd=${words[i]}; else [[ -z $sub ]] && sub=${words[i]}; ((n++)); fi ;;
+        esac
+    done
+    local IFS=$'\n'
+    if [[ -z $cmd ]]; then
+        COMPREPLY=($(compgen -W $'game\nscore\nround\nspeaker\nrelic\nstats\ncompletion' -- "$cur"))
+        return
+    fi
+    case $cmd in
+        game)       ((n == 0)) && COMPREPLY=($(compgen -W $'new\nshow\nlist\nuse' -- "$cur")) ;;
+        round)      ((n == 0)) && COMPREPLY=($(compgen -W 'advance' -- "$cur")) ;;
+        stats)      ((n == 0)) && COMPREPLY=($(compgen -W 'overview' -- "$cur")) ;;
+        completion) ((n == 0)) && COMPREPLY=($(compgen -W $'bash\nzsh\nfish' -- "$cur")) ;;
+        speaker)    if ((n == 0)); then COMPREPLY=($(compgen -W 'set' -- "$cur"));
+                    elif ((n == 1)); then COMPREPLY=($(compgen -W "$(ti4ctl __complete players 2>/dev/null)" -- "$cur")); fi ;;
+        relic)      if ((n == 0)); then COMPREPLY=($(compgen -W 'shard' -- "$cur"));
+                    elif ((n == 1)); then COMPREPLY=($(compgen -W "$(ti4ctl __complete players 2>/dev/null)" -- "$cur")); fi ;;
+        score)      if ((n == 0)); then COMPREPLY=($(compgen -W "$(ti4ctl __complete players 2>/dev/null)" -- "$cur"));
+                    else COMPREPLY=($(compgen -W "$(ti4ctl __complete objectives 2>/dev/null)" -- "$cur"));
+                         COMPREPLY=("${COMPREPLY[@]// /\\ }"); fi ;;
+    esac
+}
+complete -F _ti4ctl ti4ctl
+`
+
+const zshCompletion = `#compdef ti4ctl
+# ti4ctl zsh completion. Load with: source <(ti4ctl completion zsh)
+autoload -U +X bashcompinit && bashcompinit
+` + bashCompletion
+
+const fishCompletion = `# ti4ctl fish completion. Load with: ti4ctl completion fish | source
+set -l commands game score round speaker relic stats completion
+complete -c ti4ctl -f
+complete -c ti4ctl -o server -r -d 'API base URL'
+complete -c ti4ctl -o game -r -d 'Game ID'
+complete -c ti4ctl -o json -d 'Print the API response as JSON'
+complete -c ti4ctl -n "not __fish_seen_subcommand_from $commands" -a "$commands"
+complete -c ti4ctl -n '__fish_seen_subcommand_from game; and not __fish_seen_subcommand_from new show list use' -a 'new show list use'
+complete -c ti4ctl -n '__fish_seen_subcommand_from round; and not __fish_seen_subcommand_from advance' -a advance
+complete -c ti4ctl -n '__fish_seen_subcommand_from stats; and not __fish_seen_subcommand_from overview' -a overview
+complete -c ti4ctl -n '__fish_seen_subcommand_from completion' -a 'bash zsh fish'
+complete -c ti4ctl -n '__fish_seen_subcommand_from speaker; and not __fish_seen_subcommand_from set' -a set
+complete -c ti4ctl -n '__fish_seen_subcommand_from relic; and not __fish_seen_subcommand_from shard' -a shard
+complete -c ti4ctl -n '__fish_seen_subcommand_from set shard score' -a '(ti4ctl __complete players 2>/dev/null)'
+complete -c ti4ctl -n '__fish_seen_subcommand_from score' -a '(ti4ctl __complete objectives 2>/dev/null)'
+`
+
+func completion(args []string) error {
+	if len(args) != 1 {
+		return usageError("completion takes bash, zsh or fish")
+	}
+	switch args[0] {
+	case "bash":
+		fmt.Print(bashCompletion)
+	case "zsh":
+		fmt.Print(zshCompletion)
+	case "fish":
+		fmt.Print(fishCompletion)
+	default:
+		return usageError(fmt.Sprintf("no completion for shell %q", args[0]))
+	}
+	return nil
+}
+
+// complete prints one candidate per line for the completion scripts.
+func complete(ctx context.Context, c *client.Client, g globals, args []string) error {
+	if len(args) != 1 {
+		return nil
+	}
+	var names []string
+	switch args[0] {
+	case "players":
+		id, err := gameID(g, "")
+		if err != nil {
+			return err
+		}
+		game, err := c.GetGameByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, gp := range game.Players {
+			names = append(names, gp.Player.Name)
+		}
+	case "objectives":
+		all, err := objectives(ctx, c)
+		if err != nil {
+			return err
+		}
+		for _, o := range all {
+			names = append(names, o.Name)
+		}
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		fmt.Println(n)
+	}
+	return nil
+}
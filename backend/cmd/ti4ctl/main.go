@@ -0,0 +1,173 @@
+// Command ti4ctl records and inspects TI4 games through the REST API.
+//
+//	ti4ctl game new -players alice:Hacan,bob:Sol
+//	ti4ctl score alice "Corner the Market"
+//	ti4ctl round advance
+//
+// The server defaults to http://127.0.0.1:8080 and can be changed with
+// -server or TI4_SERVER. Commands that act on a game use -game, then
+// TI4_GAME, then the game last created or picked with "game use".
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const usage = `usage: ti4ctl [-server url] [-game id] [-json] <command> [args]
+
+commands:
+  game new -players name:faction,... [-points 10|14] [-title t] [-location l]
+  game new -template id [-players name[:faction],...] [-title t] [-location l]
+                                   create a game and make it the current game
+  game show [game]                 players, points, objectives and speaker
+  game list [-n count]             most recent games
+  game use <game>                  make a game the current game
+  score [-imperial | -override reason] [game] <player> <objective>
+                                   score a public or secret objective; -imperial
+                                   or -override allow a second public one
+  round advance [game]             end the round and reveal the next objective
+  speaker set <player>             make a player the speaker this round
+  relic shard <player>             give Shard of the Throne to a player
+  stats overview                   totals, win rates and faction results
+  completion bash|zsh|fish         print a shell completion script
+
+Players are matched by name (case-insensitive) or player ID, objectives by
+name or any unique part of it.
+`
+
+// globals are the flags given before the command.
+type globals struct {
+	server string
+	game   string
+	json   bool
+}
+
+func main() {
+	fs := flag.NewFlagSet("ti4ctl", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	var g globals
+	fs.StringVar(&g.server, "server", envOr("TI4_SERVER", "http://127.0.0.1:8080"), "API base URL")
+	fs.StringVar(&g.game, "game", os.Getenv("TI4_GAME"), "game ID (default: the current game)")
+	fs.BoolVar(&g.json, "json", false, "print the API response as JSON")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		os.Exit(2)
+	}
+
+	err := run(g, fs.Args())
+	var ue usageError
+	switch {
+	case errors.As(err, &ue):
+		fmt.Fprintf(os.Stderr, "ti4ctl: %s\n\n%s", ue, usage)
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "ti4ctl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// usageError is a command line mistake; usage is printed after it.
+type usageError string
+
+func (e usageError) Error() string { return string(e) }
+
+func run(g globals, args []string) error {
+	if len(args) == 0 {
+		return usageError("no command given")
+	}
+	ctx := context.Background()
+	c := newClient(g.server)
+	out := newPrinter(g.json)
+
+	cmd, rest := args[0], args[1:]
+	sub := ""
+	if len(rest) > 0 {
+		sub = rest[0]
+	}
+	switch {
+	case cmd == "game" && sub == "new":
+		return gameNew(ctx, c, out, rest[1:])
+	case cmd == "game" && sub == "show":
+		return gameShow(ctx, c, out, g, rest[1:])
+	case cmd == "game" && sub == "list":
+		return gameList(ctx, c, out, rest[1:])
+	case cmd == "game" && sub == "use":
+		return gameUse(ctx, c, out, rest[1:])
+	case cmd == "score":
+		return score(ctx, c, out, g, rest)
+	case cmd == "round" && sub == "advance":
+		return roundAdvance(ctx, c, out, g, rest[1:])
+	case cmd == "speaker" && sub == "set":
+		return speakerSet(ctx, c, out, g, rest[1:])
+	case cmd == "relic" && sub == "shard":
+		return relicShard(ctx, c, out, g, rest[1:])
+	case cmd == "stats" && sub == "overview":
+		return statsOverview(ctx, c, out)
+	case cmd == "completion":
+		return completion(rest)
+	case cmd == "__complete":
+		return complete(ctx, c, g, rest)
+	case cmd == "help" || cmd == "-h":
+		fmt.Print(usage)
+		return nil
+	default:
+		return usageError(fmt.Sprintf("unknown command %q", strings.TrimSpace(cmd+" "+sub)))
+	}
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// stateFile holds the ID of the current game.
+func stateFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "ti4ctl", "current-game"), nil
+}
+
+func saveCurrentGame(id int) error {
+	path, err := stateFile()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(strconv.Itoa(id)+"\n"), 0o644)
+}
+
+// gameID returns the game to act on: arg when given, then -game or
+// TI4_GAME, then the saved current game.
+func gameID(g globals, arg string) (int, error) {
+	raw := arg
+	if raw == "" {
+		raw = g.game
+	}
+	if raw == "" {
+		if path, err := stateFile(); err == nil {
+			if b, err := os.ReadFile(path); err == nil {
+				raw = strings.TrimSpace(string(b))
+			}
+		}
+	}
+	if raw == "" {
+		return 0, errors.New("no game selected: pass -game, set TI4_GAME or run ti4ctl game use <game>")
+	}
+	id, err := strconv.Atoi(raw)
+	if err != nil || id <= 0 {
+		return 0, usageError(fmt.Sprintf("invalid game ID %q", raw))
+	}
+	return id, nil
+}
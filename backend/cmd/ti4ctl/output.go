@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// printer writes either tables for people or the raw API response for
+// scripts.
+type printer struct {
+	json bool
+}
+
+func newPrinter(asJSON bool) *printer { return &printer{json: asJSON} }
+
+// raw prints an API response as indented JSON. It reports false when
+// output is not JSON, so the caller prints its table instead.
+func (p *printer) raw(v any) bool {
+	if !p.json {
+		return false
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		fmt.Fprintf(os.Stderr, "ti4ctl: %v\n", err)
+	}
+	return true
+}
+
+// table prints rows under header with aligned columns.
+func (p *printer) table(header []string, rows [][]string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	w.Flush()
+}
+
+func (p *printer) line(format string, args ...any) {
+	fmt.Printf(format+"\n", args...)
+}
+
+func pct(v float64) string { return fmt.Sprintf("%.0f%%", v) }
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/arphillips06/TI4-stats/apispec"
+	"github.com/arphillips06/TI4-stats/config"
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/docs"
+	"github.com/gin-gonic/gin"
+)
+
+const usage = `usage: ti4stats [-config file] [command]
+
+Without a command the server is started.
+
+commands:
+  config show [-format yaml|toml|json]   print the configuration in effect
+  backup                                 back up the SQLite database into backup.dir
+  restore <file>                         replace the SQLite database with a backup;
+                                         stop the server first
+  spec check                             compare the OpenAPI spec with the router;
+                                         run after swag init
+`
+
+// runCommand runs a subcommand and returns the process exit code. loadErr
+// is the error from loading the configuration, which commands that do not
+// need a valid configuration may report rather than fail on.
+func runCommand(args []string, cfg config.Config, loadErr error) int {
+	switch {
+	case len(args) >= 2 && args[0] == "config" && args[1] == "show":
+		return configShow(args[2:], cfg, loadErr)
+	case len(args) == 1 && args[0] == "backup":
+		return backup(cfg, loadErr)
+	case len(args) == 2 && args[0] == "restore":
+		return restore(args[1], cfg, loadErr)
+	case len(args) == 2 && args[0] == "spec" && args[1] == "check":
+		return specCheck()
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+}
+
+// configShow prints the configuration, then any validation errors.
+func configShow(args []string, cfg config.Config, loadErr error) int {
+	fs := flag.NewFlagSet("config show", flag.ContinueOnError)
+	format := fs.String("format", "yaml", "output format: yaml, toml or json")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if cfg.Source != "" {
+		fmt.Printf("# loaded from %s\n", cfg.Source)
+	}
+	if err := cfg.Write(os.Stdout, *format); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if loadErr != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", loadErr)
+		return 1
+	}
+	return 0
+}
+
+// backup writes a backup of the database and rotates old ones.
+func backup(cfg config.Config, loadErr error) int {
+	if loadErr != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", loadErr)
+		return 1
+	}
+	if cfg.Database.Driver != "sqlite" {
+		fmt.Fprintln(os.Stderr, database.ErrBackupUnsupported)
+		return 1
+	}
+	database.InitDatabase(cfg.Database.Driver, cfg.Database.DSN, cfg.Log.GormLogLevel())
+	b, err := database.Backup(cfg.Backup.Dir, cfg.Backup.Keep)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Printf("backed up to %s (%d bytes)\n", b.File, b.Size)
+	for _, file := range b.Removed {
+		fmt.Printf("removed old backup %s\n", file)
+	}
+	return 0
+}
+
+// restore checks file and swaps it in for the database, keeping a copy of
+// the database it replaces.
+func restore(file string, cfg config.Config, loadErr error) int {
+	if loadErr != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", loadErr)
+		return 1
+	}
+	if cfg.Database.Driver != "sqlite" {
+		fmt.Fprintln(os.Stderr, database.ErrBackupUnsupported)
+		return 1
+	}
+	safety, err := database.Restore(file, cfg.Database.DSN, cfg.Backup.Dir, cfg.Server.ListenAddress)
+	if safety != "" {
+		fmt.Printf("previous database saved to %s\n", safety)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "restore failed: %v\n", err)
+		return 1
+	}
+	fmt.Printf("restored %s from %s\n", database.SQLitePath(cfg.Database.DSN), file)
+	return 0
+}
+
+// specCheck compares the generated spec with the routes of a server that
+// has every feature turned on, so optional routes are checked too.
+func specCheck() int {
+	gin.SetMode(gin.ReleaseMode)
+	spec, problems, err := checkSpec(allFeatures())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "%d problems with the spec\n", len(problems))
+		return 1
+	}
+	ops := 0
+	for _, methods := range spec.Paths {
+		ops += len(methods)
+	}
+	fmt.Printf("spec matches the router: %d operations\n", ops)
+	return 0
+}
+
+// allFeatures returns the default configuration with every feature turned
+// on.
+func allFeatures() config.Config {
+	cfg := config.Default()
+	cfg.Features = config.FeatureConfig{Swagger: true, AuditLog: true, Import: true, Admin: true, TurnTimer: true}
+	return cfg
+}
+
+// checkSpec parses the generated spec and compares it with the routes of
+// newRouter(cfg). Legacy aliases are left out: they share their handler
+// with a documented /api/v1 route.
+func checkSpec(cfg config.Config) (*apispec.Spec, []apispec.Problem, error) {
+	spec, err := apispec.Parse([]byte(docs.SwaggerInfo.ReadDoc()))
+	if err != nil {
+		return nil, nil, err
+	}
+	legacy := legacyRoutes(cfg)
+	var routes gin.RoutesInfo
+	for _, rt := range newRouter(cfg).Routes() {
+		if !legacy[rt.Method+" "+rt.Path] {
+			routes = append(routes, rt)
+		}
+	}
+	return spec, apispec.Check(spec, routes), nil
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSpecMatchesRouter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	spec, problems, err := checkSpec(allFeatures())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range problems {
+		t.Error(p)
+	}
+	if len(spec.Paths) == 0 {
+		t.Error("the spec has no paths")
+	}
+}
@@ -0,0 +1,363 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm/logger"
+)
+
+// Config is the server configuration. Values come from the defaults below,
+// then a YAML or TOML file, then the environment variables named in the env
+// tags; the first variable listed that is set wins.
+type Config struct {
+	Database DatabaseConfig `yaml:"database" toml:"database" json:"database"`
+	Server   ServerConfig   `yaml:"server" toml:"server" json:"server"`
+	Log      LogConfig      `yaml:"log" toml:"log" json:"log"`
+	Features FeatureConfig  `yaml:"features" toml:"features" json:"features"`
+	Defaults GameDefaults   `yaml:"defaults" toml:"defaults" json:"defaults"`
+	Backup   BackupConfig   `yaml:"backup" toml:"backup" json:"backup"`
+
+	// Source is the file the configuration was read from, if any.
+	Source string `yaml:"-" toml:"-" json:"-"`
+}
+
+type DatabaseConfig struct {
+	// Driver is sqlite or postgres. For sqlite the DSN is a file path; for
+	// postgres it is a connection URL or key=value string.
+	Driver string `yaml:"driver" toml:"driver" json:"driver" env:"TI4_DB_DRIVER"`
+	DSN    string `yaml:"dsn" toml:"dsn" json:"dsn" env:"TI4_DB_DSN"`
+}
+
+type ServerConfig struct {
+	ListenAddress string `yaml:"listen_address" toml:"listen_address" json:"listen_address" env:"TI4_LISTEN_ADDRESS,BIND_ADDRESS"`
+	// AllowedOrigins are the CORS origins the API answers. * matches any
+	// run of characters, e.g. http://192.168.1.* or *.ts.net:3000.
+	AllowedOrigins []string `yaml:"allowed_origins" toml:"allowed_origins" json:"allowed_origins" env:"TI4_ALLOWED_ORIGINS"`
+	// StaticDir holds the built frontend: index.html and static/.
+	StaticDir string `yaml:"static_dir" toml:"static_dir" json:"static_dir" env:"TI4_STATIC_DIR"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level" toml:"level" json:"level" env:"TI4_LOG_LEVEL"` // debug, info, warn or error
+}
+
+// FeatureConfig switches optional parts of the API on or off. Admin is off
+// by default because the /admin routes have no authentication.
+type FeatureConfig struct {
+	Swagger   bool `yaml:"swagger" toml:"swagger" json:"swagger" env:"TI4_FEATURE_SWAGGER"`
+	AuditLog  bool `yaml:"audit_log" toml:"audit_log" json:"audit_log" env:"TI4_FEATURE_AUDIT_LOG"`
+	Import    bool `yaml:"import" toml:"import" json:"import" env:"TI4_FEATURE_IMPORT"`
+	Admin     bool `yaml:"admin" toml:"admin" json:"admin" env:"TI4_FEATURE_ADMIN"`
+	TurnTimer bool `yaml:"turn_timer" toml:"turn_timer" json:"turn_timer" env:"TI4_FEATURE_TURN_TIMER"`
+}
+
+// GameDefaults fill in new games that do not set these themselves.
+type GameDefaults struct {
+	WinningPoints     int  `yaml:"winning_points" toml:"winning_points" json:"winning_points" env:"TI4_DEFAULT_WINNING_POINTS"`
+	RandomSpeaker     bool `yaml:"random_speaker" toml:"random_speaker" json:"random_speaker" env:"TI4_DEFAULT_RANDOM_SPEAKER"`
+	UseObjectiveDecks bool `yaml:"use_objective_decks" toml:"use_objective_decks" json:"use_objective_decks" env:"TI4_DEFAULT_USE_OBJECTIVE_DECKS"`
+	TrackPhases       bool `yaml:"track_phases" toml:"track_phases" json:"track_phases" env:"TI4_DEFAULT_TRACK_PHASES"`
+}
+
+// BackupConfig controls SQLite backups. Interval is a Go duration such as
+// 24h; empty or 0 turns scheduled backups off.
+type BackupConfig struct {
+	Dir      string `yaml:"dir" toml:"dir" json:"dir" env:"TI4_BACKUP_DIR"`
+	Keep     int    `yaml:"keep" toml:"keep" json:"keep" env:"TI4_BACKUP_KEEP"` // newest backups kept; older ones are deleted
+	Interval string `yaml:"interval" toml:"interval" json:"interval" env:"TI4_BACKUP_INTERVAL"`
+}
+
+var LogLevels = []string{"debug", "info", "warn", "error"}
+
+var DatabaseDrivers = []string{"sqlite", "postgres"}
+
+// searchPaths are tried in order when no file is named.
+var searchPaths = []string{"config.yaml", "config.yml", "config.toml"}
+
+// Default returns the configuration used when nothing is set.
+func Default() Config {
+	return Config{
+		Database: DatabaseConfig{Driver: "sqlite", DSN: "ti4stats.db"},
+		Server: ServerConfig{
+			ListenAddress: "127.0.0.1:8080",
+			AllowedOrigins: []string{
+				"http://localhost:3000",
+				"http://192.168.1.*",
+				"http://100.*",
+				"*.ts.net:3000",
+			},
+			StaticDir: "./build",
+		},
+		Log: LogConfig{Level: "info"},
+		Features: FeatureConfig{
+			Swagger:   true,
+			AuditLog:  true,
+			Import:    true,
+			Admin:     false,
+			TurnTimer: true,
+		},
+		Defaults: GameDefaults{
+			WinningPoints:     10,
+			UseObjectiveDecks: true,
+		},
+		Backup: BackupConfig{Dir: "backups", Keep: 10},
+	}
+}
+
+var current = Default()
+
+// Current returns the configuration the server is running with.
+func Current() Config { return current }
+
+// Use makes cfg the current configuration.
+func Use(cfg Config) { current = cfg }
+
+// Load reads the configuration from path, or from TI4_CONFIG or the first of
+// searchPaths that exists when path is empty, applies environment overrides
+// and validates the result.
+func Load(path string) (Config, error) {
+	cfg := Default()
+
+	if path == "" {
+		path = os.Getenv("TI4_CONFIG")
+	}
+	if path == "" {
+		for _, p := range searchPaths {
+			if _, err := os.Stat(p); err == nil {
+				path = p
+				break
+			}
+		}
+	}
+	if path != "" {
+		if err := cfg.readFile(path); err != nil {
+			return cfg, err
+		}
+		cfg.Source = path
+	}
+
+	if err := applyEnv(reflect.ValueOf(&cfg).Elem()); err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.Validate()
+}
+
+func (c *Config) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(f)
+		dec.KnownFields(true)
+		err = dec.Decode(c)
+		if errors.Is(err, io.EOF) {
+			err = nil // empty file
+		}
+	case ".toml":
+		err = toml.NewDecoder(f).DisallowUnknownFields().Decode(c)
+	default:
+		return fmt.Errorf("%s: config files must be .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// applyEnv sets fields from the environment variables in their env tags.
+func applyEnv(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, value := t.Field(i), v.Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			if err := applyEnv(value); err != nil {
+				return err
+			}
+			continue
+		}
+		tag := field.Tag.Get("env")
+		if tag == "" {
+			continue
+		}
+		for _, name := range strings.Split(tag, ",") {
+			raw, ok := os.LookupEnv(name)
+			if !ok {
+				continue
+			}
+			if err := setFromEnv(value, raw); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func setFromEnv(v reflect.Value, raw string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", raw)
+		}
+		v.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("%q is not a whole number", raw)
+		}
+		v.SetInt(int64(n))
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
+
+// Validate reports every setting that is out of range.
+func (c Config) Validate() error {
+	var errs []error
+	if !slices.Contains(DatabaseDrivers, c.Database.Driver) {
+		errs = append(errs, fmt.Errorf("database.driver %q must be one of %s", c.Database.Driver, strings.Join(DatabaseDrivers, ", ")))
+	}
+	if strings.TrimSpace(c.Database.DSN) == "" {
+		errs = append(errs, errors.New("database.dsn is required"))
+	}
+	if _, _, err := net.SplitHostPort(c.Server.ListenAddress); err != nil {
+		errs = append(errs, fmt.Errorf("server.listen_address %q must be host:port", c.Server.ListenAddress))
+	}
+	for _, origin := range c.Server.AllowedOrigins {
+		if strings.TrimSpace(origin) == "" {
+			errs = append(errs, errors.New("server.allowed_origins cannot contain an empty origin"))
+			break
+		}
+	}
+	if c.Server.StaticDir == "" {
+		errs = append(errs, errors.New("server.static_dir is required"))
+	}
+	if !slices.Contains(LogLevels, c.Log.Level) {
+		errs = append(errs, fmt.Errorf("log.level %q must be one of %s", c.Log.Level, strings.Join(LogLevels, ", ")))
+	}
+	if c.Defaults.WinningPoints != 10 && c.Defaults.WinningPoints != 14 {
+		errs = append(errs, fmt.Errorf("defaults.winning_points must be 10 or 14, not %d", c.Defaults.WinningPoints))
+	}
+	if c.Backup.Dir == "" {
+		errs = append(errs, errors.New("backup.dir is required"))
+	}
+	if c.Backup.Keep < 1 {
+		errs = append(errs, fmt.Errorf("backup.keep must be at least 1, not %d", c.Backup.Keep))
+	}
+	if d, err := time.ParseDuration(c.Backup.interval()); err != nil || d < 0 {
+		errs = append(errs, fmt.Errorf("backup.interval %q must be a duration such as 24h or 30m", c.Backup.Interval))
+	} else if d > 0 && d < time.Minute {
+		errs = append(errs, fmt.Errorf("backup.interval must be at least 1m, not %s", d))
+	}
+	return errors.Join(errs...)
+}
+
+func (b BackupConfig) interval() string {
+	if b.Interval == "" {
+		return "0"
+	}
+	return b.Interval
+}
+
+// IntervalDuration is the time between scheduled backups, or 0 when they
+// are off. Validate has already checked Interval.
+func (b BackupConfig) IntervalDuration() time.Duration {
+	d, _ := time.ParseDuration(b.interval())
+	return d
+}
+
+// OriginAllowed reports whether origin matches one of AllowedOrigins.
+func (s ServerConfig) OriginAllowed(origin string) bool {
+	if origin == "" {
+		return false
+	}
+	for _, pattern := range s.AllowedOrigins {
+		if matchWildcard(pattern, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchWildcard matches s against pattern, where * stands for any run of
+// characters, including none.
+func matchWildcard(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
+
+// GormLogLevel is the GORM logger level matching Log.Level.
+func (l LogConfig) GormLogLevel() logger.LogLevel {
+	switch l.Level {
+	case "debug":
+		return logger.Info
+	case "error":
+		return logger.Error
+	default:
+		return logger.Warn
+	}
+}
+
+// Write prints the configuration as yaml, toml or json.
+func (c Config) Write(w io.Writer, format string) error {
+	switch format {
+	case "", "yaml":
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(c); err != nil {
+			return err
+		}
+		return enc.Close()
+	case "toml":
+		return toml.NewEncoder(w).Encode(c)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(c)
+	default:
+		return fmt.Errorf("unknown format %q: must be yaml, toml or json", format)
+	}
+}
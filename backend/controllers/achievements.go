@@ -11,14 +11,16 @@ import (
 
 // GetGameAchievements godoc
 // @Summary      Get achievements for a game
+// @ID           GetGameAchievements
 // @Description  Computes and returns per-game achievements (only for finished, non-partial games).
 // @Tags         games
 // @Param        id   path      int  true  "Game ID"
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}  "value, Count"
-// @Failure      400  {object}  map[string]string       "error"
-// @Failure      500  {object}  map[string]string       "error"
-// @Router       /games/{id}/achievements [get]
+// @Success      200  {object}  achievements.BadgeList
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/games/{id}/achievements [get]
 func GetGameAchievements(c *gin.Context) (int, any, error) {
 	id, err := handle.ParseID(c, "id")
 	if err != nil {
@@ -31,21 +33,22 @@ func GetGameAchievements(c *gin.Context) (int, any, error) {
 	if badges == nil {
 		badges = []achievements.Badge{}
 	}
-	return http.StatusOK, gin.H{"value": badges, "Count": len(badges)}, nil
+	return http.StatusOK, achievements.BadgeList{Value: badges, Count: len(badges)}, nil
 }
 
 // GetGlobalAchievements godoc
 // @Summary      Global achievements (records)
+// @ID           GetGlobalAchievements
 // @Description  Returns current records across all finished, non-partial games, including all holders for each record.
 // @Tags         achievements
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}  "value, Count"
-// @Failure      500  {object}  map[string]string       "error"
-// @Router       /achievements [get]
+// @Success      200  {object}  achievements.BadgeList
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/achievements [get]
 func GetGlobalAchievements(c *gin.Context) (int, any, error) {
 	badges, err := achievements.ComputeGlobalAchievements(database.DB)
 	if err != nil {
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
-	return http.StatusOK, gin.H{"value": badges, "Count": len(badges)}, nil
+	return http.StatusOK, achievements.BadgeList{Value: badges, Count: len(badges)}, nil
 }
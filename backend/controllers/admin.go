@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"net/http"
+
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+// CorrectGamePlayer godoc
+// @Summary      Correct a player's faction
+// @ID           CorrectGamePlayer
+// @Description  Changes the faction a player had in a game, finished or not. The change is audited with the reason given.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int                              true  "Game ID"
+// @Param        player_id  path      int                              true  "Player ID"
+// @Param        body       body      models.CorrectGamePlayerRequest  true  "New faction and reason"
+// @Success      200        {object}  models.GamePlayer
+// @Failure      400        {object}  map[string]string  "error"
+// @Failure      404        {object}  map[string]string  "error"
+// @Failure      409        {object}  map[string]string  "error"
+// @Failure      422        {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/admin/games/{id}/players/{player_id} [post]
+func CorrectGamePlayer(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	playerID, err := handle.ParseID(c, "player_id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.CorrectGamePlayerRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	gp, err := services.CorrectGamePlayerFaction(requestContext(c), gameID, playerID, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, gp, nil
+}
+
+// CorrectScore godoc
+// @Summary      Correct a score
+// @ID           CorrectScore
+// @Description  Moves a score to another player in the same game or another round (by number), or changes its points.
+// @Description  The game result is not recomputed; use /api/v1/admin/games/{id}/recompute afterwards if needed.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                         true  "Score ID"
+// @Param        body  body      models.CorrectScoreRequest  true  "Changes and reason"
+// @Success      200   {object}  models.Score
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      404   {object}  map[string]string  "error"
+// @Failure      409   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/admin/scores/{id} [post]
+func CorrectScore(c *gin.Context) (int, any, error) {
+	scoreID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.CorrectScoreRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	score, err := services.CorrectScore(requestContext(c), scoreID, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, score, nil
+}
+
+// ReopenGame godoc
+// @Summary      Reopen a finished game
+// @ID           ReopenGame
+// @Description  Clears FinishedAt, WinnerID and every player's Won flag so scoring can continue.
+// @Description  A game timed by sessions starts its next session, so turns can be timed again.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                   true  "Game ID"
+// @Param        body     body      models.ReasonRequest  true  "Reason"
+// @Success      200      {object}  models.Game
+// @Failure      400      {object}  map[string]string  "error"
+// @Failure      404      {object}  map[string]string  "error"
+// @Failure      409      {object}  map[string]string  "error"
+// @Failure      422      {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/admin/games/{id}/reopen [post]
+func ReopenGame(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.ReasonRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	game, err := services.ReopenGame(requestContext(c), gameID, req.Reason)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, game, nil
+}
+
+// RecomputeGameResult godoc
+// @Summary      Recompute a game's result
+// @ID           RecomputeGameResult
+// @Description  Sets WinnerID, Won and FinishedAt from the scores: a player at the winning points wins, or the highest total
+// @Description  if the game had already ended. winner_id overrides the scores, e.g. to settle a tie.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                            true  "Game ID"
+// @Param        body     body      models.RecomputeResultRequest  true  "Optional winner and reason"
+// @Success      200      {object}  models.Game
+// @Failure      400      {object}  map[string]string  "error"
+// @Failure      404      {object}  map[string]string  "error"
+// @Failure      409      {object}  map[string]string  "error"
+// @Failure      422      {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/admin/games/{id}/recompute [post]
+func RecomputeGameResult(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.RecomputeResultRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	game, err := services.RecomputeGameResult(requestContext(c), gameID, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, game, nil
+}
@@ -1,9 +1,10 @@
 package controllers
 
 import (
+	"context"
 	"net/http"
 
-	"github.com/arphillips06/TI4-stats/helpers"
+	handle "github.com/arphillips06/TI4-stats/errors"
 	"github.com/arphillips06/TI4-stats/models"
 	"github.com/arphillips06/TI4-stats/services"
 	"github.com/gin-gonic/gin"
@@ -11,87 +12,122 @@ import (
 
 // ResolveMutinyAgenda godoc
 // @Summary      Apply "Mutiny" agenda
+// @ID           ResolveMutinyAgenda
 // @Description  Awards 1 point to players who voted "for" and finalizes the result.
 // @Tags         agendas
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      models.AgendaResolution  true  "Game and resolution context (if applicable)"
 // @Success      204
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error: the game is not in the agenda phase"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /agendas/mutiny [post]
+// @Router       /api/v1/games/{id}/agendas/mutiny [post]
 func ResolveMutinyAgenda(c *gin.Context) {
-	helpers.HandleRequest(c, services.ApplyMutinyAgenda)
+	handleAgenda(c, services.ApplyMutinyAgenda, func(r *models.AgendaResolution) *uint { return &r.GameID })
 }
 
 // HandlePoliticalCensure godoc
 // @Summary      Apply "Political Censure" agenda
+// @ID           HandlePoliticalCensure
 // @Description  Bans the elected player from voting on the next agenda.
 // @Tags         agendas
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      models.PoliticalCensureRequest  true  "Game ID and elected player"
 // @Success      204
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error: the game is not in the agenda phase"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /agendas/political-censure [post]
+// @Router       /api/v1/games/{id}/agendas/political-censure [post]
 func HandlePoliticalCensure(c *gin.Context) {
-	helpers.HandleRequest(c, services.ApplyPoliticalCensure)
-
+	handleAgenda(c, services.ApplyPoliticalCensure, func(r *models.PoliticalCensureRequest) *uint { return &r.GameID })
 }
 
 // HandleSeedOfEmpire godoc
 // @Summary      Apply "Seed of an Empire" agenda
+// @ID           HandleSeedOfEmpire
 // @Description  Grants a VP to the elected player and creates a public 1-point objective for them.
 // @Tags         agendas
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      models.SeedOfEmpireResolution  true  "Game ID and elected player"
 // @Success      204
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error: the game is not in the agenda phase"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /agendas/seed-of-empire [post]
+// @Router       /api/v1/games/{id}/agendas/seed-of-empire [post]
 func HandleSeedOfEmpire(c *gin.Context) {
-	helpers.HandleRequest(c, services.ApplySeedOfEmpire)
+	handleAgenda(c, services.ApplySeedOfEmpire, func(r *models.SeedOfEmpireResolution) *uint { return &r.GameID })
 }
 
 // HandleClassifiedDocumentLeaks godoc
 // @Summary      Apply "Classified Document Leaks"
+// @ID           HandleClassifiedDocumentLeaks
 // @Description  Selects a scored secret objective to become public; the scorer keeps the point but loses a secret slot.
 // @Tags         agendas
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      models.ClassifiedDocumentLeaksRequest  true  "Game ID, player, and target secret objective"
 // @Success      204
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error: the game is not in the agenda phase"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /agendas/classified-document-leaks [post]
+// @Router       /api/v1/games/{id}/agendas/classified-document-leaks [post]
 func HandleClassifiedDocumentLeaks(c *gin.Context) {
-	helpers.HandleRequest(c, services.ApplyClassifiedDocumentLeaks)
+	handleAgenda(c, services.ApplyClassifiedDocumentLeaks, func(r *models.ClassifiedDocumentLeaksRequest) *uint { return &r.GameID })
 }
 
 // HandleIncentiveProgram godoc
 // @Summary      Apply "Incentive Program"
+// @ID           HandleIncentiveProgram
 // @Description  Grants 1 point to all players who voted with the outcome (for or against).
 // @Tags         agendas
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      models.IncentiveProgramRequest  true  "Game ID and outcome"
-// @Success      200  {object}  map[string]string  "message"
+// @Success      200  {object}  models.MessageResponse
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error: the game is not in the agenda phase"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /agendas/incentive-program [post]
+// @Router       /api/v1/games/{id}/agendas/incentive-program [post]
 func HandleIncentiveProgram(c *gin.Context) {
-	req, ok := helpers.BindJSON[models.IncentiveProgramRequest](c)
-	if !ok {
+	var req models.IncentiveProgramRequest
+	if err := bindGameJSON(c, &req, &req.GameID); err != nil {
+		handle.Handle(c, err)
 		return
 	}
 
-	err := services.ApplyIncentiveProgramEffect(req.GameID, req.Outcome)
+	err := services.ApplyIncentiveProgramEffect(requestContext(c), req.GameID, req.Outcome)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		handle.Handle(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Incentive Program applied"})
+	c.JSON(http.StatusOK, models.MessageResponse{Message: "Incentive Program applied"})
+}
+
+// handleAgenda binds and applies an agenda resolution, which fails with 409
+// outside the agenda phase. gameID points at the request's game_id.
+func handleAgenda[T any](c *gin.Context, apply func(ctx context.Context, input T) error, gameID func(*T) *uint) {
+	var input T
+	if err := bindGameJSON(c, &input, gameID(&input)); err != nil {
+		handle.Handle(c, err)
+		return
+	}
+	if err := apply(requestContext(c), input); err != nil {
+		handle.Handle(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
 }
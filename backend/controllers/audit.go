@@ -0,0 +1,162 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// ClientIDHeader identifies the device a write came from. The frontend
+	// keeps one per browser.
+	ClientIDHeader = "X-Client-ID"
+
+	auditGameKey    = "audit_game_id"
+	maxAuditPayload = 8 << 10
+)
+
+// setAuditGame attributes the request to a game whose ID is not in the
+// route or payload, such as one it has just created.
+func setAuditGame(c *gin.Context, gameID uint) {
+	c.Set(auditGameKey, gameID)
+}
+
+// AuditRequests logs every POST, PUT, PATCH and DELETE with its route,
+// payload, response status, the rows it changed and who sent it. Rows are
+// counted for database work done with the request's context.
+func AuditRequests() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			c.Next()
+			return
+		}
+
+		payload := readAuditPayload(c)
+		ctx, done := database.TrackChanges(c.Request.Context())
+		c.Request = c.Request.WithContext(ctx)
+		defer func() {
+			rows := done()
+			entry := models.AuditLog{
+				GameID:      auditGameID(c, payload),
+				Method:      c.Request.Method,
+				Route:       c.FullPath(),
+				Path:        c.Request.URL.Path,
+				Payload:     payload,
+				Status:      c.Writer.Status(),
+				RowsChanged: rows,
+				ClientID:    strings.TrimSpace(c.GetHeader(ClientIDHeader)),
+				ClientIP:    c.ClientIP(),
+				UserAgent:   c.Request.UserAgent(),
+			}
+			if err := services.RecordRequestAudit(entry); err != nil {
+				log.Printf("Failed to audit %s %s: %v", entry.Method, entry.Path, err)
+			}
+		}()
+
+		c.Next()
+	}
+}
+
+// readAuditPayload reads the request body, leaving it in place for the
+// handler. Uploads are summarised and long bodies truncated.
+func readAuditPayload(c *gin.Context) string {
+	if c.Request.Body == nil {
+		return ""
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil || len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		return fmt.Sprintf("[multipart upload %d bytes]", len(body))
+	}
+	if len(body) > maxAuditPayload {
+		return string(body[:maxAuditPayload]) + "…"
+	}
+	return string(body)
+}
+
+// auditGameID works out which game a request touched: one set by the
+// handler, the game in a /games/ or /game/ route, or game_id in the payload.
+func auditGameID(c *gin.Context, payload string) *uint {
+	if v, ok := c.Get(auditGameKey); ok {
+		if id, ok := v.(uint); ok {
+			return &id
+		}
+	}
+
+	route := c.FullPath()
+	if strings.Contains(route, "/games/:") || strings.HasPrefix(route, "/game/:") {
+		if id, err := strconv.ParseUint(c.Param("id"), 10, 64); err == nil {
+			gameID := uint(id)
+			return &gameID
+		}
+	}
+
+	var body struct {
+		GameID json.Number `json:"game_id"`
+	}
+	if json.Unmarshal([]byte(payload), &body) != nil {
+		return nil
+	}
+	if id, err := strconv.ParseUint(body.GameID.String(), 10, 64); err == nil && id > 0 {
+		gameID := uint(id)
+		return &gameID
+	}
+	return nil
+}
+
+// GetGameAudit godoc
+// @Summary      Game audit trail
+// @ID           GetGameAudit
+// @Description  Lists the write requests and admin corrections made to a game, newest first.
+// @Description  Request entries carry the route, payload, response status, rows changed per table and the client
+// @Description  (X-Client-ID header, IP and user agent). The total number of entries is returned in X-Total-Count.
+// @Tags         games
+// @Produce      json
+// @Param        id         path      int  true   "Game ID"
+// @Param        page       query     int  false  "Page number"
+// @Param        page_size  query     int  false  "Page size (max 200)"
+// @Success      200        {array}   models.AuditLog
+// @Header       200        {integer}  X-Total-Count  "Total number of entries"
+// @Failure      400        {object}  map[string]string  "error"
+// @Failure      404        {object}  map[string]string  "error"
+// @Failure      422        {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/audit [get]
+func GetGameAudit(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	page, err := helpers.ParsePage(c, helpers.DefaultPageSize)
+	if err != nil {
+		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	}
+
+	entries, total, err := services.ListGameAudit(gameID, page)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return http.StatusNotFound, gin.H{"error": "game not found"}, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	helpers.SetPageHeaders(c, page, total)
+	return http.StatusOK, entries, nil
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateBackup godoc
+// @Summary      Back up the database
+// @ID           CreateBackup
+// @Description  Writes a consistent copy of the SQLite database into the backup directory with VACUUM INTO, then deletes the oldest backups beyond backup.keep. Safe to run while games are being recorded.
+// @Tags         admin
+// @Produce      json
+// @Success      201  {object}  models.Backup
+// @Failure      500  {object}  map[string]string  "error"
+// @Failure      501  {object}  map[string]string  "error: database is not SQLite"
+// @Router       /api/v1/admin/backup [post]
+func CreateBackup(c *gin.Context) (int, any, error) {
+	backup, err := services.CreateBackup()
+	if errors.Is(err, database.ErrBackupUnsupported) {
+		return http.StatusNotImplemented, gin.H{"error": err.Error()}, nil
+	}
+	if err != nil {
+		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	}
+	return http.StatusCreated, backup, nil
+}
+
+// ListBackups godoc
+// @Summary      List backups
+// @ID           ListBackups
+// @Description  Lists the backups in the backup directory, newest first.
+// @Tags         admin
+// @Produce      json
+// @Success      200  {array}   models.Backup
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/admin/backups [get]
+func ListBackups(c *gin.Context) (int, any, error) {
+	backups, err := services.ListBackups()
+	if err != nil {
+		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	}
+	if backups == nil {
+		backups = []models.Backup{}
+	}
+	return http.StatusOK, backups, nil
+}
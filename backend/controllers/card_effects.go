@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"net/http"
+
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ListCardEffects godoc
+// @Summary      Card effect registry
+// @ID           ListCardEffects
+// @Description  Action cards, abilities and promissory notes that grant or remove victory points, including homebrew.
+// @Tags         scoring
+// @Produce      json
+// @Success      200  {array}   models.CardEffect
+// @Router       /api/v1/card-effects [get]
+func ListCardEffects(c *gin.Context) (int, any, error) {
+	out, err := services.ListCardEffects()
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
+
+// CreateCardEffect godoc
+// @Summary      Add a homebrew card effect
+// @ID           CreateCardEffect
+// @Description  kind is action_card (default), ability or promissory_note. victory_points may be negative.
+// @Tags         scoring
+// @Accept       json
+// @Produce      json
+// @Param        body  body      models.CardEffect  true  "Card (name required)"
+// @Success      201   {object}  models.CardEffect
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      409   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/card-effects [post]
+func CreateCardEffect(c *gin.Context) (int, any, error) {
+	var card models.CardEffect
+	if err := bindJSON(c, &card); err != nil {
+		return 0, nil, err
+	}
+	created, err := services.CreateCardEffect(requestContext(c), card)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, created, nil
+}
+
+// RecordCardEffect godoc
+// @Summary      Record a card effect in a game
+// @ID           RecordCardEffect
+// @Description  Scores the card's victory points (or points, if given) for player_id in round_id, defaulting to the current round.
+// @Description  The score is an action_card score titled with the card name and can finish the game.
+// @Tags         scoring
+// @Accept       json
+// @Produce      json
+// @Param        id       path  int                       true  "Game ID"
+// @Param        body     body  models.CardEffectRequest  true  "Card effect"
+// @Success      204
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/card-effects [post]
+func RecordCardEffect(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.CardEffectRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	if err := services.RecordCardEffect(requestContext(c), gameID, req); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+// GetCardEffectStats godoc
+// @Summary      Card effect stats
+// @ID           GetCardEffectStats
+// @Description  How often each action card or ability has been recorded across games and the net points it gave.
+// @Tags         stats
+// @Produce      json
+// @Success      200  {array}   models.CardEffectStats
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/stats/card-effects [get]
+func GetCardEffectStats(c *gin.Context) (int, any, error) {
+	out, err := services.GetCardEffectStats()
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
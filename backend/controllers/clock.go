@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"net/http"
+
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+// StartTurn godoc
+// @Summary      Start a player's turn
+// @ID           StartTurn
+// @Description  Starts player_id's clock in the given phase, stopping whoever's clock was running. The phase defaults to the round's phase when the game tracks phases, otherwise to action.
+// @Description  A player who has passed cannot start another action phase turn in the same round.
+// @Tags         clock
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                 true  "Game ID"
+// @Param        body     body      models.TurnRequest  true  "Player and phase"
+// @Success      200      {object}  models.GameClock
+// @Failure      400      {object}  map[string]string  "error"
+// @Failure      404      {object}  map[string]string  "error"
+// @Failure      409      {object}  map[string]string  "error"
+// @Failure      422      {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/turns/start [post]
+func StartTurn(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.TurnRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	clock, err := services.StartTurn(requestContext(c), gameID, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, clock, nil
+}
+
+// PassTurn godoc
+// @Summary      Pass
+// @ID           PassTurn
+// @Description  Ends the running action phase turn and marks its player as passed for the round.
+// @Description  player_id is optional; when given it must be the player whose clock is running.
+// @Tags         clock
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                 true   "Game ID"
+// @Param        body     body      models.TurnRequest  false  "Passing player"
+// @Success      200      {object}  models.GameClock
+// @Failure      400      {object}  map[string]string  "error"
+// @Failure      404      {object}  map[string]string  "error"
+// @Failure      409      {object}  map[string]string  "error"
+// @Failure      422      {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/turns/pass [post]
+func PassTurn(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.TurnRequest
+	if c.Request.ContentLength != 0 {
+		if err := bindJSON(c, &req); err != nil {
+			return 0, nil, err
+		}
+	}
+	clock, err := services.PassTurn(requestContext(c), gameID, req.PlayerID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, clock, nil
+}
+
+// EndTurn godoc
+// @Summary      End the running turn
+// @ID           EndTurn
+// @Description  Stops the running clock without starting another, e.g. at the end of a phase.
+// @Tags         clock
+// @Produce      json
+// @Param        id       path      int  true  "Game ID"
+// @Success      200      {object}  models.GameClock
+// @Failure      400      {object}  map[string]string  "error"
+// @Failure      404      {object}  map[string]string  "error"
+// @Failure      409      {object}  map[string]string  "error"
+// @Failure      422      {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/turns/end [post]
+func EndTurn(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	clock, err := services.EndTurn(requestContext(c), gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, clock, nil
+}
+
+// GetGameClock godoc
+// @Summary      Game clock
+// @ID           GetGameClock
+// @Description  Time each player has spent on their turns, the running turn and who has passed this round.
+// @Tags         clock,games
+// @Produce      json
+// @Param        id   path      int  true  "Game ID"
+// @Success      200  {object}  models.GameClock
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/clock [get]
+func GetGameClock(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	clock, err := services.GetGameClock(gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, clock, nil
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"log"
+	"net/http"
+
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ExportGamesCSV godoc
+// @Summary      Export games as CSV
+// @ID           ExportGamesCSV
+// @Description  One row per player per game: game number, date, player, faction, final points, placement and whether they won.
+// @Tags         export
+// @Produce      text/csv
+// @Success      200  {file}    file
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/export/games.csv [get]
+func ExportGamesCSV(c *gin.Context) {
+	writeCSV(c, services.ExportGames)
+}
+
+// ExportScoresCSV godoc
+// @Summary      Export scores as CSV
+// @ID           ExportScoresCSV
+// @Description  One row per score with round, type and the objective, agenda or relic title.
+// @Tags         export
+// @Produce      text/csv
+// @Success      200  {file}    file
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/export/scores.csv [get]
+func ExportScoresCSV(c *gin.Context) {
+	writeCSV(c, services.ExportScores)
+}
+
+// ExportStatsCSV godoc
+// @Summary      Export stats as CSV
+// @ID           ExportStatsCSV
+// @Description  Per-player and per-faction summary rows, distinguished by the kind column.
+// @Tags         export
+// @Produce      text/csv
+// @Success      200  {file}    file
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/export/stats.csv [get]
+func ExportStatsCSV(c *gin.Context) {
+	writeCSV(c, services.ExportStats)
+}
+
+// ExportWorkbook godoc
+// @Summary      Export everything as a spreadsheet
+// @ID           ExportWorkbook
+// @Description  An .xlsx workbook with games, scores and stats sheets.
+// @Tags         export
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Success      200  {file}    file
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/export/workbook.xlsx [get]
+func ExportWorkbook(c *gin.Context) {
+	f, err := services.ExportWorkbook()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer f.Close()
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="ti4-stats.xlsx"`)
+	c.Data(http.StatusOK, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", buf.Bytes())
+}
+
+// writeCSV builds the table before writing anything so that a failure can
+// still be reported as JSON.
+func writeCSV(c *gin.Context, build func() (services.ExportTable, error)) {
+	table, err := build()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", `attachment; filename="`+table.Name+`.csv"`)
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	w.Write(table.Header)
+	for _, row := range table.Rows {
+		w.Write(row)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		// The status is already sent, so the client just gets a short file.
+		log.Printf("Failed to write %s.csv: %v", table.Name, err)
+		c.Abort()
+	}
+}
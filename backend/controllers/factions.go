@@ -3,18 +3,24 @@ package controllers
 import (
 	"net/http"
 
-	"github.com/arphillips06/TI4-stats/database/factions"
+	"github.com/arphillips06/TI4-stats/services"
 	"github.com/gin-gonic/gin"
 )
 
 // GetFactions godoc
 // @Summary      List factions
-// @Description  Returns all available factions.
+// @ID           GetFactions
+// @Description  Returns the faction catalogue with code, expansion, aliases, home system and commodities.
 // @Tags         factions
 // @Produce      json
-// @Success      200  {array}   map[string]interface{}
-// @Failure      500  {object}  map[string]string  "error"
-// @Router       /factions [get]
+// @Param        expansion  query     string  false  "Only factions from this expansion (base, pok, codex)"
+// @Success      200  {array}   models.Faction
+// @Failure      400  {object}  map[string]string  "error"
+// @Router       /api/v1/factions [get]
 func GetFactions(c *gin.Context) (int, any, error) {
-	return http.StatusOK, factions.AllFactions, nil
+	out, err := services.ListFactions(c.Query("expansion"))
+	if err != nil {
+		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	}
+	return http.StatusOK, out, nil
 }
@@ -1,58 +1,103 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
-	"strconv"
 	"strings"
 
 	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
 	"github.com/arphillips06/TI4-stats/helpers"
 	"github.com/arphillips06/TI4-stats/models"
 	"github.com/arphillips06/TI4-stats/services"
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
+var gameSortColumns = map[string]string{
+	"id":             "games.id",
+	"game_number":    "games.game_number",
+	"created_at":     "games.created_at",
+	"finished_at":    "games.finished_at",
+	"winning_points": "games.winning_points",
+	"current_round":  "games.current_round",
+}
+
 // ListGames godoc
 // @Summary      List games
-// @Description  Returns all games with players and winner info.
+// @ID           ListGames
+// @Description  Returns games with players and winner info. The total number of matching games is returned in X-Total-Count.
+// @Description  Search terms: w: winner, wf: winner faction, p: player, f: faction, o: objective scored,
+// @Description  s: secret scored, a: agenda, r: relic, c: custodians (true|false), rounds/players/vp with =,>=,<=,>,<,
+// @Description  after:/before: YYYY-MM-DD, and free text over title, notes and location. Prefix any term with - to negate it.
+// @Description  Without page/page_size all games are returned, except for searches which default to 50 per page.
 // @Tags         games
 // @Produce      json
-// @Param        search  query     string  false  "Search query (e.g., 'winner:Alice', 'player:Bob')"
+// @Param        search     query     string  false  "Search query (e.g., 'w:Alice -p:Bob rounds<=6')"
+// @Param        page       query     int     false  "Page number"
+// @Param        page_size  query     int     false  "Page size (max 200)"
+// @Param        sort       query     string  false  "Comma separated sort keys, prefix with - for descending (id, game_number, created_at, finished_at, winning_points, current_round)"
+// @Param        fields     query     string  false  "Comma separated JSON fields to return (e.g. id,game_number,winner)"
 // @Success      200     {array}   models.Game
+// @Header       200     {integer}  X-Total-Count  "Total number of matching games"
+// @Failure      400     {object}  map[string]string  "error"
 // @Failure      500     {object}  map[string]string  "error"
-// @Router       /games [get]
+// @Router       /api/v1/games [get]
 func ListGames(c *gin.Context) (int, any, error) {
-	if s := strings.TrimSpace(c.Query("search")); s != "" {
-		var games []models.Game
+	opts, err := helpers.ParseListOptions(c, gameSortColumns, models.Game{})
+	if err != nil {
+		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	}
 
-		if err := listGamesWithSearch(s).
-			Preload("GamePlayers.Player").
-			Preload("Winner").
-			Find(&games).Error; err != nil {
-			return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	query := database.DB.Model(&models.Game{})
+	defaultOrder := "games.id ASC"
+	if s := strings.TrimSpace(c.Query("search")); s != "" {
+		query, err = listGamesWithSearch(s)
+		if err != nil {
+			return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+		}
+		defaultOrder = "COALESCE(games.finished_at, games.created_at) DESC"
+		if opts.Page.Size == 0 {
+			opts.Page.Size = helpers.DefaultPageSize
 		}
-		return http.StatusOK, games, nil
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	}
+
+	query = opts.Apply(query, defaultOrder)
+	if opts.Wants("players") {
+		query = query.Preload("GamePlayers.Player")
+	}
+	if opts.Wants("winner") {
+		query = query.Preload("Winner")
 	}
 
 	var games []models.Game
-	if err := database.DB.
-		Preload("GamePlayers.Player").
-		Preload("Winner").
-		Find(&games).Error; err != nil {
+	if err := query.Find(&games).Error; err != nil {
+		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	}
+
+	out, err := opts.Project(games)
+	if err != nil {
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
-	return http.StatusOK, games, nil
+	helpers.SetPageHeaders(c, opts.Page, total)
+	return http.StatusOK, out, nil
 }
 
 // GetGameByID godoc
 // @Summary      Get game detail
+// @ID           GetGameByID
 // @Description  Returns detailed game state with objective-based scoring breakdown.
 // @Tags         games
-// @Param        id   path      string  true  "Game ID"
+// @Param        id   path      int     true  "Game ID"
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}
+// @Success      200  {object}  models.GameDetailResponse
 // @Failure      404  {object}  map[string]string  "error"
-// @Router       /games/{id} [get]
+// @Router       /api/v1/games/{id} [get]
 func GetGameByID(c *gin.Context) (int, any, error) {
 	id := c.Param("id")
 	resp, err := services.BuildGameDetailResponse(id)
@@ -64,13 +109,14 @@ func GetGameByID(c *gin.Context) (int, any, error) {
 
 // GetGameObjectives godoc
 // @Summary      Get game public objectives
+// @ID           GetGameObjectives
 // @Description  Returns all public objectives for a game, including stage and round.
 // @Tags         games
-// @Param        id   path      string  true  "Game ID"
+// @Param        id   path      int     true  "Game ID"
 // @Produce      json
-// @Success      200  {array}   map[string]interface{}
+// @Success      200  {array}   models.GameObjective
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /games/{id}/objectives [get]
+// @Router       /api/v1/games/{id}/objectives [get]
 func GetGameObjectives(c *gin.Context) (int, any, error) {
 	gameID := c.Param("id")
 	objectives, err := services.GetAllPublicObjectivesForGame(gameID)
@@ -82,64 +128,89 @@ func GetGameObjectives(c *gin.Context) (int, any, error) {
 
 // GetGameExists godoc
 // @Summary      Check game exists
+// @ID           GetGameExists
 // @Description  Returns {"exists": true|false}.
 // @Tags         games
-// @Param        id   path      string  true  "Game ID"
+// @Param        id   path      int     true  "Game ID"
 // @Produce      json
-// @Success      200  {object}  map[string]bool
-// @Failure      404  {object}  map[string]bool
-// @Router       /games/{id}/exists [get]
+// @Success      200  {object}  models.ExistsResponse
+// @Failure      404  {object}  models.ExistsResponse
+// @Router       /api/v1/games/{id}/exists [get]
 func GetGameExists(c *gin.Context) (int, any, error) {
 	id := c.Param("id")
 	var game models.Game
 	if err := database.DB.First(&game, id).Error; err != nil {
-		return http.StatusNotFound, gin.H{"exists": false}, nil
+		return http.StatusNotFound, models.ExistsResponse{Exists: false}, nil
 	}
-	return http.StatusOK, gin.H{"exists": true}, nil
+	return http.StatusOK, models.ExistsResponse{Exists: true}, nil
 }
 
 // CreateGame godoc
 // @Summary      Create a new game
+// @ID           CreateGame
 // @Description  Creates a new game with players; can optionally generate objectives.
 // @Tags         games
 // @Accept       json
 // @Produce      json
 // @Param        body  body      models.CreateGameInput  true  "New game payload"
-// @Success      200  {object}  map[string]interface{}  "game, revealed"
-// @Failure      400  {object}  map[string]string       "error"
-// @Failure      500  {object}  map[string]string       "error"
-// @Router       /games [post]
+// @Success      200  {object}  models.CreateGameResponse
+// @Failure      400  {object}  map[string]string             "error"
+// @Failure      409  {object}  models.UnknownPlayerResponse  "a name looks like a typo of an existing player"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Failure      500  {object}  map[string]string             "error"
+// @Router       /api/v1/games [post]
 func CreateGame(c *gin.Context) (int, any, error) {
-	input, ok := helpers.BindJSON[models.CreateGameInput](c)
-	if !ok {
-		return http.StatusBadRequest, gin.H{"error": "invalid payload"}, nil
+	var input models.CreateGameInput
+	if err := bindJSON(c, &input); err != nil {
+		return 0, nil, err
+	}
+	game, revealed, err := services.CreateNewGameWithPlayers(requestContext(c), input)
+	return createGameResponse(c, http.StatusOK, game, revealed, err)
+}
+
+// createGameResponse answers a request that created a game, or failed to.
+func createGameResponse(c *gin.Context, status int, game models.Game, revealed []models.GameObjective, err error) (int, any, error) {
+	if errors.Is(err, services.ErrUnknownFaction) || errors.Is(err, services.ErrPlayerNotFound) {
+		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	}
+	if handle.IsValidation(err) || errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil, err
+	}
+	var unknown *services.UnknownPlayerError
+	if errors.As(err, &unknown) {
+		return http.StatusConflict, models.UnknownPlayerResponse{Error: err.Error(), Player: unknown.Name, Suggestions: unknown.Suggestions}, nil
 	}
-	game, revealed, err := services.CreateNewGameWithPlayers(*input)
 	if err != nil {
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
-	return http.StatusOK, gin.H{"game": game, "revealed": revealed}, nil
+	setAuditGame(c, game.ID)
+	return status, models.CreateGameResponse{Game: game, Revealed: revealed}, nil
 }
 
 // AdvanceRound godoc
 // @Summary      Advance game round
+// @ID           AdvanceRound
 // @Description  Advances the round; reveals a public objective unless none remain (then ends the game).
 // @Tags         games
-// @Param        game_id  path      string  true  "Game ID"
+// @Param        id       path      int     true  "Game ID"
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}
+// @Success      200  {object}  models.AdvanceRoundResponse
 // @Failure      400  {object}  map[string]string  "error"
 // @Failure      404  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error: the game tracks phases and the round is not in its last phase"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /games/{game_id}/advance-round [post]
+// @Router       /api/v1/games/{id}/rounds [post]
 func AdvanceRound(c *gin.Context) (int, any, error) {
-	gameIDStr := c.Param("game_id")
-	gameIDUint, err := strconv.ParseUint(gameIDStr, 10, 64)
+	gameID, err := handle.ParseID(c, "id")
 	if err != nil {
-		return http.StatusBadRequest, gin.H{"error": "invalid game ID"}, nil
+		return 0, nil, err
 	}
-	response, err := services.AdvanceGameRound(uint(gameIDUint))
+	response, err := services.AdvanceGameRound(requestContext(c), gameID)
 	if err != nil {
+		if handle.IsRule(err) {
+			return 0, nil, err
+		}
 		status := http.StatusInternalServerError
 		if err.Error() == "game not found" {
 			status = http.StatusNotFound
@@ -153,101 +224,102 @@ func AdvanceRound(c *gin.Context) (int, any, error) {
 
 // AssignObjective godoc
 // @Summary      Manually assign a public objective to a round
+// @ID           AssignObjective
 // @Description  Admin action to attach an objective to a specific game round.
 // @Tags         games
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      models.AssignObjectiveRequest  true  "Assignment payload"
-// @Success      200  {object}  map[string]string  "message"
+// @Success      200  {object}  models.MessageResponse
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /games/assign-objective [post]
+// @Router       /api/v1/games/{id}/objectives [post]
 func AssignObjective(c *gin.Context) (int, any, error) {
-	req, ok := helpers.BindJSON[models.AssignObjectiveRequest](c)
-	if !ok {
-		return http.StatusBadRequest, gin.H{"error": "invalid payload"}, nil
+	var req models.AssignObjectiveRequest
+	if err := bindGameJSON(c, &req, &req.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.ManuallyAssignObjective(req.GameID, uint(req.RoundID), req.ObjectiveID); err != nil {
-		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	if err := services.ManuallyAssignObjective(requestContext(c), req.GameID, uint(req.RoundID), req.ObjectiveID); err != nil {
+		return 0, nil, err
 	}
-	return http.StatusOK, gin.H{"message": "objective assigned"}, nil
+	return http.StatusOK, models.MessageResponse{Message: "objective assigned"}, nil
 }
 
 // RandomiseSpeaker godoc
 // @Summary      Randomise speaker
+// @ID           RandomiseSpeaker
 // @Description  Randomly selects a speaker for the game.
 // @Tags         games
-// @Param        id   path      string  true  "Game ID"
+// @Param        id   path      int     true  "Game ID"
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}  "speaker_id, speaker_name"
-// @Failure      400  {object}  map[string]string       "error"
-// @Failure      500  {object}  map[string]string       "error"
-// @Router       /games/{id}/speaker/randomise [post]
+// @Success      200  {object}  models.SpeakerResponse
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/games/{id}/speaker/random [post]
 func RandomiseSpeaker(c *gin.Context) (int, any, error) {
-	gameIDParam := c.Param("id")
-	gameID, err := strconv.ParseUint(gameIDParam, 10, 64)
+	gameID, err := handle.ParseID(c, "id")
 	if err != nil {
-		return http.StatusBadRequest, gin.H{"error": "Invalid game ID"}, nil
+		return 0, nil, err
 	}
-	speaker, err := services.RandomiseSpeaker(uint(gameID))
+	speaker, err := services.RandomiseSpeaker(requestContext(c), gameID)
 	if err != nil {
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
-	return http.StatusOK, gin.H{"speaker_id": speaker.ID, "speaker_name": speaker.Name}, nil
+	return http.StatusOK, models.SpeakerResponse{SpeakerID: speaker.ID, SpeakerName: speaker.Name}, nil
 }
 
 // PostAssignSpeaker godoc
 // @Summary      Assign speaker
+// @ID           PostAssignSpeaker
 // @Description  Assigns a speaker (initial or current) for a specific round.
 // @Tags         games
 // @Accept       json
 // @Produce      json
-// @Param        game_id  path      string  true  "Game ID"
-// @Param        body     body      object  true  "player_id, round_id, is_initial"
-// @Success      200  {object}  map[string]string  "message"
+// @Param        id       path      int     true  "Game ID"
+// @Param        body     body      models.AssignSpeakerRequest  true  "round_id is the round number; player_id is the game player ID"
+// @Success      200  {object}  models.MessageResponse
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /games/{game_id}/speaker [post]
+// @Router       /api/v1/games/{id}/speaker [post]
 func PostAssignSpeaker(c *gin.Context) (int, any, error) {
-	gameID, _ := strconv.Atoi(c.Param("game_id"))
-	type AssignSpeakerRequest struct {
-		PlayerID  uint `json:"player_id"`
-		RoundID   uint `json:"round_id"`
-		IsInitial bool `json:"is_initial"`
-	}
-	req, ok := helpers.BindJSON[AssignSpeakerRequest](c)
-	if !ok {
-		return http.StatusBadRequest, gin.H{"error": "invalid payload"}, nil
+	var req models.AssignSpeakerRequest
+	if err := bindGameJSON(c, &req, &req.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.AssignSpeaker(uint(gameID), req.RoundID, req.PlayerID); err != nil {
-		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	if err := services.AssignSpeaker(requestContext(c), req.GameID, req.RoundID, req.PlayerID); err != nil {
+		return 0, nil, err
 	}
-	return http.StatusOK, gin.H{"message": "Speaker assigned"}, nil
+	return http.StatusOK, models.MessageResponse{Message: "Speaker assigned"}, nil
 }
 
 // DeleteGameHandler godoc
 // @Summary      Delete a game
+// @ID           DeleteGameHandler
 // @Description  Permanently deletes a game by its ID.
 // @Tags         games
 // @Accept       json
 // @Produce      json
 // @Param        id   path      int  true  "Game ID"
-// @Success      200  {object}  map[string]interface{} "status and deleted game ID"
+// @Success      200  {object}  models.DeleteGameResponse
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /games/{id} [delete]
+// @Router       /api/v1/games/{id} [delete]
 func DeleteGameHandler(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.Atoi(idStr)
+	id, err := handle.ParseID(c, "id")
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid game id"})
+		handle.Handle(c, err)
 		return
 	}
 
-	if err := helpers.DeleteGame(uint(id)); err != nil {
+	if err := helpers.DeleteGame(requestContext(c), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"status": "deleted", "game_id": id})
+	c.JSON(http.StatusOK, models.DeleteGameResponse{Status: "deleted", GameID: int(id)})
 }
@@ -2,6 +2,7 @@
 package controllers
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -12,168 +13,214 @@ import (
 	"gorm.io/gorm"
 )
 
-var tokRe = regexp.MustCompile(`"([^"]+)"|(\S+)`)
-
-type searchFilters struct {
-	Winner     string
-	Player     string
-	Faction    string
-	Agenda     string
-	Relic      string
-	Custodians *bool
-	RoundsOp   string // "=", ">=", "<="
-	RoundsVal  *int
-	After      *time.Time
-	Before     *time.Time
-	FreeText   []string
+// Game search query grammar
+//
+// A query is a whitespace separated list of terms. Values containing spaces
+// can be quoted, e.g. p:"Alice Smith" or "long title". Every term must match
+// (terms are ANDed) and any term can be negated with a leading "-".
+//
+//	w:<name>          winner name contains <name>
+//	wf:<faction>      winner played a faction containing <faction>
+//	p:<name>          a player whose name contains <name> took part
+//	f:<faction>       a faction containing <faction> was played
+//	o:<objective>     a public or secret objective containing <objective> was scored
+//	s:<objective>     a secret objective containing <objective> was scored
+//	a:<agenda>        an agenda containing <agenda> awarded or removed points
+//	r:<relic>         a relic containing <relic> was used
+//	c:<bool>          Custodians was (true|yes|1) or was not (false|no|0) claimed
+//	rounds<op><n>     number of rounds played
+//	players<op><n>    number of players in the game
+//	vp<op><n>         points target of the game (alias: points<op><n>)
+//	after:<date>      finished on or after <date> (YYYY-MM-DD)
+//	before:<date>     finished before <date> (YYYY-MM-DD)
+//	<text>            free text matched against title, notes and location
+//
+// <op> is one of =, >=, <=, > or <. Names are matched case-insensitively.
+// Examples:
+//
+//	w:alice rounds<=6
+//	-p:bob f:hacan after:2025-01-01
+//	vp=14 players>=5 o:"Corner the Market"
+var tokRe = regexp.MustCompile(`(-?[a-zA-Z]*:?)"([^"]+)"|(\S+)`)
+
+var cmpRe = regexp.MustCompile(`^(rounds|players|vp|points)(>=|<=|=|>|<)(.*)$`)
+
+type searchTerm struct {
+	Key    string // operator key, "" for free text
+	Cmp    string // comparison for numeric operators
+	Value  string
+	Num    int
+	Date   time.Time
+	Negate bool
+}
+
+// SearchError is returned for queries that do not follow the search grammar.
+type SearchError struct {
+	Term   string
+	Reason string
+}
+
+func (e *SearchError) Error() string {
+	return fmt.Sprintf("invalid search term %q: %s", e.Term, e.Reason)
+}
+
+var textOperators = map[string]bool{
+	"w": true, "wf": true, "p": true, "f": true, "o": true,
+	"s": true, "a": true, "r": true, "c": true, "after": true, "before": true,
 }
 
-func parseSearchQuery(q string) (searchFilters, error) {
+func parseSearchQuery(q string) ([]searchTerm, error) {
 	q = strings.TrimSpace(q)
-	var f searchFilters
 	if q == "" {
-		return f, nil
+		return nil, nil
 	}
 
-	matches := tokRe.FindAllStringSubmatch(q, -1)
-	for _, m := range matches {
-		token := m[1]
+	var terms []searchTerm
+	for _, m := range tokRe.FindAllStringSubmatch(q, -1) {
+		raw := m[0]
+		token := m[3]
 		if token == "" {
-			token = m[2]
+			token = m[1] + m[2]
 		}
-		if token == "" {
+		if token == "" || token == "-" {
 			continue
 		}
 
+		var t searchTerm
+		if strings.HasPrefix(token, "-") {
+			t.Negate = true
+			token = token[1:]
+		}
 		lc := strings.ToLower(token)
-		switch {
-		case strings.HasPrefix(lc, "w:"):
-			f.Winner = strings.Trim(strings.TrimPrefix(token, "w:"), `"`)
-		case strings.HasPrefix(lc, "p:"):
-			f.Player = strings.Trim(strings.TrimPrefix(token, "p:"), `"`)
-		case strings.HasPrefix(lc, "f:"):
-			f.Faction = strings.Trim(strings.TrimPrefix(token, "f:"), `"`)
-		case strings.HasPrefix(lc, "a:"):
-			f.Agenda = strings.Trim(strings.TrimPrefix(token, "a:"), `"`)
-		case strings.HasPrefix(lc, "r:"):
-			f.Relic = strings.Trim(strings.TrimPrefix(token, "r:"), `"`)
-		case strings.HasPrefix(lc, "c:"):
-			val := strings.Trim(strings.TrimPrefix(lc, "c:"), `"`)
-			switch val {
-			case "true", "1", "yes":
-				t := true
-				f.Custodians = &t
-			case "false", "0", "no":
-				t := false
-				f.Custodians = &t
+
+		if cm := cmpRe.FindStringSubmatch(lc); cm != nil {
+			n, err := strconv.Atoi(cm[3])
+			if err != nil || n < 0 {
+				return nil, &SearchError{Term: raw, Reason: "expected a non-negative number"}
 			}
-		case strings.HasPrefix(lc, "rounds>="), strings.HasPrefix(lc, "rounds<="), strings.HasPrefix(lc, "rounds="):
-			op := ">="
-			if strings.Contains(lc, "<=") {
-				op = "<="
+			t.Key, t.Cmp, t.Num = cm[1], cm[2], n
+			if t.Key == "points" {
+				t.Key = "vp"
 			}
-			if strings.Contains(lc, "rounds=") && !strings.Contains(lc, "<=") && !strings.Contains(lc, ">=") {
-				op = "="
+			terms = append(terms, t)
+			continue
+		}
+
+		if key, value, ok := strings.Cut(token, ":"); ok && textOperators[strings.ToLower(key)] {
+			t.Key = strings.ToLower(key)
+			t.Value = strings.Trim(value, `"`)
+			if t.Value == "" {
+				return nil, &SearchError{Term: raw, Reason: "missing value"}
 			}
-			parts := strings.SplitN(token, op, 2)
-			if len(parts) == 2 {
-				if n, err := strconv.Atoi(parts[1]); err == nil {
-					f.RoundsOp = op
-					f.RoundsVal = &n
+			switch t.Key {
+			case "c":
+				switch strings.ToLower(t.Value) {
+				case "true", "1", "yes":
+				case "false", "0", "no":
+					t.Negate = !t.Negate
+				default:
+					return nil, &SearchError{Term: raw, Reason: "expected true or false"}
 				}
+			case "after", "before":
+				d, err := time.Parse("2006-01-02", t.Value)
+				if err != nil {
+					return nil, &SearchError{Term: raw, Reason: "expected a date in YYYY-MM-DD format"}
+				}
+				t.Date = d
 			}
-		case strings.HasPrefix(lc, "after:"):
-			if t, err := time.Parse("2006-01-02", token[len("after:"):]); err == nil {
-				f.After = &t
-			}
-		case strings.HasPrefix(lc, "before:"):
-			if t, err := time.Parse("2006-01-02", token[len("before:"):]); err == nil {
-				f.Before = &t
-			}
-		default:
-			f.FreeText = append(f.FreeText, strings.Trim(token, `"`))
+			terms = append(terms, t)
+			continue
 		}
+
+		t.Value = strings.Trim(token, `"`)
+		terms = append(terms, t)
 	}
-	return f, nil
+	return terms, nil
 }
 
-func applyGameSearch(db *gorm.DB, f searchFilters) *gorm.DB {
-	db = db.Table("games")
+// searchCondition returns the SQL predicate for a single term. Predicates are
+// written against the outer "games" table.
+func searchCondition(t searchTerm) (string, []any) {
+	like := "%" + strings.ToLower(t.Value) + "%"
 
-	if f.After != nil {
-		db = db.Where("games.finished_at IS NOT NULL AND games.finished_at >= ?", *f.After)
-	}
-	if f.Before != nil {
-		db = db.Where("games.finished_at IS NOT NULL AND games.finished_at < ?", *f.Before)
-	}
-	if f.Winner != "" {
-		name := "%" + strings.ToLower(f.Winner) + "%"
-		db = db.Where(`EXISTS (SELECT 1 FROM players w WHERE w.id = games.winner_id AND LOWER(w.name) LIKE ?)`, name)
-	}
-	if f.Player != "" {
-		name := "%" + strings.ToLower(f.Player) + "%"
-		db = db.Where(`
-			EXISTS (
+	switch t.Key {
+	case "w":
+		return `EXISTS (SELECT 1 FROM players w WHERE w.id = games.winner_id AND LOWER(w.name) LIKE ?)`, []any{like}
+	case "wf":
+		return `EXISTS (
+			  SELECT 1 FROM game_players gp
+			  WHERE gp.game_id = games.id AND gp.player_id = games.winner_id AND LOWER(gp.faction) LIKE ?
+			)`, []any{like}
+	case "p":
+		return `EXISTS (
 			  SELECT 1
 			  FROM game_players gp
 			  JOIN players p ON p.id = gp.player_id
 			  WHERE gp.game_id = games.id AND LOWER(p.name) LIKE ?
-			)`, name)
-	}
-	if f.Faction != "" {
-		name := "%" + strings.ToLower(f.Faction) + "%"
-		db = db.Where(`
-			EXISTS (
-			  SELECT 1
-			  FROM game_players gp
-			  JOIN factions f ON f.id = gp.faction_id
-			  WHERE gp.game_id = games.id AND LOWER(f.name) LIKE ?
-			)`, name)
-	}
-	if f.Agenda != "" {
-		title := "%" + strings.ToLower(f.Agenda) + "%"
-		db = db.Where(`
-			EXISTS (
+			)`, []any{like}
+	case "f":
+		return `EXISTS (
+			  SELECT 1 FROM game_players gp
+			  WHERE gp.game_id = games.id AND LOWER(gp.faction) LIKE ?
+			)`, []any{like}
+	case "o":
+		return `EXISTS (
+			  SELECT 1 FROM scores s
+			  JOIN objectives o ON o.id = s.objective_id
+			  WHERE s.game_id = games.id AND s.type IN ('public','secret') AND LOWER(o.name) LIKE ?
+			)`, []any{like}
+	case "s":
+		return `EXISTS (
+			  SELECT 1 FROM scores s
+			  JOIN objectives o ON o.id = s.objective_id
+			  WHERE s.game_id = games.id AND o.stage = 'Secret'
+			    AND (s.type = 'secret' OR s.originally_secret = ?) AND LOWER(o.name) LIKE ?
+			)`, []any{true, like}
+	case "a":
+		return `EXISTS (
 			  SELECT 1 FROM scores s
 			  WHERE s.game_id = games.id AND s.type='agenda' AND LOWER(s.agenda_title) LIKE ?
-			)`, title)
-	}
-	if f.Relic != "" {
-		title := "%" + strings.ToLower(f.Relic) + "%"
-		db = db.Where(`
-			EXISTS (
+			)`, []any{like}
+	case "r":
+		return `EXISTS (
 			  SELECT 1 FROM scores s
 			  WHERE s.game_id = games.id AND s.type='relic' AND LOWER(s.relic_title) LIKE ?
-			)`, title)
-	}
-	if f.Custodians != nil {
-		if *f.Custodians {
-			db = db.Where(`EXISTS (SELECT 1 FROM scores s WHERE s.game_id=games.id AND s.type='mecatol' AND s.points>0)`)
-		} else {
-			db = db.Where(`NOT EXISTS (SELECT 1 FROM scores s WHERE s.game_id=games.id AND s.type='mecatol' AND s.points>0)`)
-		}
-	}
-	if f.RoundsVal != nil && f.RoundsOp != "" {
-		switch f.RoundsOp {
-		case "=":
-			db = db.Where(`(SELECT COALESCE(MAX(number),0) FROM rounds r WHERE r.game_id=games.id) = ?`, *f.RoundsVal)
-		case ">=":
-			db = db.Where(`(SELECT COALESCE(MAX(number),0) FROM rounds r WHERE r.game_id=games.id) >= ?`, *f.RoundsVal)
-		case "<=":
-			db = db.Where(`(SELECT COALESCE(MAX(number),0) FROM rounds r WHERE r.game_id=games.id) <= ?`, *f.RoundsVal)
-		}
+			)`, []any{like}
+	case "c":
+		return `EXISTS (SELECT 1 FROM scores s WHERE s.game_id=games.id AND s.type='mecatol' AND s.points>0)`, nil
+	case "rounds":
+		return `(SELECT COALESCE(MAX(number),0) FROM rounds r WHERE r.game_id=games.id) ` + t.Cmp + ` ?`, []any{t.Num}
+	case "players":
+		return `(SELECT COUNT(*) FROM game_players gp WHERE gp.game_id=games.id) ` + t.Cmp + ` ?`, []any{t.Num}
+	case "vp":
+		return `games.winning_points ` + t.Cmp + ` ?`, []any{t.Num}
+	case "after":
+		return "games.finished_at IS NOT NULL AND games.finished_at >= ?", []any{t.Date}
+	case "before":
+		return "games.finished_at IS NOT NULL AND games.finished_at < ?", []any{t.Date}
+	default:
+		return "(LOWER(games.title) LIKE ? OR LOWER(games.notes) LIKE ? OR LOWER(games.location) LIKE ?)", []any{like, like, like}
 	}
-	if len(f.FreeText) > 0 {
-		for _, term := range f.FreeText {
-			like := "%" + strings.ToLower(term) + "%"
-			db = db.Where("(LOWER(games.title) LIKE ? OR LOWER(games.notes) LIKE ?)", like, like)
+}
+
+func applyGameSearch(db *gorm.DB, terms []searchTerm) *gorm.DB {
+	db = db.Table("games")
+	for _, t := range terms {
+		cond, args := searchCondition(t)
+		if t.Negate {
+			cond = "NOT (" + cond + ")"
 		}
+		db = db.Where(cond, args...)
 	}
 	return db
 }
 
-func listGamesWithSearch(q string) *gorm.DB {
-	f, _ := parseSearchQuery(q)
-	return applyGameSearch(database.DB.Model(&models.Game{}), f).
-		Order("COALESCE(games.finished_at, games.created_at) DESC")
+// listGamesWithSearch returns a query for the games matching q, or a
+// *SearchError if q cannot be parsed.
+func listGamesWithSearch(q string) (*gorm.DB, error) {
+	terms, err := parseSearchQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	return applyGameSearch(database.DB.Model(&models.Game{}), terms), nil
 }
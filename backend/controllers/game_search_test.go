@@ -0,0 +1,186 @@
+package controllers
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	newYear := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		q    string
+		want []searchTerm
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"w:alice rounds<=6", []searchTerm{
+			{Key: "w", Value: "alice"},
+			{Key: "rounds", Cmp: "<=", Num: 6},
+		}},
+		{"-p:bob f:hacan after:2025-01-01", []searchTerm{
+			{Key: "p", Value: "bob", Negate: true},
+			{Key: "f", Value: "hacan"},
+			{Key: "after", Value: "2025-01-01", Date: newYear},
+		}},
+		{`vp=14 players>=5 o:"Corner the Market"`, []searchTerm{
+			{Key: "vp", Cmp: "=", Num: 14},
+			{Key: "players", Cmp: ">=", Num: 5},
+			{Key: "o", Value: "Corner the Market"},
+		}},
+		{"points>10 rounds<3", []searchTerm{
+			{Key: "vp", Cmp: ">", Num: 10},
+			{Key: "rounds", Cmp: "<", Num: 3},
+		}},
+		{`p:"Alice Smith" "long title"`, []searchTerm{
+			{Key: "p", Value: "Alice Smith"},
+			{Value: "long title"},
+		}},
+		{"W:Alice WF:Sol", []searchTerm{
+			{Key: "w", Value: "Alice"},
+			{Key: "wf", Value: "Sol"},
+		}},
+		{"c:yes c:no -c:false", []searchTerm{
+			{Key: "c", Value: "yes"},
+			{Key: "c", Value: "no", Negate: true},
+			{Key: "c", Value: "false"},
+		}},
+		{"- unknown:x -draft", []searchTerm{
+			{Value: "unknown:x"},
+			{Value: "draft", Negate: true},
+		}},
+	}
+	for _, tt := range tests {
+		got, err := parseSearchQuery(tt.q)
+		if err != nil {
+			t.Errorf("parseSearchQuery(%q): %v", tt.q, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseSearchQuery(%q) = %+v, want %+v", tt.q, got, tt.want)
+		}
+	}
+}
+
+func TestParseSearchQueryErrors(t *testing.T) {
+	tests := []struct {
+		q, term, reason string
+	}{
+		{"rounds<=x", "rounds<=x", "expected a non-negative number"},
+		{"w:alice players>-1", "players>-1", "expected a non-negative number"},
+		{"vp=", "vp=", "expected a non-negative number"},
+		{"w:", "w:", "missing value"},
+		{`p:""`, `p:""`, "missing value"},
+		{"c:maybe", "c:maybe", "expected true or false"},
+		{"after:2025-13-01", "after:2025-13-01", "expected a date in YYYY-MM-DD format"},
+		{"-before:yesterday", "-before:yesterday", "expected a date in YYYY-MM-DD format"},
+	}
+	for _, tt := range tests {
+		_, err := parseSearchQuery(tt.q)
+		var searchErr *SearchError
+		if !errors.As(err, &searchErr) {
+			t.Errorf("parseSearchQuery(%q): got %v, want a *SearchError", tt.q, err)
+			continue
+		}
+		if searchErr.Term != tt.term || searchErr.Reason != tt.reason {
+			t.Errorf("parseSearchQuery(%q): got %q: %s, want %q: %s", tt.q, searchErr.Term, searchErr.Reason, tt.term, tt.reason)
+		}
+	}
+}
+
+// TestGameSearchQueries runs every operator's SQL on each driver. Game 1 is
+// the finished game from dbtest.SeedFinishedGame with a secret, an agenda
+// and a relic added; game 2 is an unfinished 14 point game Bob plays alone.
+func TestGameSearchQueries(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T) {
+		db := database.DB
+		game := dbtest.SeedFinishedGame(t)
+		if err := db.Model(&game).Updates(models.Game{Title: "Friday night", Location: "Basement"}).Error; err != nil {
+			t.Fatal(err)
+		}
+		var bob, carol models.Player
+		if err := db.Where("name = ?", "Bob").First(&bob).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Where("name = ?", "Carol").First(&carol).Error; err != nil {
+			t.Fatal(err)
+		}
+		var public, secret models.Objective
+		if err := db.Joins("JOIN scores s ON s.objective_id = objectives.id").Where("s.game_id = ?", game.ID).First(&public).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Where("stage = ?", "Secret").First(&secret).Error; err != nil {
+			t.Fatal(err)
+		}
+		var round models.Round
+		if err := db.Where("game_id = ? AND number = ?", game.ID, 3).First(&round).Error; err != nil {
+			t.Fatal(err)
+		}
+		scores := []models.Score{
+			{GameID: game.ID, RoundID: round.ID, PlayerID: carol.ID, ObjectiveID: secret.ID, Points: 1, Type: models.ScoreTypeSecret},
+			{GameID: game.ID, RoundID: round.ID, PlayerID: carol.ID, Points: 1, Type: models.ScoreTypeAgenda, AgendaTitle: "Mutiny"},
+			{GameID: game.ID, RoundID: round.ID, PlayerID: bob.ID, Points: 1, Type: models.ScoreTypeRelic, RelicTitle: "Shard of the Throne"},
+		}
+		if err := db.Create(&scores).Error; err != nil {
+			t.Fatal(err)
+		}
+		other := models.Game{GameNumber: 2, WinningPoints: 14}
+		if err := db.Create(&other).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Create(&models.GamePlayer{GameID: other.ID, PlayerID: bob.ID, Faction: "Naalu Collective"}).Error; err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			q    string
+			want []int
+		}{
+			{"", []int{1, 2}},
+			{"w:ALI", []int{1}},
+			{"-w:ali", []int{2}},
+			{"wf:arbo", []int{1}},
+			{"wf:sol", []int{}},
+			{"p:bob", []int{1, 2}},
+			{"-p:carol", []int{2}},
+			{"f:naalu", []int{2}},
+			{`o:"` + strings.ToLower(public.Name) + `"`, []int{1}},
+			{`s:"` + secret.Name + `"`, []int{1}},
+			{`s:"` + public.Name + `"`, []int{}},
+			{"a:mutiny", []int{1}},
+			{"r:shard", []int{1}},
+			{"c:yes", []int{1}},
+			{"c:no", []int{2}},
+			{"rounds=3", []int{1}},
+			{"rounds<1", []int{2}},
+			{"players>=3", []int{1}},
+			{"vp=14", []int{2}},
+			{"points<12", []int{1}},
+			{"after:2000-01-01", []int{1}},
+			{"before:2000-01-01", []int{}},
+			{"basement", []int{1}},
+			{`"friday night" players>2 -f:naalu`, []int{1}},
+		}
+		for _, tt := range tests {
+			query, err := listGamesWithSearch(tt.q)
+			if err != nil {
+				t.Errorf("listGamesWithSearch(%q): %v", tt.q, err)
+				continue
+			}
+			var got []int
+			if err := query.Order("game_number").Pluck("game_number", &got).Error; err != nil {
+				t.Errorf("search %q: %v", tt.q, err)
+				continue
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("search %q found games %v, want %v", tt.q, got, tt.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+type importGamesRequest struct {
+	Games []models.ImportGame `json:"games"`
+}
+
+// ImportGames godoc
+// @Summary      Import historical games
+// @ID           ImportGames
+// @Description  Creates finished games from a CSV (text/csv body or multipart "file" field) or a JSON body of the form {"games": [...]}.
+// @Description  CSV rows are one per player per game, grouped by the game column; optional round_1..round_N columns give per-round points.
+// @Description  Games without per-round points or a round count are marked partial. Nothing is imported if any row is invalid.
+// @Tags         games
+// @Accept       json
+// @Accept       text/csv
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        body  body      importGamesRequest  false  "Games to import (JSON)"
+// @Param        file  formData  file                false  "Games to import (CSV)"
+// @Success      201   {object}  models.ImportResult
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ImportResult
+// @Router       /api/v1/import/games [post]
+func ImportGames(c *gin.Context) (int, any, error) {
+	var (
+		games []models.ImportGame
+		errs  []models.ImportError
+	)
+
+	contentType := c.ContentType()
+	switch {
+	case contentType == "multipart/form-data":
+		fh, err := c.FormFile("file")
+		if err != nil {
+			return http.StatusBadRequest, gin.H{"error": "missing CSV file field \"file\""}, nil
+		}
+		f, err := fh.Open()
+		if err != nil {
+			return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+		}
+		defer f.Close()
+		games, errs = services.ParseImportCSV(f)
+	case strings.Contains(contentType, "csv"):
+		games, errs = services.ParseImportCSV(c.Request.Body)
+	default:
+		var req importGamesRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			if errors.Is(err, io.EOF) {
+				return http.StatusBadRequest, gin.H{"error": "request body is empty"}, nil
+			}
+			return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+		}
+		for i := range req.Games {
+			req.Games[i].Row = i + 1
+			for j := range req.Games[i].Players {
+				req.Games[i].Players[j].Row = i + 1
+			}
+		}
+		games = req.Games
+	}
+
+	if len(errs) > 0 {
+		return http.StatusUnprocessableEntity, models.ImportResult{Errors: errs}, nil
+	}
+
+	imported, err := services.ImportGames(requestContext(c), games)
+	var invalid *services.ImportValidationError
+	if errors.As(err, &invalid) {
+		return http.StatusUnprocessableEntity, models.ImportResult{Errors: invalid.Errors}, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, models.ImportResult{Imported: imported}, nil
+}
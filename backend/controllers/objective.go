@@ -17,22 +17,24 @@ func serveObjectives(objType string) (int, any, error) {
 
 // GetAllSecretObjectives godoc
 // @Summary      List secret objectives
+// @ID           GetAllSecretObjectives
 // @Tags         objectives
 // @Produce      json
-// @Success      200  {array}   map[string]interface{}
+// @Success      200  {array}   models.Objective
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /objectives/secret [get]
+// @Router       /api/v1/objectives/secret [get]
 func GetAllSecretObjectives(c *gin.Context) (int, any, error) {
 	return serveObjectives("Secret")
 }
 
 // GetAllPublicObjectives godoc
 // @Summary      List public objectives
+// @ID           GetAllPublicObjectives
 // @Tags         objectives
 // @Produce      json
-// @Success      200  {array}   map[string]interface{}
+// @Success      200  {array}   models.Objective
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /objectives/public [get]
+// @Router       /api/v1/objectives/public [get]
 func GetAllPublicObjectives(c *gin.Context) (int, any, error) {
 	return serveObjectives("Public")
 }
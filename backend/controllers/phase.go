@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"net/http"
+
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+// GetPhase godoc
+// @Summary      Current phase
+// @ID           GetPhase
+// @Description  The phase of the game's current round and the phase that follows it. phase is empty for games that do not track phases.
+// @Tags         games
+// @Produce      json
+// @Param        id   path      int  true  "Game ID"
+// @Success      200  {object}  models.PhaseState
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/phase [get]
+func GetPhase(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	state, err := services.GetPhase(gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, state, nil
+}
+
+// SetPhase godoc
+// @Summary      Change phase
+// @ID           SetPhase
+// @Description  Moves the current round to the next phase: strategy, action, status, then agenda once Custodians is claimed.
+// @Description  An empty phase moves to the next one. Games that do not track phases yet can start in any phase.
+// @Description  While a game tracks phases, objectives, Custodians, Imperial and agendas can only be scored in their phase,
+// @Description  and the round can only be advanced from its last phase.
+// @Tags         games
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                  true   "Game ID"
+// @Param        body     body      models.PhaseRequest  false  "Phase to move to"
+// @Success      200      {object}  models.PhaseState
+// @Failure      400      {object}  map[string]string  "error"
+// @Failure      404      {object}  map[string]string  "error"
+// @Failure      409      {object}  map[string]string  "error"
+// @Failure      422      {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/phase [post]
+func SetPhase(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.PhaseRequest
+	if c.Request.ContentLength != 0 {
+		if err := bindJSON(c, &req); err != nil {
+			return 0, nil, err
+		}
+	}
+	state, err := services.SetPhase(requestContext(c), gameID, req.Phase)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, state, nil
+}
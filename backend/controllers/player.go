@@ -2,19 +2,24 @@ package controllers
 
 import (
 	"net/http"
+	"strings"
 
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers"
+	"github.com/arphillips06/TI4-stats/models"
 	"github.com/arphillips06/TI4-stats/services"
 	"github.com/gin-gonic/gin"
 )
 
 // ListPlayersInGame godoc
 // @Summary      List players in a game
+// @ID           ListPlayersInGame
 // @Tags         players
-// @Param        id   path      string  true  "Game ID"
+// @Param        id   path      int     true  "Game ID"
 // @Produce      json
-// @Success      200  {array}   map[string]interface{}
+// @Success      200  {array}   models.GamePlayer
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /games/{id}/players [get]
+// @Router       /api/v1/games/{id}/players [get]
 func ListPlayersInGame(c *gin.Context) (int, any, error) {
 	gameID := c.Param("id")
 	players, err := services.GetPlayersInGame(gameID)
@@ -24,34 +29,283 @@ func ListPlayersInGame(c *gin.Context) (int, any, error) {
 	return http.StatusOK, players, nil
 }
 
+var playerGameSortColumns = map[string]string{
+	"game_id":     "game_players.game_id",
+	"game_number": "games.game_number",
+	"created_at":  "games.created_at",
+	"finished_at": "games.finished_at",
+	"faction":     "game_players.faction",
+	"won":         "game_players.won",
+}
+
 // GetPlayerGames godoc
 // @Summary      Get a player's games
+// @ID           GetPlayerGames
+// @Description  The total number of games is returned in X-Total-Count.
 // @Tags         players
-// @Param        id   path      string  true  "Player ID"
+// @Param        id         path      int     true   "Player ID"
+// @Param        page       query     int     false  "Page number"
+// @Param        page_size  query     int     false  "Page size (max 200)"
+// @Param        sort       query     string  false  "Comma separated sort keys, prefix with - for descending (game_id, game_number, created_at, finished_at, faction, won)"
+// @Param        fields     query     string  false  "Comma separated JSON fields to return for each game"
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}  "player, games"
-// @Failure      404  {object}  map[string]string       "error"
-// @Router       /players/{id}/games [get]
+// @Success      200  {object}  models.PlayerGamesResponse
+// @Header       200  {integer} X-Total-Count      "Total number of games for the player"
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Router       /api/v1/players/{id}/games [get]
 func GetPlayerGames(c *gin.Context) (int, any, error) {
+	opts, err := helpers.ParseListOptions(c, playerGameSortColumns, models.GamePlayer{})
+	if err != nil {
+		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	}
+
 	playerID := c.Param("id")
-	player, err := services.GetGamesForPlayer(playerID)
+	player, games, total, err := services.GetGamesForPlayer(playerID, opts)
 	if err != nil {
 		return http.StatusNotFound, gin.H{"error": "Player not found"}, nil
 	}
-	return http.StatusOK, gin.H{"player": player.Name, "games": player.Games}, nil
+
+	helpers.SetPageHeaders(c, opts.Page, total)
+	if len(opts.Fields) == 0 {
+		return http.StatusOK, models.PlayerGamesResponse{Player: player.Name, Games: games}, nil
+	}
+
+	// Same shape as PlayerGamesResponse with only the requested fields.
+	out, err := opts.Project(games)
+	if err != nil {
+		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	}
+	return http.StatusOK, struct {
+		Player string `json:"player"`
+		Games  any    `json:"games"`
+	}{player.Name, out}, nil
+}
+
+var playerSortColumns = map[string]string{
+	"id":   "players.id",
+	"name": "players.name",
 }
 
 // ListPlayers godoc
 // @Summary      List players
+// @ID           ListPlayers
+// @Description  The total number of players is returned in X-Total-Count.
 // @Tags         players
+// @Param        page       query     int     false  "Page number"
+// @Param        page_size  query     int     false  "Page size (max 200)"
+// @Param        sort       query     string  false  "Comma separated sort keys, prefix with - for descending (id, name)"
+// @Param        fields     query     string  false  "Comma separated JSON fields to return (e.g. ID,Name)"
 // @Produce      json
-// @Success      200  {array}   map[string]interface{}
+// @Success      200  {array}   models.Player
+// @Header       200  {integer} X-Total-Count      "Total number of players"
+// @Failure      400  {object}  map[string]string  "error"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /players [get]
+// @Router       /api/v1/players [get]
 func ListPlayers(c *gin.Context) (int, any, error) {
-	players, err := services.ListAllPlayers()
+	opts, err := helpers.ParseListOptions(c, playerSortColumns, models.Player{})
+	if err != nil {
+		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	}
+
+	players, total, err := services.ListAllPlayers(opts)
 	if err != nil {
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
-	return http.StatusOK, players, nil
+
+	out, err := opts.Project(players)
+	if err != nil {
+		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	}
+	helpers.SetPageHeaders(c, opts.Page, total)
+	return http.StatusOK, out, nil
+}
+
+// GetPlayer godoc
+// @Summary      Get a player
+// @ID           GetPlayer
+// @Description  Returns the player with their aliases and whether they are active.
+// @Tags         players
+// @Param        id   path      int  true  "Player ID"
+// @Produce      json
+// @Success      200  {object}  models.Player
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/players/{id} [get]
+func GetPlayer(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	player, err := services.GetPlayer(id)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, player, nil
+}
+
+// RenamePlayer godoc
+// @Summary      Rename a player
+// @ID           RenamePlayer
+// @Description  The new name must not belong to another player, as a name or alias. keep_alias keeps the old name as an alias.
+// @Tags         players
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                         true  "Player ID"
+// @Param        body  body      models.RenamePlayerRequest  true  "New name"
+// @Success      200   {object}  models.Player
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      404   {object}  map[string]string  "error"
+// @Failure      409   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/players/{id}/rename [post]
+func RenamePlayer(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.RenamePlayerRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return 0, nil, handle.Invalid("name", "is required")
+	}
+	player, err := services.RenamePlayer(requestContext(c), id, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, player, nil
+}
+
+// MergePlayer godoc
+// @Summary      Merge a duplicate player
+// @ID           MergePlayer
+// @Description  Moves the games, scores, speaker turns, achievements, relics and Support for the Throne of player {id} to into_player_id,
+// @Description  then deletes player {id} and keeps their name as an alias. Fails if both played in the same game.
+// @Tags         players
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                        true  "Player to merge away"
+// @Param        body  body      models.MergePlayerRequest  true  "Player to keep"
+// @Success      200   {object}  models.Player
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      404   {object}  map[string]string  "error"
+// @Failure      409   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/players/{id}/merge [post]
+func MergePlayer(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.MergePlayerRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	player, err := services.MergePlayers(requestContext(c), id, req.IntoPlayerID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, player, nil
+}
+
+// AddPlayerAlias godoc
+// @Summary      Add a player alias
+// @ID           AddPlayerAlias
+// @Description  Aliases are matched, ignoring case, when games are created or imported.
+// @Tags         players
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                        true  "Player ID"
+// @Param        body  body      models.PlayerAliasRequest  true  "Alias"
+// @Success      200   {object}  models.Player
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      404   {object}  map[string]string  "error"
+// @Failure      409   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/players/{id}/aliases [post]
+func AddPlayerAlias(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.PlayerAliasRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	if strings.TrimSpace(req.Alias) == "" {
+		return 0, nil, handle.Invalid("alias", "is required")
+	}
+	player, err := services.AddPlayerAlias(requestContext(c), id, req.Alias)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, player, nil
+}
+
+// RemovePlayerAlias godoc
+// @Summary      Remove a player alias
+// @ID           RemovePlayerAlias
+// @Tags         players
+// @Produce      json
+// @Param        id     path      int     true  "Player ID"
+// @Param        alias  path      string  true  "Alias"
+// @Success      200    {object}  models.Player
+// @Failure      400    {object}  map[string]string  "error"
+// @Failure      409    {object}  map[string]string  "error"
+// @Failure      422    {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/players/{id}/aliases/{alias} [delete]
+func RemovePlayerAlias(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	player, err := services.RemovePlayerAlias(requestContext(c), id, c.Param("alias"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, player, nil
+}
+
+// RetirePlayer godoc
+// @Summary      Retire a player
+// @ID           RetirePlayer
+// @Description  Retired players keep their games but are left off leaderboards.
+// @Tags         players
+// @Produce      json
+// @Param        id   path      int  true  "Player ID"
+// @Success      200  {object}  models.Player
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Router       /api/v1/players/{id}/retire [post]
+func RetirePlayer(c *gin.Context) (int, any, error) {
+	return setPlayerActive(c, false)
+}
+
+// ReactivatePlayer godoc
+// @Summary      Reactivate a retired player
+// @ID           ReactivatePlayer
+// @Tags         players
+// @Produce      json
+// @Param        id   path      int  true  "Player ID"
+// @Success      200  {object}  models.Player
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Router       /api/v1/players/{id}/reactivate [post]
+func ReactivatePlayer(c *gin.Context) (int, any, error) {
+	return setPlayerActive(c, true)
+}
+
+func setPlayerActive(c *gin.Context, active bool) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	player, err := services.SetPlayerActive(requestContext(c), id, active)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, player, nil
 }
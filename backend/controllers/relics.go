@@ -3,104 +3,198 @@ package controllers
 import (
 	"net/http"
 
+	"github.com/arphillips06/TI4-stats/database/relics"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
 	"github.com/arphillips06/TI4-stats/services"
 	"github.com/gin-gonic/gin"
 )
 
 type ShardRequest struct {
-	GameID      uint `json:"game_id"`
-	NewHolderID uint `json:"new_holder_id"`
+	GameID      uint `json:"game_id" binding:"required"`
+	NewHolderID uint `json:"new_holder_id" binding:"required"`
 }
 
 type RelicRequest struct {
-	GameID   uint `json:"game_id"`
-	PlayerID uint `json:"player_id"`
+	GameID   uint `json:"game_id" binding:"required"`
+	PlayerID uint `json:"player_id" binding:"required"`
 }
 
 // HandleShardRelic godoc
 // @Summary      Update "Shard of the Throne" holder
+// @ID           HandleShardRelic
 // @Description  Transfers Shard; grants a point to new holder and removes from previous holder if applicable.
 // @Tags         relics
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      controllers.ShardRequest  true  "Game ID and new holder ID"
-// @Success      200  {object}  map[string]string  "message"
+// @Success      200  {object}  models.MessageResponse
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /relic/shard [post]
+// @Router       /api/v1/games/{id}/relics/shard [post]
 func HandleShardRelic(c *gin.Context) (int, any, error) {
 	var req ShardRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		return http.StatusBadRequest, gin.H{"error": "Invalid request"}, nil
+	if err := bindGameJSON(c, &req, &req.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.ApplyShardOfTheThrone(req.GameID, req.NewHolderID); err != nil {
-		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	if err := services.GainOrTransferRelic(requestContext(c), req.GameID, relics.ShardOfTheThrone, req.NewHolderID); err != nil {
+		return 0, nil, err
 	}
-	return http.StatusOK, gin.H{"message": "Shard of the Throne updated"}, nil
+	return http.StatusOK, models.MessageResponse{Message: "Shard of the Throne updated"}, nil
 }
 
 // HandleCrownRelic godoc
 // @Summary      Apply "Crown of Emphidia"
+// @ID           HandleCrownRelic
 // @Description  Grants 1 point to the specified player (one-time effect).
 // @Tags         relics
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      controllers.RelicRequest  true  "Game ID and player ID"
-// @Success      200  {object}  map[string]string  "message"
+// @Success      200  {object}  models.MessageResponse
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /relic/crown [post]
+// @Router       /api/v1/games/{id}/relics/crown [post]
 func HandleCrownRelic(c *gin.Context) (int, any, error) {
 	var req RelicRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		return http.StatusBadRequest, gin.H{"error": "Invalid request"}, nil
+	if err := bindGameJSON(c, &req, &req.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.ApplyCrownOfEmphidia(req.GameID, req.PlayerID); err != nil {
-		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	if err := services.GainOrTransferRelic(requestContext(c), req.GameID, relics.CrownOfEmphidia, req.PlayerID); err != nil {
+		return 0, nil, err
 	}
-	return http.StatusOK, gin.H{"message": "Crown of Emphidia point assigned"}, nil
+	return http.StatusOK, models.MessageResponse{Message: "Crown of Emphidia point assigned"}, nil
 }
 
 // HandleObsidianRelic godoc
 // @Summary      Apply "The Obsidian"
+// @ID           HandleObsidianRelic
 // @Description  Allows a player to score one additional secret objective this game.
 // @Tags         relics
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      controllers.RelicRequest  true  "Game ID and player ID"
-// @Success      200  {object}  map[string]string  "message"
+// @Success      200  {object}  models.MessageResponse
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /relic/obsidian [post]
+// @Router       /api/v1/games/{id}/relics/obsidian [post]
 func HandleObsidianRelic(c *gin.Context) (int, any, error) {
 	var req RelicRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		return http.StatusBadRequest, gin.H{"error": "Invalid request"}, nil
+	if err := bindGameJSON(c, &req, &req.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.ApplyObsidian(req.GameID, req.PlayerID); err != nil {
-		return http.StatusInternalServerError, gin.H{"error": "Failed to record Obsidian relic use"}, nil
+	if err := services.GainOrTransferRelic(requestContext(c), req.GameID, relics.TheObsidian, req.PlayerID); err != nil {
+		return 0, nil, err
 	}
-	return http.StatusOK, gin.H{"message": "The Obsidian has been granted"}, nil
+	return http.StatusOK, models.MessageResponse{Message: "The Obsidian has been granted"}, nil
 }
 
 // HandleLatvinaRelic godoc
-// @Summary      Apply "Book Of Latvina"
+// @Summary      Apply "Book of Latvinia"
+// @ID           HandleLatvinaRelic
 // @Description  Grants a player a point for planets with 4 tech specialties.
 // @Tags         relics
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      controllers.RelicRequest  true  "Game ID and player ID"
-// @Success      200  {object}  map[string]string  "message"
+// @Success      200  {object}  models.MessageResponse
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /relic/latvina [post]
+// @Router       /api/v1/games/{id}/relics/latvina [post]
 func HandleLatvinaRelic(c *gin.Context) (int, any, error) {
 	var req RelicRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		return http.StatusBadRequest, gin.H{"error": "Invalid request"}, nil
+	if err := bindGameJSON(c, &req, &req.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.ApplyBookOfLatvina(req.GameID, req.PlayerID); err != nil {
-		return http.StatusInternalServerError, gin.H{"error": "Failed to record Book Of Latvina use"}, nil
+	if err := services.GainOrTransferRelic(requestContext(c), req.GameID, relics.BookOfLatvinia, req.PlayerID); err != nil {
+		return 0, nil, err
 	}
-	return http.StatusOK, gin.H{"message": "Book Of Latvina point assigned"}, nil
+	return http.StatusOK, models.MessageResponse{Message: "Book of Latvinia point assigned"}, nil
+}
+
+// ListRelics godoc
+// @Summary      List relics
+// @ID           ListRelics
+// @Description  Returns the relic catalogue with effect metadata.
+// @Tags         relics
+// @Produce      json
+// @Success      200  {array}   models.Relic
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/relics [get]
+func ListRelics(c *gin.Context) (int, any, error) {
+	out, err := services.ListRelics()
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
+
+// GetGameRelics godoc
+// @Summary      Relic holdings in a game
+// @ID           GetGameRelics
+// @Description  Returns every relic gained in the game; holdings without lost_at are current.
+// @Tags         relics
+// @Produce      json
+// @Param        id   path      int  true  "Game ID"
+// @Success      200  {array}   models.RelicHoldingDTO
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/relics [get]
+func GetGameRelics(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	out, err := services.GetRelicHoldings(gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
+
+// HandleRelicAction godoc
+// @Summary      Gain, lose or transfer a relic
+// @ID           HandleRelicAction
+// @Description  action is gain (player_id gains the relic), lose (player_id loses it) or transfer (from player_id to to_player_id).
+// @Description  Victory points from the relic are scored in the current round and can finish the game.
+// @Tags         relics
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                        true  "Game ID"
+// @Param        body     body      models.RelicActionRequest  true  "Relic action"
+// @Success      200      {array}   models.RelicHoldingDTO
+// @Failure      400      {object}  map[string]string  "error"
+// @Failure      409      {object}  map[string]string  "error"
+// @Failure      422      {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/relics [post]
+func HandleRelicAction(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.RelicActionRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	if err := services.ApplyRelicAction(requestContext(c), gameID, req); err != nil {
+		return 0, nil, err
+	}
+
+	out, err := services.GetRelicHoldings(gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
 }
@@ -1,12 +1,12 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
-	"strconv"
 	"strings"
 
 	"github.com/arphillips06/TI4-stats/database"
-	"github.com/arphillips06/TI4-stats/helpers"
+	handle "github.com/arphillips06/TI4-stats/errors"
 	"github.com/arphillips06/TI4-stats/models"
 	"github.com/arphillips06/TI4-stats/services"
 
@@ -15,29 +15,34 @@ import (
 
 // AddScore godoc
 // @Summary      Score an objective
-// @Description  Marks a player as having scored a specific objective in a game.
+// @ID           AddScore
+// @Description  Marks a player as having scored a specific objective in a game. A public objective must be revealed in the game
+// @Description  (or assigned to it when the game does not use objective decks), and a player scores one per status phase unless
+// @Description  override is "imperial" (the Imperial strategy card, in the action phase) or "other" with an override_reason.
+// @Description  A secret made public by Classified Document Leaks is scored as a public objective.
 // @Tags         scoring
 // @Accept       json
 // @Produce      json
-// @Param        game_id     path      string  true  "Game ID"
-// @Param        body        body      object  true  "game_id, player_id, objective_id"
-// @Success      200  {object}  map[string]interface{}
+// @Param        id    path      int  true  "Game ID"
+// @Param        body  body      models.ScoreRequest  true  "Objective scored"
+// @Success      200  {object}  models.ScoreResponse
 // @Failure      400  {object}  map[string]string  "error"
 // @Failure      403  {object}  map[string]string  "error"
 // @Failure      404  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error: not allowed in the current phase"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /games/{game_id}/score [post]
+// @Router       /api/v1/games/{id}/scores [post]
 func AddScore(c *gin.Context) (int, any, error) {
-	var input struct {
-		GameID      uint `json:"game_id"`
-		PlayerID    uint `json:"player_id"`
-		ObjectiveID uint `json:"objective_id"`
-	}
-	if err := c.ShouldBindJSON(&input); err != nil {
-		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	var input models.ScoreRequest
+	if err := bindGameJSON(c, &input, &input.GameID); err != nil {
+		return 0, nil, err
 	}
 
-	resp, err := services.SubmitScore(input.GameID, input.PlayerID, input.ObjectiveID)
+	resp, err := services.SubmitScore(requestContext(c), input)
+	if handle.IsValidation(err) || handle.IsRule(err) {
+		return 0, nil, err
+	}
 	if err != nil {
 		switch err.Error() {
 		case "game not found", "objective not found", "current round not found":
@@ -54,24 +59,27 @@ func AddScore(c *gin.Context) (int, any, error) {
 
 // ScoreImperialPoint godoc
 // @Summary      Score Imperial point
+// @ID           ScoreImperialPoint
 // @Tags         scoring
 // @Accept       json
 // @Produce      json
-// @Param        body  body      object  true  "game_id, player_id, round_id"
+// @Param        id    path      int  true  "Game ID"
+// @Param        body  body      models.PointRequest  true  "Player awarded the point"
 // @Success      204
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error: not allowed in the current phase"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /score/imperial [post]
+// @Router       /api/v1/games/{id}/scores/imperial [post]
 func ScoreImperialPoint(c *gin.Context) (int, any, error) {
-	var input struct {
-		GameID   uint `json:"game_id"`
-		PlayerID uint `json:"player_id"`
-		RoundID  uint `json:"round_id"`
-	}
-	if err := c.ShouldBindJSON(&input); err != nil {
-		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	var input models.PointRequest
+	if err := bindGameJSON(c, &input, &input.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.ScoreImperialPoint(input.GameID, input.PlayerID); err != nil {
+	if err := services.ScoreImperialPoint(requestContext(c), input.GameID, input.PlayerID); err != nil {
+		if handle.IsValidation(err) || handle.IsRule(err) {
+			return 0, nil, err
+		}
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
 	return http.StatusNoContent, nil, nil
@@ -79,24 +87,26 @@ func ScoreImperialPoint(c *gin.Context) (int, any, error) {
 
 // ScoreMecatolPoint godoc
 // @Summary      Score Custodians (Mecatol) point
+// @ID           ScoreMecatolPoint
 // @Tags         scoring
 // @Accept       json
 // @Produce      json
-// @Param        body  body      object  true  "game_id, player_id, round_id"
+// @Param        id    path      int  true  "Game ID"
+// @Param        body  body      models.PointRequest  true  "Player awarded the point"
 // @Success      204
 // @Failure      400  {object}  map[string]string  "error"
 // @Failure      409  {object}  map[string]string  "error"
-// @Router       /score/mecatol [post]
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/scores/mecatol [post]
 func ScoreMecatolPoint(c *gin.Context) (int, any, error) {
-	var input struct {
-		GameID   uint `json:"game_id"`
-		PlayerID uint `json:"player_id"`
-		RoundID  uint `json:"round_id"`
-	}
-	if err := c.ShouldBindJSON(&input); err != nil {
-		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	var input models.PointRequest
+	if err := bindGameJSON(c, &input, &input.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.ScoreMecatolPoint(input.GameID, input.PlayerID); err != nil {
+	if err := services.ScoreMecatolPoint(requestContext(c), input.GameID, input.PlayerID); err != nil {
+		if handle.IsValidation(err) {
+			return 0, nil, err
+		}
 		return http.StatusConflict, gin.H{"error": err.Error()}, nil
 	}
 	return http.StatusNoContent, nil, nil
@@ -104,45 +114,49 @@ func ScoreMecatolPoint(c *gin.Context) (int, any, error) {
 
 // DeleteScore godoc
 // @Summary      Delete a scored objective
+// @ID           DeleteScore
 // @Tags         scoring
 // @Accept       json
 // @Produce      json
-// @Param        body  body      object  true  "game_id, player_id, objective_id"
+// @Param        id    path      int  true  "Game ID"
+// @Param        body  body      models.ScoreRequest  true  "Objective to unscore"
 // @Success      204
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /score [delete]
+// @Router       /api/v1/games/{id}/scores [delete]
 func DeleteScore(c *gin.Context) (int, any, error) {
-	var req struct {
-		GameID      int `json:"game_id"`
-		PlayerID    int `json:"player_id"`
-		ObjectiveID int `json:"objective_id"`
-	}
-	if err := c.ShouldBindJSON(&req); err != nil {
-		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	var req models.ScoreRequest
+	if err := bindGameJSON(c, &req, &req.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.RemoveScore(req.GameID, req.PlayerID, req.ObjectiveID); err != nil {
-		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	if err := services.RemoveScore(requestContext(c), int(req.GameID), int(req.PlayerID), int(req.ObjectiveID)); err != nil {
+		return 0, nil, err
 	}
 	return http.StatusNoContent, nil, nil
 }
 
 // CreatePlayer godoc
 // @Summary      Create player
+// @ID           CreatePlayer
 // @Tags         players
 // @Accept       json
 // @Produce      json
 // @Param        body  body      models.Player  true  "Player (name required)"
-// @Success      200  {object}  map[string]interface{}
+// @Success      200  {object}  models.Player
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /players [post]
+// @Router       /api/v1/players [post]
 func CreatePlayer(c *gin.Context) (int, any, error) {
-	input, ok := helpers.BindJSON[models.Player](c)
-	if !ok || strings.TrimSpace(input.Name) == "" {
-		return http.StatusBadRequest, gin.H{"error": "name is required"}, nil
+	var input models.Player
+	if err := bindJSON(c, &input); err != nil {
+		return 0, nil, err
 	}
-	player, err := services.CreatePlayer(input.Name)
+	if strings.TrimSpace(input.Name) == "" {
+		return 0, nil, handle.Invalid("Name", "is required")
+	}
+	player, err := services.CreatePlayer(requestContext(c), input.Name)
 	if err != nil {
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
@@ -151,20 +165,29 @@ func CreatePlayer(c *gin.Context) (int, any, error) {
 
 // AssignPlayerToGame godoc
 // @Summary      Assign player to game
+// @ID           AssignPlayerToGame
 // @Tags         players,games
 // @Accept       json
 // @Produce      json
+// @Param        id    path      int  true  "Game ID"
 // @Param        body  body      models.AssignPlayerInput  true  "Game ID, Player ID, Faction"
-// @Success      200  {object}  map[string]interface{}      "game_player"
-// @Failure      400  {object}  map[string]string           "error"
-// @Failure      500  {object}  map[string]string           "error"
-// @Router       /games/assign-player [post]
+// @Success      200  {object}  models.GamePlayer
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/games/{id}/players [post]
 func AssignPlayerToGame(c *gin.Context) (int, any, error) {
-	input, ok := helpers.BindJSON[models.AssignPlayerInput](c)
-	if !ok {
-		return http.StatusBadRequest, gin.H{"error": "invalid payload"}, nil
+	var input models.AssignPlayerInput
+	if err := bindGameJSON(c, &input, &input.GameID); err != nil {
+		return 0, nil, err
+	}
+	gp, err := services.AssignPlayerToGame(requestContext(c), input.GameID, input.PlayerID, input.Faction)
+	if handle.IsValidation(err) {
+		return 0, nil, err
+	}
+	if errors.Is(err, services.ErrUnknownFaction) {
+		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
 	}
-	gp, err := services.AssignPlayerToGame(input.GameID, input.PlayerID, input.Faction)
 	if err != nil {
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
@@ -173,28 +196,34 @@ func AssignPlayerToGame(c *gin.Context) (int, any, error) {
 
 // SFTT godoc
 // @Summary      Support for the Throne
-// @Description  Give or revoke Support for the Throne for a player in a game.
+// @ID           SFTT
+// @Description  Give or return Support for the Throne for a player in a game. "score" gives player_id the note of owner_id,
+// @Description  which may be omitted when only one other player's note is available. "unscore" returns the note player_id
+// @Description  most recently received, or owner_id's note if given.
 // @Tags         scoring
 // @Accept       json
 // @Produce      json
-// @Param        game_id   path      string  true  "Game ID"
-// @Param        player_id path      string  true  "Player ID"
-// @Param        body      body      object  true  "round_id, action (give|revoke)"
+// @Param        id        path      int     true  "Game ID"
+// @Param        player_id path      int     true  "Player ID"
+// @Param        body      body      models.SupportForTheThroneRequest  true  "action (score|unscore), owner_id"
 // @Success      200
 // @Failure      400  {object}  map[string]string  "error"
 // @Failure      404  {object}  map[string]string  "error"
-// @Router       /games/{game_id}/players/{player_id}/sftt [post]
+// @Failure      409  {object}  map[string]string  "error: breaks a Support for the Throne rule"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/support/{player_id} [post]
 func SFTT(c *gin.Context) (int, any, error) {
-	gameID, _ := strconv.ParseUint(c.Param("game_id"), 10, 64)
-	playerID, _ := strconv.ParseUint(c.Param("player_id"), 10, 64)
-
-	type SFTTRequest struct {
-		RoundID uint   `json:"round_id"`
-		Action  string `json:"action"`
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
 	}
-	req, ok := helpers.BindJSON[SFTTRequest](c)
-	if !ok {
-		return http.StatusBadRequest, gin.H{"error": "invalid payload"}, nil
+	playerID, err := handle.ParseID(c, "player_id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.SupportForTheThroneRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
 	}
 
 	var game models.Game
@@ -202,21 +231,81 @@ func SFTT(c *gin.Context) (int, any, error) {
 		return http.StatusNotFound, gin.H{"error": "Game not found"}, nil
 	}
 
-	if err := services.HandleSupportForTheThrone(uint(gameID), uint(playerID), req.Action); err != nil {
-		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	if err := services.HandleSupportForTheThrone(requestContext(c), gameID, playerID, req.OwnerID, req.Action); err != nil {
+		return 0, nil, err
 	}
 
 	return http.StatusOK, nil, nil
 }
 
+// HandleSupportAction godoc
+// @Summary      Give, return or eliminate for Support for the Throne
+// @ID           HandleSupportAction
+// @Description  give: owner_id's note goes to holder_id (+1 VP). return: the note goes back to its owner (-1 VP for the holder).
+// @Description  eliminate: player_id is eliminated; their note is purged (-1 VP for its holder) and notes they hold return to their owners.
+// @Tags         scoring
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                          true  "Game ID"
+// @Param        body     body      models.SupportActionRequest  true  "Support action"
+// @Success      200      {array}   models.SupportHoldingDTO
+// @Failure      400      {object}  map[string]string  "error"
+// @Failure      404      {object}  map[string]string  "error"
+// @Failure      409      {object}  map[string]string  "error: breaks a Support for the Throne rule"
+// @Failure      422      {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/support [post]
+func HandleSupportAction(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.SupportActionRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	if err := services.ApplySupportAction(requestContext(c), gameID, req); err != nil {
+		return 0, nil, err
+	}
+
+	out, err := services.GetSupportHoldings(gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
+
+// GetGameSupport godoc
+// @Summary      Support for the Throne in a game
+// @ID           GetGameSupport
+// @Description  Every Support for the Throne given in the game; entries without ended_at are still held.
+// @Tags         scoring,games
+// @Produce      json
+// @Param        id   path      int  true  "Game ID"
+// @Success      200  {array}   models.SupportHoldingDTO
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/support [get]
+func GetGameSupport(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	out, err := services.GetSupportHoldings(gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
+
 // GetScoreSummary godoc
-// @Summary      Player score summary
-// @Tags         scoring,players
-// @Param        id   path      string  true  "Player ID"
+// @Summary      Points per player in a game
+// @ID           GetScoreSummary
+// @Tags         scoring,games
+// @Param        id   path      int     true  "Game ID"
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}
+// @Success      200  {array}   models.PlayerScoreSummary
 // @Failure      404  {object}  map[string]string  "error"
-// @Router       /players/{id}/scores/summary [get]
+// @Router       /api/v1/games/{id}/scores/summary [get]
 func GetScoreSummary(c *gin.Context) (int, any, error) {
 	id := c.Param("id")
 	summary, err := services.GetScoreSummaryByPlayer(id)
@@ -227,13 +316,14 @@ func GetScoreSummary(c *gin.Context) (int, any, error) {
 }
 
 // GetScoresByRound godoc
-// @Summary      Player scores by round
-// @Tags         scoring,players
-// @Param        id   path      string  true  "Player ID"
+// @Summary      Scores in a game by round
+// @ID           GetScoresByRound
+// @Tags         scoring,games
+// @Param        id   path      int     true  "Game ID"
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}
+// @Success      200  {array}   models.RoundScoresGroup
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /players/{id}/scores/by-round [get]
+// @Router       /api/v1/games/{id}/scores/by-round [get]
 func GetScoresByRound(c *gin.Context) (int, any, error) {
 	id := c.Param("id")
 	groupedScores, err := services.GetScoresGroupedByRound(id)
@@ -245,19 +335,21 @@ func GetScoresByRound(c *gin.Context) (int, any, error) {
 
 // GetObjectiveScoreSummary godoc
 // @Summary      Objective score summary for a game
+// @ID           GetObjectiveScoreSummary
 // @Tags         scoring,games
 // @Param        id   path      int  true  "Game ID"
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}
+// @Success      200  {array}   models.ObjectiveScoreSummary
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /games/{id}/scores/objectives/summary [get]
+// @Router       /api/v1/games/{id}/objectives/scores [get]
 func GetObjectiveScoreSummary(c *gin.Context) (int, any, error) {
-	gameID, err := strconv.Atoi(c.Param("id"))
+	gameID, err := handle.ParseID(c, "id")
 	if err != nil {
-		return http.StatusBadRequest, gin.H{"error": "Invalid game ID"}, nil
+		return 0, nil, err
 	}
-	summary, err := services.GetObjectiveScoreSummary(uint(gameID))
+	summary, err := services.GetObjectiveScoreSummary(gameID)
 	if err != nil {
 		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
 	}
@@ -266,25 +358,50 @@ func GetObjectiveScoreSummary(c *gin.Context) (int, any, error) {
 
 // ScoreImperialRiderPoint godoc
 // @Summary      Score Imperial Rider point
+// @ID           ScoreImperialRiderPoint
+// @Description  Shorthand for recording the Imperial Rider card effect; see POST /api/v1/games/{id}/card-effects.
 // @Tags         scoring
 // @Accept       json
 // @Produce      json
-// @Param        body  body      object  true  "game_id, round_id, player_id"
+// @Param        id    path      int  true  "Game ID"
+// @Param        body  body      models.PointRequest  true  "Player awarded the point"
 // @Success      204
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /score/imperial-rider [post]
+// @Router       /api/v1/games/{id}/scores/imperial-rider [post]
 func ScoreImperialRiderPoint(c *gin.Context) (int, any, error) {
-	var input struct {
-		GameID   uint `json:"game_id"`
-		PlayerID uint `json:"player_id"`
-		RoundID  uint `json:"round_id"`
-	}
-	if err := c.ShouldBindJSON(&input); err != nil {
-		return http.StatusBadRequest, gin.H{"error": err.Error()}, nil
+	var input models.PointRequest
+	if err := bindGameJSON(c, &input, &input.GameID); err != nil {
+		return 0, nil, err
 	}
-	if err := services.ScoreImperialRiderPoint(input.GameID, input.RoundID, input.PlayerID); err != nil {
-		return http.StatusInternalServerError, gin.H{"error": err.Error()}, nil
+	if err := services.ScoreImperialRiderPoint(requestContext(c), input.GameID, input.RoundID, input.PlayerID); err != nil {
+		return 0, nil, err
 	}
 	return http.StatusNoContent, nil, nil
 }
+
+// GetVPBreakdown godoc
+// @Summary      Victory points by source for a game
+// @ID           GetVPBreakdown
+// @Description  Each player's points grouped by source (public, secret, mecatol, imperial, support, relic, agenda, action_card),
+// @Description  listing the objective, agenda, relic or action card behind every award.
+// @Tags         scoring,games
+// @Param        id   path      int  true  "Game ID"
+// @Produce      json
+// @Success      200  {array}   models.PlayerVPBreakdown
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/vp-breakdown [get]
+func GetVPBreakdown(c *gin.Context) (int, any, error) {
+	if _, err := handle.ParseID(c, "id"); err != nil {
+		return 0, nil, err
+	}
+	breakdown, err := services.GetVPBreakdown(c.Param("id"))
+	if err != nil {
+		return http.StatusNotFound, gin.H{"error": err.Error()}, nil
+	}
+	return http.StatusOK, breakdown, nil
+}
@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"net/http"
+
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+// GetGameSessions godoc
+// @Summary      Game sessions
+// @ID           GetGameSessions
+// @Description  The evenings a game has been played over and those scheduled, with RSVPs and the time played so far.
+// @Description  New games start their first session when they are created.
+// @Tags         sessions,games
+// @Produce      json
+// @Param        id   path      int  true  "Game ID"
+// @Success      200  {object}  models.GameSessions
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/sessions [get]
+func GetGameSessions(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	out, err := services.GetGameSessions(gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
+
+// ScheduleSession godoc
+// @Summary      Schedule a session
+// @ID           ScheduleSession
+// @Description  Plans another evening of an unfinished game. The location defaults to the game's.
+// @Tags         sessions
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                            true  "Game ID"
+// @Param        body  body      models.ScheduleSessionRequest  true  "Start time and place"
+// @Success      201   {object}  models.GameSession
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      404   {object}  map[string]string  "error"
+// @Failure      409   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/sessions [post]
+func ScheduleSession(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.ScheduleSessionRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	s, err := services.ScheduleSession(requestContext(c), gameID, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, s, nil
+}
+
+// CancelSession godoc
+// @Summary      Cancel a scheduled session
+// @ID           CancelSession
+// @Description  Deletes a session that has not started, with its RSVPs.
+// @Tags         sessions
+// @Param        id          path  int  true  "Game ID"
+// @Param        session_id  path  int  true  "Session ID"
+// @Success      204
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/sessions/{session_id} [delete]
+func CancelSession(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	sessionID, err := handle.ParseID(c, "session_id")
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := services.CancelSession(requestContext(c), gameID, sessionID); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+// RespondToSession godoc
+// @Summary      RSVP to a session
+// @ID           RespondToSession
+// @Description  Records whether a player in the game is coming: yes, no or maybe. A later answer replaces the earlier one.
+// @Tags         sessions
+// @Accept       json
+// @Produce      json
+// @Param        id          path      int                 true  "Game ID"
+// @Param        session_id  path      int                 true  "Session ID"
+// @Param        body        body      models.RSVPRequest  true  "Player and answer"
+// @Success      200         {object}  models.GameSession
+// @Failure      400         {object}  map[string]string  "error"
+// @Failure      404         {object}  map[string]string  "error"
+// @Failure      409         {object}  map[string]string  "error"
+// @Failure      422         {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/sessions/{session_id}/rsvps [post]
+func RespondToSession(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	sessionID, err := handle.ParseID(c, "session_id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.RSVPRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	s, err := services.RespondToSession(requestContext(c), gameID, sessionID, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, s, nil
+}
+
+// PauseGame godoc
+// @Summary      Pause a game
+// @ID           PauseGame
+// @Description  Ends the running session, and the running turn, when a game stops for the evening. Paused games do not count towards their duration, and turns cannot be started until the game is resumed.
+// @Tags         sessions
+// @Produce      json
+// @Param        id   path      int  true  "Game ID"
+// @Success      200  {object}  models.GameSession
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/sessions/pause [post]
+func PauseGame(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	s, err := services.PauseGame(requestContext(c), gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, s, nil
+}
+
+// ResumeGame godoc
+// @Summary      Resume a game
+// @ID           ResumeGame
+// @Description  Starts the earliest scheduled session that has not started, or a new session if none is scheduled.
+// @Tags         sessions
+// @Produce      json
+// @Param        id   path      int  true  "Game ID"
+// @Success      200  {object}  models.GameSession
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/games/{id}/sessions/resume [post]
+func ResumeGame(c *gin.Context) (int, any, error) {
+	gameID, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	s, err := services.ResumeGame(requestContext(c), gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, s, nil
+}
+
+// GetUpcomingSessions godoc
+// @Summary      Upcoming sessions
+// @ID           GetUpcomingSessions
+// @Description  Scheduled sessions of unfinished games that have not started yet, soonest first, with RSVPs.
+// @Tags         sessions
+// @Produce      json
+// @Success      200  {array}   models.UpcomingSession
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/sessions/upcoming [get]
+func GetUpcomingSessions(c *gin.Context) (int, any, error) {
+	out, err := services.UpcomingSessions()
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
+
+// ExportUpcomingSessionsICal godoc
+// @Summary      Upcoming sessions as iCalendar
+// @ID           ExportUpcomingSessionsICal
+// @Description  The upcoming sessions as a calendar feed to subscribe to. Each event lasts four hours and lists who is coming.
+// @Tags         sessions,export
+// @Produce      text/calendar
+// @Success      200  {file}    file
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/sessions/upcoming.ics [get]
+func ExportUpcomingSessionsICal(c *gin.Context) {
+	cal, err := services.UpcomingSessionsICal()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", `inline; filename="ti4-sessions.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(cal))
+}
@@ -10,31 +10,45 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// RefreshStatsOnWrite invalidates the stats snapshot after each write that
+// succeeded. It runs once the handler has returned, so the handler's
+// transaction has committed before the rebuild reads the data.
+func RefreshStatsOnWrite() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			return
+		}
+		if c.Writer.Status() < http.StatusBadRequest {
+			services.InvalidateStatsSnapshot()
+		}
+	}
+}
+
 // GetStatsOverview godoc
 // @Summary      Stats overview
+// @ID           GetStatsOverview
 // @Description  Returns headline stats plus Custodians (Mecatol) stats per player.
+// @Description  Served from a snapshot that is rebuilt after every write.
 // @Tags         stats
 // @Produce      json
-// @Success      200  {object}  map[string]interface{}
+// @Success      200  {object}  services.StatsOverview
 // @Failure      500  {object}  map[string]string  "error"
-// @Router       /stats/overview [get]
+// @Router       /api/v1/stats/overview [get]
 func GetStatsOverview(c *gin.Context) (int, any, error) {
-	overview, err := services.CalculateStatsOverview()
+	overview, err := services.GetStatsSnapshot()
 	if err != nil {
 		return 0, nil, fmt.Errorf("failed to generate overview stats: %w", err)
 	}
-
-	custodians, err := services.GetPlayerCustodiansStats()
-	if err != nil {
-		return 0, nil, fmt.Errorf("failed to generate custodians stats: %w", err)
-	}
-
-	overview.CustodiansStats = custodians
 	return http.StatusOK, overview, nil
 }
 
 // GetObjectiveDifficulty godoc
 // @Summary      Get objective difficulty
+// @ID           GetObjectiveDifficulty
 // @Description  Calculates and returns difficulty metrics for TI4 objectives.
 // @Tags         objectives, stats
 // @Produce      json
@@ -43,7 +57,7 @@ func GetStatsOverview(c *gin.Context) (int, any, error) {
 // @Param        minOpportunities  query   int     false  "Minimum scoring opportunities required"   default(0)
 // @Success      200  {object}  models.ObjectiveDifficultyResponse
 // @Failure      500  {object}  map[string]string "Internal server error"
-// @Router       /stats/objectives/difficulty [get]
+// @Router       /api/v1/stats/objectives/difficulty [get]
 func GetObjectiveDifficulty(c *gin.Context) (int, any, error) {
 	stage := c.DefaultQuery("stage", "all")
 	minApp := parseIntDefault(c.Query("minAppearances"), 5)
@@ -74,3 +88,38 @@ func parseIntDefault(s string, def int) int {
 	}
 	return v
 }
+
+// GetSupportStats godoc
+// @Summary      Support for the Throne stats
+// @ID           GetSupportStats
+// @Description  Who gives Support for the Throne to whom, how often it is returned and how often it is still held at game end.
+// @Tags         stats
+// @Produce      json
+// @Success      200  {object}  models.SupportStats
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/stats/support [get]
+func GetSupportStats(c *gin.Context) (int, any, error) {
+	out, err := services.GetSupportStats()
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to calculate support stats: %w", err)
+	}
+	return http.StatusOK, out, nil
+}
+
+// GetClockStats godoc
+// @Summary      Game clock stats
+// @ID           GetClockStats
+// @Description  Average round length by round number, players by average turn length and time spent in each phase.
+// @Description  Only games played with round timestamps or the turn timer are included.
+// @Tags         stats,clock
+// @Produce      json
+// @Success      200  {object}  models.ClockStats
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/stats/clock [get]
+func GetClockStats(c *gin.Context) (int, any, error) {
+	out, err := services.GetClockStats()
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to calculate clock stats: %w", err)
+	}
+	return http.StatusOK, out, nil
+}
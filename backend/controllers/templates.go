@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"net/http"
+
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/arphillips06/TI4-stats/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ListGameTemplates godoc
+// @Summary      Game templates
+// @ID           ListGameTemplates
+// @Description  Saved game setups, by name.
+// @Tags         templates
+// @Produce      json
+// @Success      200  {array}   models.GameTemplate
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /api/v1/templates [get]
+func ListGameTemplates(c *gin.Context) (int, any, error) {
+	out, err := services.ListGameTemplates()
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, out, nil
+}
+
+// GetGameTemplate godoc
+// @Summary      Game template
+// @ID           GetGameTemplate
+// @Tags         templates
+// @Produce      json
+// @Param        id   path      int  true  "Template ID"
+// @Success      200  {object}  models.GameTemplate
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/templates/{id} [get]
+func GetGameTemplate(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	t, err := services.GetGameTemplate(id)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, t, nil
+}
+
+// CreateGameTemplate godoc
+// @Summary      Save a game template
+// @ID           CreateGameTemplate
+// @Description  winning_points may be any target from 1 to 30. speaker_mode is random (default), first (the first listed player) or none.
+// @Description  Players without a faction draw one from faction_pool, or from every faction when the pool is empty. Factions are stored under their catalogue names.
+// @Tags         templates
+// @Accept       json
+// @Produce      json
+// @Param        body  body      models.GameTemplate  true  "Template"
+// @Success      201   {object}  models.GameTemplate
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/templates [post]
+func CreateGameTemplate(c *gin.Context) (int, any, error) {
+	var t models.GameTemplate
+	if err := bindJSON(c, &t); err != nil {
+		return 0, nil, err
+	}
+	created, err := services.CreateGameTemplate(requestContext(c), t)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, created, nil
+}
+
+// UpdateGameTemplate godoc
+// @Summary      Replace a game template
+// @ID           UpdateGameTemplate
+// @Description  Games already created from the template are not changed.
+// @Tags         templates
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                  true  "Template ID"
+// @Param        body  body      models.GameTemplate  true  "Template"
+// @Success      200   {object}  models.GameTemplate
+// @Failure      400   {object}  map[string]string  "error"
+// @Failure      404   {object}  map[string]string  "error"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/templates/{id} [put]
+func UpdateGameTemplate(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var t models.GameTemplate
+	if err := bindJSON(c, &t); err != nil {
+		return 0, nil, err
+	}
+	updated, err := services.UpdateGameTemplate(requestContext(c), id, t)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, updated, nil
+}
+
+// DeleteGameTemplate godoc
+// @Summary      Delete a game template
+// @ID           DeleteGameTemplate
+// @Description  Games created from the template are kept.
+// @Tags         templates
+// @Param        id   path  int  true  "Template ID"
+// @Success      204
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      422  {object}  models.ValidationErrorResponse  "invalid fields"
+// @Router       /api/v1/templates/{id} [delete]
+func DeleteGameTemplate(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := services.DeleteGameTemplate(requestContext(c), id); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+// CreateGameFromTemplate godoc
+// @Summary      Create a game from a template
+// @ID           CreateGameFromTemplate
+// @Description  Creates a game with the template's points target, deck mode, speaker mode and house rules.
+// @Description  The body is optional: players replace the template's seats, and title, notes and location describe the game.
+// @Tags         games,templates
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                             true   "Template ID"
+// @Param        body  body      models.GameFromTemplateRequest  false  "Changes for this game"
+// @Success      201   {object}  models.CreateGameResponse
+// @Failure      400   {object}  map[string]string             "error"
+// @Failure      404   {object}  map[string]string             "error"
+// @Failure      409   {object}  models.UnknownPlayerResponse  "a name looks like a typo of an existing player"
+// @Failure      422   {object}  models.ValidationErrorResponse  "invalid fields"
+// @Failure      500   {object}  map[string]string             "error"
+// @Router       /api/v1/games/from-template/{id} [post]
+func CreateGameFromTemplate(c *gin.Context) (int, any, error) {
+	id, err := handle.ParseID(c, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req models.GameFromTemplateRequest
+	if err := bindJSON(c, &req); err != nil {
+		return 0, nil, err
+	}
+	game, revealed, err := services.CreateGameFromTemplate(requestContext(c), id, req)
+	return createGameResponse(c, http.StatusCreated, game, revealed, err)
+}
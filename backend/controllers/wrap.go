@@ -1,7 +1,10 @@
 package controllers
 
 import (
+	"context"
+
 	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers"
 	"github.com/gin-gonic/gin"
 )
 
@@ -21,3 +24,41 @@ func Wrap(handler RespondingHandler) gin.HandlerFunc {
 		c.JSON(status, payload)
 	}
 }
+
+// requestContext is the context for a handler's database work. It carries
+// the audit log's row counter but is not cancelled when the client hangs
+// up, so a write is never abandoned halfway.
+func requestContext(c *gin.Context) context.Context {
+	return context.WithoutCancel(c.Request.Context())
+}
+
+// bindJSON decodes and validates a request body. Return its error from a
+// wrapped handler: Handle answers 400 for a body that is not JSON and 422
+// with the invalid fields otherwise.
+func bindJSON(c *gin.Context, obj any) error {
+	if err := helpers.DecodeJSON(c, obj); err != nil {
+		return err
+	}
+	return helpers.Validate(obj)
+}
+
+// bindGameJSON is bindJSON for a body that names its game in game_id.
+// Legacy routes send it in the body; on /api/v1 routes it comes from the
+// :id path parameter, and a game_id in the body is then optional but must
+// match.
+func bindGameJSON(c *gin.Context, obj any, gameID *uint) error {
+	if err := helpers.DecodeJSON(c, obj); err != nil {
+		return err
+	}
+	if c.Param("id") != "" {
+		id, err := handle.ParseID(c, "id")
+		if err != nil {
+			return err
+		}
+		if *gameID != 0 && *gameID != id {
+			return handle.Invalid("game_id", "does not match game %d in the path", id)
+		}
+		*gameID = id
+	}
+	return helpers.Validate(obj)
+}
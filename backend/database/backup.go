@@ -0,0 +1,287 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// ErrBackupUnsupported is returned when the database is not SQLite.
+// PostgreSQL databases are backed up with pg_dump.
+var ErrBackupUnsupported = errors.New("backups are only supported for the sqlite driver; use pg_dump for postgres")
+
+const (
+	backupPrefix     = "ti4stats-"
+	backupTimeFormat = "20060102-150405"
+)
+
+// requiredTables must exist in a file before it can be restored.
+var requiredTables = []string{"games", "players", "scores", "rounds", "game_players"}
+
+// SQLitePath returns the file behind a sqlite DSN such as ti4stats.db or
+// file:ti4stats.db?_pragma=busy_timeout(5000).
+func SQLitePath(dsn string) string {
+	path := strings.TrimPrefix(dsn, "file:")
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+// Backup writes a consistent copy of the open database into dir with
+// VACUUM INTO, which is safe while the server is running, then deletes all
+// but the newest keep backups.
+func Backup(dir string, keep int) (models.Backup, error) {
+	if DB.Dialector.Name() != "sqlite" {
+		return models.Backup{}, ErrBackupUnsupported
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return models.Backup{}, err
+	}
+
+	now := time.Now()
+	path := filepath.Join(dir, backupPrefix+now.Format(backupTimeFormat)+".db")
+	for i := 1; fileExists(path); i++ {
+		path = filepath.Join(dir, fmt.Sprintf("%s%s-%d.db", backupPrefix, now.Format(backupTimeFormat), i))
+	}
+	if err := DB.Exec("VACUUM INTO ?", path).Error; err != nil {
+		return models.Backup{}, fmt.Errorf("backup to %s: %w", path, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return models.Backup{}, err
+	}
+
+	removed, err := rotateBackups(dir, keep)
+	return models.Backup{File: path, Size: info.Size(), CreatedAt: now, Removed: removed}, err
+}
+
+// rotateBackups deletes the oldest backups in dir so that at most keep are
+// left. Pre-restore safety copies are not counted or deleted.
+func rotateBackups(dir string, keep int) ([]string, error) {
+	backups, err := ListBackups(dir)
+	if err != nil || len(backups) <= keep {
+		return nil, err
+	}
+	var removed []string
+	for _, b := range backups[keep:] {
+		if err := os.Remove(b.File); err != nil {
+			return removed, err
+		}
+		removed = append(removed, b.File)
+	}
+	return removed, nil
+}
+
+// ListBackups returns the backups in dir, newest first.
+func ListBackups(dir string) ([]models.Backup, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out []models.Backup
+	for _, e := range entries {
+		name := e.Name()
+		stamp, ok := strings.CutPrefix(strings.TrimSuffix(name, ".db"), backupPrefix)
+		if e.IsDir() || !ok || !strings.HasSuffix(name, ".db") || len(stamp) < len(backupTimeFormat) {
+			continue
+		}
+		created, err := time.ParseInLocation(backupTimeFormat, stamp[:len(backupTimeFormat)], time.Local)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, models.Backup{File: filepath.Join(dir, name), Size: info.Size(), CreatedAt: created})
+	}
+	// Backups taken within the same second are numbered -1, -2, ...
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		if len(a.File) != len(b.File) {
+			return len(a.File) > len(b.File)
+		}
+		return a.File > b.File
+	})
+	return out, nil
+}
+
+// CheckBackup opens path read only and checks that it is an intact TI4
+// stats database.
+func CheckBackup(path string) error {
+	if !fileExists(path) {
+		return fmt.Errorf("%s does not exist", path)
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	// Build the URI properly so that names with '?', '#' or '%' still open.
+	uri := url.URL{Scheme: "file", Path: filepath.ToSlash(abs), RawQuery: "mode=ro"}
+	db, err := sql.Open("sqlite", uri.String())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var result string
+	if err := db.QueryRow("PRAGMA integrity_check").Scan(&result); err != nil {
+		return fmt.Errorf("%s is not a SQLite database: %w", path, err)
+	}
+	if result != "ok" {
+		return fmt.Errorf("%s failed the integrity check: %s", path, result)
+	}
+	for _, table := range requiredTables {
+		var n int
+		if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&n); err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("%s is not a TI4 stats database: table %s is missing", path, table)
+		}
+	}
+	return nil
+}
+
+// Restore replaces the SQLite database at dsn with the backup at src. The
+// backup is checked first, and the current database is copied into
+// backupDir as ti4stats-pre-restore-<time>.db before it is replaced. The
+// server must not be running: Restore refuses while something answers on
+// listenAddress or holds a lock on the database. It returns the path of
+// the safety copy, or "" when there was no database to copy.
+func Restore(src, dsn, backupDir, listenAddress string) (string, error) {
+	if err := CheckBackup(src); err != nil {
+		return "", err
+	}
+	dst := SQLitePath(dsn)
+	if err := checkNotInUse(dst, listenAddress); err != nil {
+		return "", err
+	}
+
+	var safety string
+	if fileExists(dst) {
+		if err := os.MkdirAll(backupDir, 0o755); err != nil {
+			return "", err
+		}
+		safety = filepath.Join(backupDir, backupPrefix+"pre-restore-"+time.Now().Format(backupTimeFormat)+".db")
+		current, err := sql.Open("sqlite", dst)
+		if err != nil {
+			return "", err
+		}
+		_, err = current.Exec("VACUUM INTO ?", safety)
+		current.Close()
+		if err != nil {
+			return "", fmt.Errorf("safety copy of %s: %w", dst, err)
+		}
+	}
+
+	// Copy next to the target and rename, so a failed copy leaves the
+	// current database in place.
+	tmp := dst + ".restore"
+	if err := copyFile(src, tmp); err != nil {
+		os.Remove(tmp)
+		return safety, err
+	}
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		if err := os.Remove(dst + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			os.Remove(tmp)
+			return safety, err
+		}
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		os.Remove(tmp)
+		return safety, err
+	}
+	return safety, nil
+}
+
+// checkNotInUse fails when a server answers on listenAddress or another
+// connection holds a lock on the database at path.
+func checkNotInUse(path, listenAddress string) error {
+	if listenAddress != "" {
+		conn, err := net.DialTimeout("tcp", dialAddress(listenAddress), time.Second)
+		if err == nil {
+			conn.Close()
+			return fmt.Errorf("a server is answering on %s; stop it before restoring", listenAddress)
+		}
+	}
+	if !fileExists(path) {
+		return nil
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// Without a busy timeout this fails at once if anyone is reading or
+	// writing.
+	if _, err := conn.ExecContext(ctx, "BEGIN EXCLUSIVE"); err != nil {
+		return fmt.Errorf("%s is in use; stop the server before restoring: %w", path, err)
+	}
+	_, err = conn.ExecContext(ctx, "ROLLBACK")
+	return err
+}
+
+// dialAddress turns a listen address such as :8080 or 0.0.0.0:8080 into one
+// that can be dialled.
+func dialAddress(listenAddress string) string {
+	host, port, err := net.SplitHostPort(listenAddress)
+	if err != nil {
+		return listenAddress
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, port)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,211 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm/logger"
+)
+
+// openTestSQLite points DB at a new SQLite file in a temporary directory
+// and returns its path.
+func openTestSQLite(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ti4stats.db")
+	InitDatabase("sqlite", path, logger.Silent)
+	t.Cleanup(closeDB)
+	return path
+}
+
+func closeDB() {
+	if sqlDB, err := DB.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
+
+func countPlayers(t *testing.T, path string) int {
+	t.Helper()
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM players").Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestBackupRotates(t *testing.T) {
+	openTestSQLite(t)
+	dir := t.TempDir()
+
+	var files []string
+	for i := 0; i < 3; i++ {
+		b, err := Backup(dir, 2)
+		if err != nil {
+			t.Fatalf("backup %d: %v", i, err)
+		}
+		if err := CheckBackup(b.File); err != nil {
+			t.Fatalf("backup %d does not check out: %v", i, err)
+		}
+		files = append(files, b.File)
+	}
+
+	backups, err := ListBackups(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("got %d backups, want 2", len(backups))
+	}
+	if backups[0].File != files[2] || backups[1].File != files[1] {
+		t.Errorf("kept %s and %s, want the newest two of %v", backups[0].File, backups[1].File, files)
+	}
+	if fileExists(files[0]) {
+		t.Errorf("oldest backup %s was not removed", files[0])
+	}
+}
+
+func TestCheckBackup(t *testing.T) {
+	dir := t.TempDir()
+
+	garbage := filepath.Join(dir, "garbage.db")
+	if err := os.WriteFile(garbage, []byte("not a database"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckBackup(garbage); err == nil {
+		t.Error("a file that is not SQLite passed the check")
+	}
+
+	other := filepath.Join(dir, "other.db")
+	db, err := sql.Open("sqlite", other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("CREATE TABLE games (id INTEGER)")
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckBackup(other); err == nil || !strings.Contains(err.Error(), "is missing") {
+		t.Errorf("a database without the stats tables: got %v", err)
+	}
+
+	if err := CheckBackup(filepath.Join(dir, "missing.db")); err == nil {
+		t.Error("a missing file passed the check")
+	}
+}
+
+func TestCheckBackupOddFileName(t *testing.T) {
+	openTestSQLite(t)
+	b, err := Backup(t.TempDir(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	odd := filepath.Join(t.TempDir(), "copy #1 ?100%.db")
+	if err := copyFile(b.File, odd); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckBackup(odd); err != nil {
+		t.Errorf("CheckBackup(%q): %v", odd, err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	path := openTestSQLite(t)
+	dir := t.TempDir()
+
+	if err := DB.Create(&models.Player{Name: "Alice"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	b, err := Backup(dir, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DB.Create(&models.Player{Name: "Bob"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	closeDB()
+
+	safety, err := Restore(b.File, path, dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := countPlayers(t, path); got != 1 {
+		t.Errorf("restored database has %d players, want 1", got)
+	}
+	if safety == "" {
+		t.Fatal("no safety copy was made")
+	}
+	if got := countPlayers(t, safety); got != 2 {
+		t.Errorf("safety copy has %d players, want 2", got)
+	}
+}
+
+func TestRestoreRefusesLockedDatabase(t *testing.T) {
+	path := openTestSQLite(t)
+	dir := t.TempDir()
+	b, err := Backup(dir, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DB.Create(&models.Player{Name: "Alice"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	sqlDB, err := DB.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "BEGIN IMMEDIATE"); err != nil {
+		t.Fatal(err)
+	}
+	defer conn.ExecContext(ctx, "ROLLBACK")
+
+	if _, err := Restore(b.File, path, dir, ""); err == nil {
+		t.Fatal("restored over a locked database")
+	}
+	if got := countPlayers(t, path); got != 1 {
+		t.Errorf("database has %d players after a refused restore, want 1", got)
+	}
+}
+
+func TestRestoreRefusesRunningServer(t *testing.T) {
+	path := openTestSQLite(t)
+	dir := t.TempDir()
+	b, err := Backup(dir, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeDB()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+
+	for _, addr := range []string{l.Addr().String(), ":" + port} {
+		if _, err := Restore(b.File, path, dir, addr); err == nil || !strings.Contains(err.Error(), "answering") {
+			t.Errorf("listen address %s: got %v", addr, err)
+		}
+	}
+	if fileExists(path + ".restore") {
+		t.Error("a refused restore left a partial copy behind")
+	}
+}
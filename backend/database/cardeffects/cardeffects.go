@@ -0,0 +1,14 @@
+package cardeffects
+
+import "github.com/arphillips06/TI4-stats/models"
+
+// All is the seeded catalogue of cards that change victory points. Anything
+// else is added as homebrew through the API.
+var All = []models.CardEffect{
+	{
+		Name:          models.ActionCardImperialRider,
+		Kind:          models.CardKindActionCard,
+		Description:   "After an agenda is revealed: predict an outcome. If your prediction is correct, gain 1 victory point.",
+		VictoryPoints: 1,
+	},
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// changeCounter tallies the rows written per table on behalf of one
+// request. It travels in the context given to DB.WithContext.
+type changeCounter struct {
+	sync.Mutex
+	rows map[string]int64
+}
+
+type changeCounterKey struct{}
+
+// untracked tables are left out of the tally so the audit log does not
+// count itself.
+var untracked = map[string]bool{"audit_logs": true}
+
+// registerChangeCounter hooks the create, update, delete and raw callbacks
+// to count the rows each statement affected, for statements whose context
+// comes from TrackChanges.
+func registerChangeCounter(db *gorm.DB) error {
+	count := func(tx *gorm.DB) {
+		if tx.Error != nil || tx.RowsAffected <= 0 || tx.Statement.Context == nil {
+			return
+		}
+		counter, ok := tx.Statement.Context.Value(changeCounterKey{}).(*changeCounter)
+		if !ok {
+			return
+		}
+		table := tx.Statement.Table
+		if table == "" {
+			table = "raw"
+		}
+		if untracked[table] {
+			return
+		}
+		counter.Lock()
+		counter.rows[table] += tx.RowsAffected
+		counter.Unlock()
+	}
+
+	cb := db.Callback()
+	if err := cb.Create().After("gorm:create").Register("ti4:count_changes", count); err != nil {
+		return err
+	}
+	if err := cb.Update().After("gorm:update").Register("ti4:count_changes", count); err != nil {
+		return err
+	}
+	if err := cb.Delete().After("gorm:delete").Register("ti4:count_changes", count); err != nil {
+		return err
+	}
+	return cb.Raw().After("gorm:raw").Register("ti4:count_changes", count)
+}
+
+// TrackChanges returns a context that counts the rows written by
+// statements run with it, through DB.WithContext, and a function that
+// reports the counts by table.
+func TrackChanges(ctx context.Context) (context.Context, func() map[string]int64) {
+	counter := &changeCounter{rows: map[string]int64{}}
+	ctx = context.WithValue(ctx, changeCounterKey{}, counter)
+	return ctx, func() map[string]int64 {
+		counter.Lock()
+		defer counter.Unlock()
+		rows := counter.rows
+		counter.rows = map[string]int64{}
+		return rows
+	}
+}
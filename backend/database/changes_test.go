@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+func TestTrackChangesPerContext(t *testing.T) {
+	openTestSQLite(t)
+
+	const requests, players = 4, 5
+	counts := make([]map[string]int64, requests)
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx, done := TrackChanges(context.Background())
+			for j := 0; j < players; j++ {
+				p := models.Player{Name: fmt.Sprintf("player %d-%d", i, j)}
+				if err := DB.WithContext(ctx).Create(&p).Error; err != nil {
+					t.Error(err)
+				}
+			}
+			counts[i] = done()
+		}(i)
+	}
+	// Untracked writes are not counted anywhere.
+	if err := DB.Create(&models.Player{Name: "untracked"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+
+	for i, rows := range counts {
+		if rows["players"] != players || len(rows) != 1 {
+			t.Errorf("request %d counted %v, want %d players", i, rows, players)
+		}
+	}
+}
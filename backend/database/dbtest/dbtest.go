@@ -0,0 +1,111 @@
+// Package dbtest opens a fresh, migrated database for tests, on SQLite and,
+// when TI4_TEST_POSTGRES_DSN is set, on PostgreSQL.
+package dbtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// PostgresEnv names the variable holding the DSN of a PostgreSQL database
+// the tests may create schemas in.
+const PostgresEnv = "TI4_TEST_POSTGRES_DSN"
+
+// Drivers are the database drivers tests run against.
+var Drivers = []string{"sqlite", "postgres"}
+
+// Run runs fn as a subtest for each driver, with database.DB pointing at a
+// new database that has been seeded and migrated. The PostgreSQL subtest is
+// skipped unless TI4_TEST_POSTGRES_DSN is set.
+func Run(t *testing.T, fn func(t *testing.T)) {
+	for _, driver := range Drivers {
+		t.Run(driver, func(t *testing.T) {
+			Open(t, driver)
+			fn(t)
+		})
+	}
+}
+
+// Open points database.DB at a new database on driver, seeds the
+// catalogues and runs the migrations, as the server does on start-up. The
+// database is closed, and a PostgreSQL schema dropped, when the test ends.
+func Open(t *testing.T, driver string) {
+	t.Helper()
+	var dsn string
+	switch driver {
+	case "sqlite":
+		dsn = filepath.Join(t.TempDir(), "ti4stats.db")
+	case "postgres":
+		dsn = postgresSchema(t)
+	default:
+		t.Fatalf("unknown database driver %q", driver)
+	}
+
+	database.InitDatabase(driver, dsn, logger.Silent)
+	t.Cleanup(func() {
+		if sqlDB, err := database.DB.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	database.SeedObjectives()
+	database.SeedRelics()
+	database.SeedCardEffects()
+	database.SeedFactions()
+	database.RunMigrations()
+}
+
+// postgresSchema creates a schema of its own for the test in the database
+// named by TI4_TEST_POSTGRES_DSN, and returns a DSN that uses it. It skips
+// the test when the variable is not set.
+func postgresSchema(t *testing.T) string {
+	t.Helper()
+	dsn := os.Getenv(PostgresEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", PostgresEnv)
+	}
+
+	admin, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("connect to %s: %v", PostgresEnv, err)
+	}
+	sqlDB, err := admin.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suffix := make([]byte, 6)
+	if _, err := rand.Read(suffix); err != nil {
+		t.Fatal(err)
+	}
+	schema := "ti4_test_" + hex.EncodeToString(suffix)
+	if err := admin.Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		sqlDB.Close()
+		t.Fatalf("create schema %s: %v", schema, err)
+	}
+	t.Cleanup(func() {
+		if err := admin.Exec("DROP SCHEMA " + schema + " CASCADE").Error; err != nil {
+			t.Errorf("drop schema %s: %v", schema, err)
+		}
+		sqlDB.Close()
+	})
+
+	// The DSN is either a URL or keyword/value pairs.
+	if strings.Contains(dsn, "://") {
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		return fmt.Sprintf("%s%ssearch_path=%s", dsn, sep, schema)
+	}
+	return fmt.Sprintf("%s search_path=%s", dsn, schema)
+}
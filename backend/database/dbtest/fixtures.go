@@ -0,0 +1,72 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// SeedFinishedGame records a three round game that Alice won as Arborec
+// with 10 points, ahead of Bob, who took the Custodians token, and Carol on
+// 4 points each.
+func SeedFinishedGame(t *testing.T) models.Game {
+	t.Helper()
+	db := database.DB
+
+	var objectives []models.Objective
+	if err := db.Where("stage = ?", "I").Order("id").Limit(3).Find(&objectives).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	players := []models.Player{{Name: "Alice"}, {Name: "Bob"}, {Name: "Carol"}}
+	if err := db.Create(&players).Error; err != nil {
+		t.Fatal(err)
+	}
+	alice, bob, carol := players[0].ID, players[1].ID, players[2].ID
+
+	started := time.Now().Add(-4 * time.Hour)
+	finished := time.Now()
+	game := models.Game{
+		GameNumber:    1,
+		CreatedAt:     started,
+		FinishedAt:    &finished,
+		WinnerID:      &alice,
+		WinningPoints: 10,
+		CurrentRound:  3,
+	}
+	if err := db.Create(&game).Error; err != nil {
+		t.Fatal(err)
+	}
+	rounds := []models.Round{{GameID: game.ID, Number: 1}, {GameID: game.ID, Number: 2}, {GameID: game.ID, Number: 3}}
+	if err := db.Create(&rounds).Error; err != nil {
+		t.Fatal(err)
+	}
+	gamePlayers := []models.GamePlayer{
+		{GameID: game.ID, PlayerID: alice, Faction: "Arborec", Won: true},
+		{GameID: game.ID, PlayerID: bob, Faction: "Federation of Sol"},
+		{GameID: game.ID, PlayerID: carol, Faction: "Emirates of Hacan"},
+	}
+	if err := db.Create(&gamePlayers).Error; err != nil {
+		t.Fatal(err)
+	}
+	for i, o := range objectives {
+		if err := db.Create(&models.GameObjective{GameID: game.ID, ObjectiveID: o.ID, RoundID: rounds[i].ID, Stage: "I", Revealed: true}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scores := []models.Score{
+		{GameID: game.ID, RoundID: rounds[0].ID, PlayerID: alice, ObjectiveID: objectives[0].ID, Points: 4, Type: models.ScoreTypePublic},
+		{GameID: game.ID, RoundID: rounds[1].ID, PlayerID: alice, ObjectiveID: objectives[1].ID, Points: 3, Type: models.ScoreTypePublic},
+		{GameID: game.ID, RoundID: rounds[2].ID, PlayerID: alice, ObjectiveID: objectives[2].ID, Points: 3, Type: models.ScoreTypePublic},
+		{GameID: game.ID, RoundID: rounds[0].ID, PlayerID: bob, Points: 1, Type: models.ScoreTypeMecatol},
+		{GameID: game.ID, RoundID: rounds[1].ID, PlayerID: bob, ObjectiveID: objectives[0].ID, Points: 3, Type: models.ScoreTypePublic},
+		{GameID: game.ID, RoundID: rounds[1].ID, PlayerID: carol, ObjectiveID: objectives[1].ID, Points: 4, Type: models.ScoreTypePublic},
+	}
+	if err := db.Create(&scores).Error; err != nil {
+		t.Fatal(err)
+	}
+	return game
+}
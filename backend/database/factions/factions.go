@@ -1,40 +1,75 @@
 package factions
 
-import "strings"
+import (
+	"strings"
+	"unicode"
 
-var AllFactions = []string{
-	"Arborec",
-	"Argent Flight",
-	"Barony of Letnev",
-	"Clan of Saar",
-	"Embers of Muaat",
-	"Empyrean",
-	"Federation of Sol",
-	"Ghosts of Creuss",
-	"Emirates of Hacan",
-	"L1Z1X Mindnet",
-	"Mahact Gene-Sorcerers",
-	"Mentak Coalition",
-	"Naalu Collective",
-	"Naaz-Rokha Alliance",
-	"Nekro Virus",
-	"Nomad",
-	"Sardakk N'orr",
-	"Titans of Ul",
-	"Universities of Jol-Nar",
-	"Vuil'raith Cabal",
-	"Winnu",
-	"Xxcha Kingdom",
-	"Yin Brotherhood",
-	"Yssaril Tribes",
-	"Council Keleres",
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// All is the seeded faction catalogue.
+var All = []models.Faction{
+	{Name: "Arborec", Code: "arborec", Expansion: models.ExpansionBase, HomeSystem: "Nestphar", Commodities: 3},
+	{Name: "Barony of Letnev", Code: "letnev", Expansion: models.ExpansionBase, Aliases: []string{"Letnev", "Barony"}, HomeSystem: "Arc Prime, Wren Terra", Commodities: 2},
+	{Name: "Clan of Saar", Code: "saar", Expansion: models.ExpansionBase, Aliases: []string{"Saar"}, HomeSystem: "Lisis II, Ragh", Commodities: 3},
+	{Name: "Embers of Muaat", Code: "muaat", Expansion: models.ExpansionBase, Aliases: []string{"Muaat"}, HomeSystem: "Muaat", Commodities: 4},
+	{Name: "Emirates of Hacan", Code: "hacan", Expansion: models.ExpansionBase, Aliases: []string{"Hacan"}, HomeSystem: "Arretze, Hercant, Kamdorn", Commodities: 6},
+	{Name: "Federation of Sol", Code: "sol", Expansion: models.ExpansionBase, Aliases: []string{"Sol"}, HomeSystem: "Jord", Commodities: 4},
+	{Name: "Ghosts of Creuss", Code: "creuss", Expansion: models.ExpansionBase, Aliases: []string{"Creuss", "Ghosts"}, HomeSystem: "Creuss", Commodities: 4},
+	{Name: "L1Z1X Mindnet", Code: "l1z1x", Expansion: models.ExpansionBase, Aliases: []string{"L1Z1X", "L1"}, HomeSystem: "[0.0.0]", Commodities: 2},
+	{Name: "Mentak Coalition", Code: "mentak", Expansion: models.ExpansionBase, Aliases: []string{"Mentak"}, HomeSystem: "Moll Primus", Commodities: 2},
+	{Name: "Naalu Collective", Code: "naalu", Expansion: models.ExpansionBase, Aliases: []string{"Naalu"}, HomeSystem: "Maaluuk, Druaa", Commodities: 3},
+	{Name: "Nekro Virus", Code: "nekro", Expansion: models.ExpansionBase, Aliases: []string{"Nekro"}, HomeSystem: "Mordai II", Commodities: 3},
+	{Name: "Sardakk N'orr", Code: "sardakk", Expansion: models.ExpansionBase, Aliases: []string{"Sardakk", "N'orr"}, HomeSystem: "Tren'lak, Quinarra", Commodities: 3},
+	{Name: "Universities of Jol-Nar", Code: "jolnar", Expansion: models.ExpansionBase, Aliases: []string{"Jol-Nar"}, HomeSystem: "Jol, Nar", Commodities: 4},
+	{Name: "Winnu", Code: "winnu", Expansion: models.ExpansionBase, HomeSystem: "Winnu", Commodities: 3},
+	{Name: "Xxcha Kingdom", Code: "xxcha", Expansion: models.ExpansionBase, Aliases: []string{"Xxcha"}, HomeSystem: "Archon Ren, Archon Tau", Commodities: 4},
+	{Name: "Yin Brotherhood", Code: "yin", Expansion: models.ExpansionBase, Aliases: []string{"Yin"}, HomeSystem: "Darien", Commodities: 2},
+	{Name: "Yssaril Tribes", Code: "yssaril", Expansion: models.ExpansionBase, Aliases: []string{"Yssaril"}, HomeSystem: "Retillion, Shalloq", Commodities: 3},
+	{Name: "Argent Flight", Code: "argent", Expansion: models.ExpansionPoK, Aliases: []string{"Argent"}, HomeSystem: "Avar, Valk, Ylir", Commodities: 3},
+	{Name: "Empyrean", Code: "empyrean", Expansion: models.ExpansionPoK, HomeSystem: "The Dark", Commodities: 4},
+	{Name: "Mahact Gene-Sorcerers", Code: "mahact", Expansion: models.ExpansionPoK, Aliases: []string{"Mahact"}, HomeSystem: "Ixth", Commodities: 3},
+	{Name: "Naaz-Rokha Alliance", Code: "naazrokha", Expansion: models.ExpansionPoK, Aliases: []string{"Naaz-Rokha", "NRA"}, HomeSystem: "Naazir, Rokha", Commodities: 3},
+	{Name: "Nomad", Code: "nomad", Expansion: models.ExpansionPoK, HomeSystem: "Arcturus", Commodities: 4},
+	{Name: "Titans of Ul", Code: "titans", Expansion: models.ExpansionPoK, Aliases: []string{"Titans", "Ul"}, HomeSystem: "Elysium", Commodities: 2},
+	{Name: "Vuil'raith Cabal", Code: "cabal", Expansion: models.ExpansionPoK, Aliases: []string{"Cabal", "Vuil'raith"}, HomeSystem: "Acheron", Commodities: 2},
+	// Keleres take the home system of an unused Mentak, Xxcha or Argent.
+	{Name: "Council Keleres", Code: "keleres", Expansion: models.ExpansionCodex, Aliases: []string{"Keleres"}, HomeSystem: "Mentak, Xxcha or Argent", Commodities: 2},
 }
 
-func IsValidFaction(name string) bool {
-	for _, f := range AllFactions {
-		if strings.EqualFold(f, name) {
-			return true
+// Key normalises a faction spelling for lookups: case, punctuation, spaces
+// and a leading "The" are ignored.
+func Key(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimPrefix(name, "the ")
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
 		}
 	}
-	return false
+	return b.String()
+}
+
+// Index finds factions by name, code or alias.
+type Index map[string]models.Faction
+
+func NewIndex(list []models.Faction) Index {
+	ix := make(Index)
+	for _, f := range list {
+		ix[Key(f.Code)] = f
+		for _, alias := range f.Aliases {
+			ix[Key(alias)] = f
+		}
+	}
+	// Full names win over any alias that happens to collide.
+	for _, f := range list {
+		ix[Key(f.Name)] = f
+	}
+	return ix
+}
+
+func (ix Index) Lookup(name string) (models.Faction, bool) {
+	f, ok := ix[Key(name)]
+	return f, ok
 }
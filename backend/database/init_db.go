@@ -2,35 +2,80 @@ package database
 
 import (
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
 
+	"github.com/arphillips06/TI4-stats/database/cardeffects"
+	"github.com/arphillips06/TI4-stats/database/factions"
 	"github.com/arphillips06/TI4-stats/database/objectives"
+	"github.com/arphillips06/TI4-stats/database/relics"
 	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 
 	_ "modernc.org/sqlite" // pure Go SQLite driver
 )
 
 var DB *gorm.DB
 
-func InitDatabase() {
-	// Open pure Go sqlite driver via database/sql
-	sqlDB, err := sql.Open("sqlite", "ti4stats.db")
+// openDialector returns the GORM dialector for driver, which is sqlite or
+// postgres.
+func openDialector(driver, dsn string) (gorm.Dialector, error) {
+	switch driver {
+	case "", "sqlite":
+		// Open pure Go sqlite driver via database/sql
+		sqlDB, err := sql.Open("sqlite", sqliteDSN(dsn))
+		if err != nil {
+			return nil, err
+		}
+		// Pass sql.DB to GORM sqlite dialector
+		return sqlite.Dialector{Conn: sqlDB}, nil
+	case "postgres":
+		return postgres.Open(dsn), nil
+	default:
+		return nil, fmt.Errorf("unknown database driver %q", driver)
+	}
+}
+
+// sqliteDSN adds a busy timeout to dsn unless it sets one, so that
+// concurrent writes wait for each other instead of failing with
+// SQLITE_BUSY.
+func sqliteDSN(dsn string) string {
+	if strings.Contains(dsn, "busy_timeout") {
+		return dsn
+	}
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	return dsn + sep + "_pragma=busy_timeout(5000)"
+}
+
+func InitDatabase(driver, dsn string, logLevel logger.LogLevel) {
+	dialector, err := openDialector(driver, dsn)
 	if err != nil {
 		log.Fatal("Failed to open database/sql DB:", err)
 	}
 
-	// Pass sql.DB to GORM sqlite dialector
-	DB, err = gorm.Open(sqlite.Dialector{Conn: sqlDB}, &gorm.Config{})
+	DB, err = gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logLevel),
+	})
 	if err != nil {
 		log.Fatal("Failed to connect to database (gorm):", err)
 	}
+	if err := registerChangeCounter(DB); err != nil {
+		log.Fatal("Failed to register change counter:", err)
+	}
 
 	// Automigrate models
 	err = DB.AutoMigrate(
+		&models.Faction{},
 		&models.Game{},
 		&models.Player{},
+		&models.PlayerAlias{},
 		&models.Round{},
 		&models.Score{},
 		&models.GamePlayer{},
@@ -39,6 +84,16 @@ func InitDatabase() {
 		&models.SpeakerAssignment{},
 		&models.Achievement{},
 		&models.PlayerAchievement{},
+		&models.Relic{},
+		&models.RelicHolding{},
+		&models.SupportHolding{},
+		&models.CardEffect{},
+		&models.AuditLog{},
+		&models.Turn{},
+		&models.GameTemplate{},
+		&models.GameSession{},
+		&models.SessionRSVP{},
+		&schemaMigration{},
 	)
 	if err != nil {
 		log.Fatal("Failed to migrate database:", err)
@@ -85,3 +140,76 @@ func insertObjective(obj models.Objective) {
 		}
 	}
 }
+
+// SeedRelics upserts the relic catalogue by name.
+func SeedRelics() {
+	for _, relic := range relics.All {
+		var existing models.Relic
+		err := DB.Where("name = ?", relic.Name).First(&existing).Error
+		if err == gorm.ErrRecordNotFound {
+			if err := DB.Create(&relic).Error; err != nil {
+				log.Printf("Failed to seed relic '%s': %v\n", relic.Name, err)
+			}
+			continue
+		}
+		if err != nil {
+			log.Printf("Error checking relic '%s': %v\n", relic.Name, err)
+			continue
+		}
+
+		relic.ID = existing.ID
+		if err := DB.Save(&relic).Error; err != nil {
+			log.Printf("Failed to update relic '%s': %v\n", relic.Name, err)
+		}
+	}
+}
+
+// SeedCardEffects upserts the card effect catalogue by name. Homebrew cards
+// with a catalogue name are left alone.
+func SeedCardEffects() {
+	for _, card := range cardeffects.All {
+		var existing models.CardEffect
+		err := DB.Where("name = ?", card.Name).First(&existing).Error
+		if err == gorm.ErrRecordNotFound {
+			if err := DB.Create(&card).Error; err != nil {
+				log.Printf("Failed to seed card effect '%s': %v\n", card.Name, err)
+			}
+			continue
+		}
+		if err != nil {
+			log.Printf("Error checking card effect '%s': %v\n", card.Name, err)
+			continue
+		}
+		if existing.Homebrew {
+			continue
+		}
+
+		card.ID = existing.ID
+		if err := DB.Save(&card).Error; err != nil {
+			log.Printf("Failed to update card effect '%s': %v\n", card.Name, err)
+		}
+	}
+}
+
+// SeedFactions upserts the faction catalogue by name.
+func SeedFactions() {
+	for _, faction := range factions.All {
+		var existing models.Faction
+		err := DB.Where("name = ?", faction.Name).First(&existing).Error
+		if err == gorm.ErrRecordNotFound {
+			if err := DB.Create(&faction).Error; err != nil {
+				log.Printf("Failed to seed faction '%s': %v\n", faction.Name, err)
+			}
+			continue
+		}
+		if err != nil {
+			log.Printf("Error checking faction '%s': %v\n", faction.Name, err)
+			continue
+		}
+
+		faction.ID = existing.ID
+		if err := DB.Save(&faction).Error; err != nil {
+			log.Printf("Failed to update faction '%s': %v\n", faction.Name, err)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package database
+
+import (
+	"log"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database/factions"
+	"github.com/arphillips06/TI4-stats/database/relics"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+// schemaMigration records a data migration that has been applied.
+type schemaMigration struct {
+	Name      string `gorm:"primaryKey;size:100"`
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string { return "schema_migrations" }
+
+type migration struct {
+	name string
+	run  func(tx *gorm.DB) error
+}
+
+// migrations run in order, once each, after AutoMigrate and seeding. Append
+// new entries; never rename or reorder existing ones.
+var migrations = []migration{
+	{"normalise_relic_titles", normaliseRelicTitles},
+	{"backfill_relic_holdings", backfillRelicHoldings},
+	{"normalise_score_sources", normaliseScoreSources},
+	{"link_game_player_factions", linkGamePlayerFactions},
+	{"drop_player_game_id", dropPlayerGameID},
+	{"backfill_support_holdings", backfillSupportHoldings},
+	{"start_unfinished_game_sessions", startUnfinishedGameSessions},
+}
+
+// RunMigrations applies any data migrations that have not run yet. Each
+// migration runs in its own transaction.
+func RunMigrations() {
+	for _, m := range migrations {
+		var count int64
+		if err := DB.Model(&schemaMigration{}).Where("name = ?", m.name).Count(&count).Error; err != nil {
+			log.Fatalf("Failed to check migration %s: %v", m.name, err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		err := DB.Transaction(func(tx *gorm.DB) error {
+			if err := m.run(tx); err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{Name: m.name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			log.Fatalf("Migration %s failed: %v", m.name, err)
+		}
+		log.Printf("Applied migration %s", m.name)
+	}
+}
+
+// normaliseRelicTitles rewrites relic scores recorded under old titles such
+// as "Book Of Latvina" to the catalogue names.
+func normaliseRelicTitles(tx *gorm.DB) error {
+	for old, name := range relics.LegacyTitles {
+		if err := tx.Model(&models.Score{}).
+			Where("type = ? AND relic_title = ?", models.ScoreTypeRelic, old).
+			Update("relic_title", name).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillRelicHoldings derives holdings from the relic scores of games that
+// predate the relic engine. A positive or zero score means the player gained
+// the relic; a negative one means they lost it.
+func backfillRelicHoldings(tx *gorm.DB) error {
+	var catalogue []models.Relic
+	if err := tx.Find(&catalogue).Error; err != nil {
+		return err
+	}
+	relicIDs := make(map[string]uint, len(catalogue))
+	for _, r := range catalogue {
+		relicIDs[r.Name] = r.ID
+	}
+
+	var scores []models.Score
+	if err := tx.
+		Where("type = ? AND game_id NOT IN (SELECT DISTINCT game_id FROM relic_holdings)", models.ScoreTypeRelic).
+		Order("game_id, created_at, id").
+		Find(&scores).Error; err != nil {
+		return err
+	}
+
+	type key struct{ game, relic uint }
+	current := make(map[key]*models.RelicHolding)
+	for _, s := range scores {
+		relicID, ok := relicIDs[s.RelicTitle]
+		if !ok {
+			log.Printf("Skipping unknown relic %q in game %d", s.RelicTitle, s.GameID)
+			continue
+		}
+		k := key{s.GameID, relicID}
+		lostAt := s.CreatedAt
+
+		if held := current[k]; held != nil && (s.Points >= 0 || held.PlayerID == s.PlayerID) {
+			if err := tx.Model(held).Update("lost_at", &lostAt).Error; err != nil {
+				return err
+			}
+			delete(current, k)
+		}
+		if s.Points < 0 {
+			continue
+		}
+
+		holding := &models.RelicHolding{
+			GameID:    s.GameID,
+			RelicID:   relicID,
+			PlayerID:  s.PlayerID,
+			RoundID:   s.RoundID,
+			CreatedAt: s.CreatedAt,
+		}
+		if err := tx.Create(holding).Error; err != nil {
+			return err
+		}
+		current[k] = holding
+	}
+	return nil
+}
+
+// normaliseScoreSources rewrites Score.Type values such as "Support" or
+// "imperial_rider" to their canonical ScoreSource. Imperial Rider scores
+// keep the card as provenance.
+func normaliseScoreSources(tx *gorm.DB) error {
+	if err := tx.Model(&models.Score{}).
+		Where("LOWER(type) IN ?", []string{"imperial_rider", "imperial rider"}).
+		Update("card_title", models.ActionCardImperialRider).Error; err != nil {
+		return err
+	}
+
+	var types []string
+	if err := tx.Model(&models.Score{}).Distinct().Pluck("type", &types).Error; err != nil {
+		return err
+	}
+	for _, t := range types {
+		source, ok := models.ParseScoreSource(t)
+		if !ok {
+			log.Printf("Leaving unknown score type %q unchanged", t)
+			continue
+		}
+		if string(source) == t {
+			continue
+		}
+		if err := tx.Model(&models.Score{}).
+			Where("type = ?", t).
+			Update("type", source).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkGamePlayerFactions points game players at the faction catalogue and
+// rewrites their faction to its canonical name. Names that match nothing
+// are logged and left unlinked.
+func linkGamePlayerFactions(tx *gorm.DB) error {
+	var catalogue []models.Faction
+	if err := tx.Find(&catalogue).Error; err != nil {
+		return err
+	}
+	index := factions.NewIndex(catalogue)
+
+	var names []string
+	if err := tx.Model(&models.GamePlayer{}).Distinct().Pluck("faction", &names).Error; err != nil {
+		return err
+	}
+	for _, name := range names {
+		faction, ok := index.Lookup(name)
+		if !ok {
+			log.Printf("Leaving unknown faction %q unlinked", name)
+			continue
+		}
+		if err := tx.Model(&models.GamePlayer{}).
+			Where("faction = ?", name).
+			Updates(map[string]any{"faction_id": faction.ID, "faction": faction.Name}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropPlayerGameID removes players.game_id, which was never written; game
+// membership lives in game_players. Older schemas also hang a foreign key
+// for Game.Speaker off the column, which has to go first.
+func dropPlayerGameID(tx *gorm.DB) error {
+	m := tx.Migrator()
+	if !m.HasColumn(&models.Player{}, "game_id") {
+		return nil
+	}
+	if m.HasConstraint(&models.Player{}, "fk_games_speaker") {
+		if err := m.DropConstraint(&models.Player{}, "fk_games_speaker"); err != nil {
+			return err
+		}
+	}
+	if m.HasIndex(&models.Player{}, "idx_players_game_id") {
+		if err := m.DropIndex(&models.Player{}, "idx_players_game_id"); err != nil {
+			return err
+		}
+	}
+	return m.DropColumn(&models.Player{}, "game_id")
+}
+
+// backfillSupportHoldings derives Support for the Throne holdings from the
+// support scores of games recorded before holdings were tracked. Those
+// scores never named the note's owner, so the holdings are left without
+// one; a negative score returns the holder's latest note.
+func backfillSupportHoldings(tx *gorm.DB) error {
+	var scores []models.Score
+	if err := tx.
+		Where("type = ? AND game_id NOT IN (SELECT DISTINCT game_id FROM support_holdings)", models.ScoreTypeSupport).
+		Order("game_id, created_at, id").
+		Find(&scores).Error; err != nil {
+		return err
+	}
+
+	type key struct{ game, holder uint }
+	held := make(map[key][]*models.SupportHolding)
+	for _, s := range scores {
+		k := key{s.GameID, s.PlayerID}
+		for i := 0; i < s.Points; i++ {
+			holding := &models.SupportHolding{
+				GameID:    s.GameID,
+				HolderID:  s.PlayerID,
+				RoundID:   s.RoundID,
+				CreatedAt: s.CreatedAt,
+			}
+			if err := tx.Create(holding).Error; err != nil {
+				return err
+			}
+			held[k] = append(held[k], holding)
+		}
+		for i := 0; i > s.Points && len(held[k]) > 0; i-- {
+			last := held[k][len(held[k])-1]
+			held[k] = held[k][:len(held[k])-1]
+			endedAt := s.CreatedAt
+			if err := tx.Model(last).Updates(map[string]any{
+				"ended_at":   &endedAt,
+				"end_reason": models.SupportReturned,
+			}).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// startUnfinishedGameSessions gives games that were in progress before
+// sessions were kept a running session from their creation, so they can be
+// paused and their time so far still counts.
+func startUnfinishedGameSessions(tx *gorm.DB) error {
+	var games []models.Game
+	if err := tx.
+		Where("finished_at IS NULL AND id NOT IN (SELECT DISTINCT game_id FROM game_sessions)").
+		Find(&games).Error; err != nil {
+		return err
+	}
+	for _, g := range games {
+		startedAt := g.CreatedAt
+		if err := tx.Create(&models.GameSession{
+			GameID:    g.ID,
+			StartedAt: &startedAt,
+			Location:  g.Location,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
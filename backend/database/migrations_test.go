@@ -0,0 +1,84 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	"github.com/arphillips06/TI4-stats/database/relics"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// TestMigrations runs the data migrations over rows written by older
+// releases, on each driver.
+func TestMigrations(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T) {
+		db := database.DB
+		var objective models.Objective
+		if err := db.First(&objective).Error; err != nil {
+			t.Fatal(err)
+		}
+		alice := models.Player{Name: "Alice"}
+		bob := models.Player{Name: "Bob"}
+		for _, p := range []*models.Player{&alice, &bob} {
+			if err := db.Create(p).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+		game := models.Game{WinningPoints: 10, Location: "Kitchen table"}
+		if err := db.Create(&game).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Create(&models.GamePlayer{GameID: game.ID, PlayerID: alice.ID, Faction: "the arborec"}).Error; err != nil {
+			t.Fatal(err)
+		}
+		legacy := []models.Score{
+			{GameID: game.ID, PlayerID: alice.ID, ObjectiveID: objective.ID, Points: 1, Type: models.ScoreTypeRelic, RelicTitle: "Book Of Latvina"},
+			{GameID: game.ID, PlayerID: bob.ID, ObjectiveID: objective.ID, Points: 1, Type: "Support"},
+		}
+		if err := db.Create(&legacy).Error; err != nil {
+			t.Fatal(err)
+		}
+
+		// Forget the migrations run by dbtest.Open so they see the rows above.
+		if err := db.Exec("DELETE FROM schema_migrations").Error; err != nil {
+			t.Fatal(err)
+		}
+		database.RunMigrations()
+		database.RunMigrations() // a second run changes nothing
+
+		var scores []models.Score
+		if err := db.Order("id").Find(&scores).Error; err != nil {
+			t.Fatal(err)
+		}
+		if scores[0].Type != models.ScoreTypeRelic || scores[0].RelicTitle != relics.BookOfLatvinia {
+			t.Errorf("relic score is %q %q, want %q %q", scores[0].Type, scores[0].RelicTitle, models.ScoreTypeRelic, relics.BookOfLatvinia)
+		}
+		if scores[1].Type != models.ScoreTypeSupport {
+			t.Errorf("support score type is %q, want %q", scores[1].Type, models.ScoreTypeSupport)
+		}
+
+		var gp models.GamePlayer
+		if err := db.Where("game_id = ?", game.ID).First(&gp).Error; err != nil {
+			t.Fatal(err)
+		}
+		if gp.Faction != "Arborec" || gp.FactionID == nil {
+			t.Errorf("game player faction is %q (id %v), want Arborec linked to the catalogue", gp.Faction, gp.FactionID)
+		}
+
+		counts := map[any]int64{
+			&models.RelicHolding{}:   1,
+			&models.SupportHolding{}: 1,
+			&models.GameSession{}:    1,
+		}
+		for model, want := range counts {
+			var got int64
+			if err := db.Model(model).Where("game_id = ?", game.ID).Count(&got).Error; err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("%T: got %d rows, want %d", model, got, want)
+			}
+		}
+	})
+}
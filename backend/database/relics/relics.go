@@ -0,0 +1,90 @@
+package relics
+
+import "github.com/arphillips06/TI4-stats/models"
+
+const (
+	ShardOfTheThrone = "Shard of the Throne"
+	CrownOfEmphidia  = "The Crown of Emphidia"
+	TheObsidian      = "The Obsidian"
+	BookOfLatvinia   = "Book of Latvinia"
+	legacyLatvinia   = "Book Of Latvina"
+	legacyCrown      = "Crown of Emphidia"
+	legacyObsidian   = "Obsidian"
+)
+
+var All = []models.Relic{
+	{
+		Name:          BookOfLatvinia,
+		Description:   "Research up to 2 technologies with no prerequisites. Gain 1 victory point if you control planets with all four technology specialties.",
+		VictoryPoints: 1,
+	},
+	{
+		Name:        "Circlet of the Void",
+		Description: "Explore frontier tokens and move ships through gravity rifts without rolling.",
+	},
+	{
+		Name:        "Dominus Orb",
+		Description: "Move ships out of systems that contain your command tokens during a tactical action.",
+	},
+	{
+		Name:        "Dynamis Core",
+		Description: "Gain extra trade goods when you replenish commodities.",
+	},
+	{
+		Name:        "JR-XS455-O",
+		Description: "Place a structure on a planet you control or gain 1 trade good.",
+	},
+	{
+		Name:        "Maw of Worlds",
+		Description: "Purge planets to gain any technology.",
+	},
+	{
+		Name:        "Nano-Forge",
+		Description: "Attach to a non-home, non-legendary planet you control; it becomes legendary.",
+	},
+	{
+		Name:        "Scepter of Emelpar",
+		Description: "Follow a strategy card without spending a command token.",
+	},
+	{
+		Name:               ShardOfTheThrone,
+		Description:        "Gain 1 victory point. Lose it if another player takes this card from you.",
+		VictoryPoints:      1,
+		PointsFollowHolder: true,
+		Transferable:       true,
+	},
+	{
+		Name:        "Stellar Converter",
+		Description: "Destroy a non-home, non-legendary planet adjacent to your ships.",
+	},
+	{
+		Name:        "The Codex",
+		Description: "Take up to 3 action cards from the discard pile.",
+	},
+	{
+		Name:          CrownOfEmphidia,
+		Description:   "Purge when you control the Tomb of Emphidia to gain 1 victory point.",
+		VictoryPoints: 1,
+	},
+	{
+		Name:        "The Crown of Thalnos",
+		Description: "Reroll dice in combat; destroy units whose rerolls miss.",
+	},
+	{
+		Name:              TheObsidian,
+		Description:       "Draw 1 secret objective. You can have 1 additional scored or unscored secret objective.",
+		SecretCapModifier: 1,
+	},
+	{
+		Name:        "The Prophet's Tears",
+		Description: "Ignore a prerequisite or draw an additional action card when researching technology.",
+	},
+}
+
+// LegacyTitles maps relic titles used by older releases to catalogue names.
+var LegacyTitles = map[string]string{
+	legacyLatvinia:    BookOfLatvinia,
+	"Book of Latvina": BookOfLatvinia,
+	legacyCrown:       CrownOfEmphidia,
+	legacyObsidian:    TheObsidian,
+}
@@ -15,7 +15,7 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/achievements": {
+        "/api/v1/achievements": {
             "get": {
                 "description": "Returns current records across all finished, non-partial games, including all holders for each record.",
                 "produces": [
@@ -25,12 +25,12 @@ const docTemplate = `{
                     "achievements"
                 ],
                 "summary": "Global achievements (records)",
+                "operationId": "GetGlobalAchievements",
                 "responses": {
                     "200": {
-                        "description": "value, Count",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/achievements.BadgeList"
                         }
                     },
                     "500": {
@@ -45,35 +45,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/agendas/classified-document-leaks": {
+        "/api/v1/admin/backup": {
             "post": {
-                "description": "Selects a scored secret objective to become public; the scorer keeps the point but loses a secret slot.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Writes a consistent copy of the SQLite database into the backup directory with VACUUM INTO, then deletes the oldest backups beyond backup.keep. Safe to run while games are being recorded.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "agendas"
+                    "admin"
                 ],
-                "summary": "Apply \"Classified Document Leaks\"",
-                "parameters": [
-                    {
-                        "description": "Game ID, player, and target secret objective",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
+                "summary": "Back up the database",
+                "operationId": "CreateBackup",
+                "responses": {
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.ClassifiedDocumentLeaksRequest"
+                            "$ref": "#/definitions/models.Backup"
                         }
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
                     },
-                    "400": {
+                    "500": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -82,6 +72,39 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "501": {
+                        "description": "error: database is not SQLite",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/backups": {
+            "get": {
+                "description": "Lists the backups in the backup directory, newest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List backups",
+                "operationId": "ListBackups",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Backup"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -94,9 +117,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/agendas/incentive-program": {
+        "/api/v1/admin/games/{id}/players/{player_id}": {
             "post": {
-                "description": "Grants 1 point to all players who voted with the outcome (for or against).",
+                "description": "Changes the faction a player had in a game, finished or not. The change is audited with the reason given.",
                 "consumes": [
                     "application/json"
                 ],
@@ -104,23 +127,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agendas"
+                    "admin"
                 ],
-                "summary": "Apply \"Incentive Program\"",
+                "summary": "Correct a player's faction",
+                "operationId": "CorrectGamePlayer",
                 "parameters": [
                     {
-                        "description": "Game ID and outcome",
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "player_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New faction and reason",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.IncentiveProgramRequest"
+                            "$ref": "#/definitions/models.CorrectGamePlayerRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "message",
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GamePlayer"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -128,7 +172,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "400": {
+                    "404": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -137,7 +181,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
+                    "409": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -145,13 +189,19 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/agendas/mutiny": {
+        "/api/v1/admin/games/{id}/recompute": {
             "post": {
-                "description": "Awards 1 point to players who voted \"for\" and finalizes the result.",
+                "description": "Sets WinnerID, Won and FinishedAt from the scores: a player at the winning points wins, or the highest total\nif the game had already ended. winner_id overrides the scores, e.g. to settle a tie.",
                 "consumes": [
                     "application/json"
                 ],
@@ -159,23 +209,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agendas"
+                    "admin"
                 ],
-                "summary": "Apply \"Mutiny\" agenda",
+                "summary": "Recompute a game's result",
+                "operationId": "RecomputeGameResult",
                 "parameters": [
                     {
-                        "description": "Game and resolution context (if applicable)",
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional winner and reason",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.AgendaResolution"
+                            "$ref": "#/definitions/models.RecomputeResultRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Game"
+                        }
                     },
                     "400": {
                         "description": "error",
@@ -186,7 +247,16 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -194,13 +264,19 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/agendas/political-censure": {
+        "/api/v1/admin/games/{id}/reopen": {
             "post": {
-                "description": "Bans the elected player from voting on the next agenda.",
+                "description": "Clears FinishedAt, WinnerID and every player's Won flag so scoring can continue.\nA game timed by sessions starts its next session, so turns can be timed again.",
                 "consumes": [
                     "application/json"
                 ],
@@ -208,23 +284,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agendas"
+                    "admin"
                 ],
-                "summary": "Apply \"Political Censure\" agenda",
+                "summary": "Reopen a finished game",
+                "operationId": "ReopenGame",
                 "parameters": [
                     {
-                        "description": "Game ID and elected player",
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.PoliticalCensureRequest"
+                            "$ref": "#/definitions/models.ReasonRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Game"
+                        }
                     },
                     "400": {
                         "description": "error",
@@ -235,7 +322,16 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -243,13 +339,19 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/agendas/seed-of-empire": {
+        "/api/v1/admin/scores/{id}": {
             "post": {
-                "description": "Grants a VP to the elected player and creates a public 1-point objective for them.",
+                "description": "Moves a score to another player in the same game or another round (by number), or changes its points.\nThe game result is not recomputed; use /api/v1/admin/games/{id}/recompute afterwards if needed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -257,23 +359,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agendas"
+                    "admin"
                 ],
-                "summary": "Apply \"Seed of an Empire\" agenda",
+                "summary": "Correct a score",
+                "operationId": "CorrectScore",
                 "parameters": [
                     {
-                        "description": "Game ID and elected player",
+                        "type": "integer",
+                        "description": "Score ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Changes and reason",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SeedOfEmpireResolution"
+                            "$ref": "#/definitions/models.CorrectScoreRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Score"
+                        }
                     },
                     "400": {
                         "description": "error",
@@ -284,7 +397,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
+                    "404": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -292,32 +405,8 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/factions": {
-            "get": {
-                "description": "Returns all available factions.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "factions"
-                ],
-                "summary": "List factions",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "additionalProperties": true
-                            }
-                        }
                     },
-                    "500": {
+                    "409": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -325,51 +414,41 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/games": {
+        "/api/v1/card-effects": {
             "get": {
-                "description": "Returns all games with players and winner info.",
+                "description": "Action cards, abilities and promissory notes that grant or remove victory points, including homebrew.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "games"
-                ],
-                "summary": "List games",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Search query (e.g., 'winner:Alice', 'player:Bob')",
-                        "name": "search",
-                        "in": "query"
-                    }
+                    "scoring"
                 ],
+                "summary": "Card effect registry",
+                "operationId": "ListCardEffects",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Game"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                                "$ref": "#/definitions/models.CardEffect"
                             }
                         }
                     }
                 }
             },
             "post": {
-                "description": "Creates a new game with players; can optionally generate objectives.",
+                "description": "kind is action_card (default), ability or promissory_note. victory_points may be negative.",
                 "consumes": [
                     "application/json"
                 ],
@@ -377,26 +456,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "games"
+                    "scoring"
                 ],
-                "summary": "Create a new game",
+                "summary": "Add a homebrew card effect",
+                "operationId": "CreateCardEffect",
                 "parameters": [
                     {
-                        "description": "New game payload",
+                        "description": "Card (name required)",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateGameInput"
+                            "$ref": "#/definitions/models.CardEffect"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "game, revealed",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.CardEffect"
                         }
                     },
                     "400": {
@@ -408,7 +487,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
+                    "409": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -416,51 +495,32 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/games/assign-objective": {
-            "post": {
-                "description": "Admin action to attach an objective to a specific game round.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/export/games.csv": {
+            "get": {
+                "description": "One row per player per game: game number, date, player, faction, final points, placement and whether they won.",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "games"
-                ],
-                "summary": "Manually assign a public objective to a round",
-                "parameters": [
-                    {
-                        "description": "Assignment payload",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.AssignObjectiveRequest"
-                        }
-                    }
+                    "export"
                 ],
+                "summary": "Export games as CSV",
+                "operationId": "ExportGamesCSV",
                 "responses": {
                     "200": {
-                        "description": "message",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "error",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "file"
                         }
                     },
                     "500": {
@@ -475,39 +535,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/assign-player": {
-            "post": {
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/export/scores.csv": {
+            "get": {
+                "description": "One row per score with round, type and the objective, agenda or relic title.",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "players",
-                    "games"
-                ],
-                "summary": "Assign player to game",
-                "parameters": [
-                    {
-                        "description": "Game ID, Player ID, Faction",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.AssignPlayerInput"
-                        }
-                    }
+                    "export"
                 ],
+                "summary": "Export scores as CSV",
+                "operationId": "ExportScoresCSV",
                 "responses": {
                     "200": {
-                        "description": "game_player",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "file"
                         }
                     },
-                    "400": {
+                    "500": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -515,6 +561,27 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/api/v1/export/stats.csv": {
+            "get": {
+                "description": "Per-player and per-faction summary rows, distinguished by the kind column.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "Export stats as CSV",
+                "operationId": "ExportStatsCSV",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
                     "500": {
                         "description": "error",
@@ -528,34 +595,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{game_id}/advance-round": {
-            "post": {
-                "description": "Advances the round; reveals a public objective unless none remain (then ends the game).",
+        "/api/v1/export/workbook.xlsx": {
+            "get": {
+                "description": "An .xlsx workbook with games, scores and stats sheets.",
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "games"
-                ],
-                "summary": "Advance game round",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Game ID",
-                        "name": "game_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "export"
                 ],
+                "summary": "Export everything as a spreadsheet",
+                "operationId": "ExportWorkbook",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "file"
                         }
                     },
-                    "400": {
+                    "500": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -563,17 +621,40 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
-                    },
-                    "404": {
-                        "description": "error",
+                    }
+                }
+            }
+        },
+        "/api/v1/factions": {
+            "get": {
+                "description": "Returns the faction catalogue with code, expansion, aliases, home system and commodities.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "factions"
+                ],
+                "summary": "List factions",
+                "operationId": "GetFactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only factions from this expansion (base, pok, codex)",
+                        "name": "expansion",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Faction"
                             }
                         }
                     },
-                    "500": {
+                    "400": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -585,47 +666,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{game_id}/players/{player_id}/sftt": {
-            "post": {
-                "description": "Give or revoke Support for the Throne for a player in a game.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/games": {
+            "get": {
+                "description": "Returns games with players and winner info. The total number of matching games is returned in X-Total-Count.\nSearch terms: w: winner, wf: winner faction, p: player, f: faction, o: objective scored,\ns: secret scored, a: agenda, r: relic, c: custodians (true|false), rounds/players/vp with =,\u003e=,\u003c=,\u003e,\u003c,\nafter:/before: YYYY-MM-DD, and free text over title, notes and location. Prefix any term with - to negate it.\nWithout page/page_size all games are returned, except for searches which default to 50 per page.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "scoring"
+                    "games"
                 ],
-                "summary": "Support for the Throne",
+                "summary": "List games",
+                "operationId": "ListGames",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Game ID",
-                        "name": "game_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Search query (e.g., 'w:Alice -p:Bob rounds\u003c=6')",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (max 200)",
+                        "name": "page_size",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Player ID",
-                        "name": "player_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Comma separated sort keys, prefix with - for descending (id, game_number, created_at, finished_at, winning_points, current_round)",
+                        "name": "sort",
+                        "in": "query"
                     },
                     {
-                        "description": "round_id, action (give|revoke)",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object"
-                        }
+                        "type": "string",
+                        "description": "Comma separated JSON fields to return (e.g. id,game_number,winner)",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Game"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of matching games"
+                            }
+                        }
                     },
                     "400": {
                         "description": "error",
@@ -636,7 +734,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
+                    "500": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -646,11 +744,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/games/{game_id}/score": {
+            },
             "post": {
-                "description": "Marks a player as having scored a specific objective in a game.",
+                "description": "Creates a new game with players; can optionally generate objectives.",
                 "consumes": [
                     "application/json"
                 ],
@@ -658,24 +754,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "scoring"
+                    "games"
                 ],
-                "summary": "Score an objective",
+                "summary": "Create a new game",
+                "operationId": "CreateGame",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Game ID",
-                        "name": "game_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "game_id, player_id, objective_id",
+                        "description": "New game payload",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/models.CreateGameInput"
                         }
                     }
                 ],
@@ -683,8 +773,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.CreateGameResponse"
                         }
                     },
                     "400": {
@@ -696,22 +785,16 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "403": {
-                        "description": "error",
+                    "409": {
+                        "description": "a name looks like a typo of an existing player",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.UnknownPlayerResponse"
                         }
                     },
-                    "404": {
-                        "description": "error",
+                    "422": {
+                        "description": "invalid fields",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
                         }
                     },
                     "500": {
@@ -726,9 +809,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{game_id}/speaker": {
+        "/api/v1/games/from-template/{id}": {
             "post": {
-                "description": "Assigns a speaker (initial or current) for a specific round.",
+                "description": "Creates a game with the template's points target, deck mode, speaker mode and house rules.\nThe body is optional: players replace the template's seats, and title, notes and location describe the game.",
                 "consumes": [
                     "application/json"
                 ],
@@ -736,30 +819,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "games"
+                    "games",
+                    "templates"
                 ],
-                "summary": "Assign speaker",
+                "summary": "Create a game from a template",
+                "operationId": "CreateGameFromTemplate",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Game ID",
-                        "name": "game_id",
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "player_id, round_id, is_initial",
+                        "description": "Changes for this game",
                         "name": "body",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/models.GameFromTemplateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "message",
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateGameResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -767,7 +857,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "400": {
+                    "404": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -776,6 +866,18 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "409": {
+                        "description": "a name looks like a typo of an existing player",
+                        "schema": {
+                            "$ref": "#/definitions/models.UnknownPlayerResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -788,7 +890,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{id}": {
+        "/api/v1/games/{id}": {
             "get": {
                 "description": "Returns detailed game state with objective-based scoring breakdown.",
                 "produces": [
@@ -798,9 +900,10 @@ const docTemplate = `{
                     "games"
                 ],
                 "summary": "Get game detail",
+                "operationId": "GetGameByID",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
@@ -811,8 +914,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.GameDetailResponse"
                         }
                     },
                     "404": {
@@ -838,6 +940,7 @@ const docTemplate = `{
                     "games"
                 ],
                 "summary": "Delete a game",
+                "operationId": "DeleteGameHandler",
                 "parameters": [
                     {
                         "type": "integer",
@@ -849,10 +952,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "status and deleted game ID",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.DeleteGameResponse"
                         }
                     },
                     "400": {
@@ -864,6 +966,12 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -876,7 +984,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{id}/achievements": {
+        "/api/v1/games/{id}/achievements": {
             "get": {
                 "description": "Computes and returns per-game achievements (only for finished, non-partial games).",
                 "produces": [
@@ -886,6 +994,7 @@ const docTemplate = `{
                     "games"
                 ],
                 "summary": "Get achievements for a game",
+                "operationId": "GetGameAchievements",
                 "parameters": [
                     {
                         "type": "integer",
@@ -897,10 +1006,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "value, Count",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/achievements.BadgeList"
                         }
                     },
                     "400": {
@@ -912,6 +1020,12 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -924,77 +1038,141 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{id}/exists": {
-            "get": {
-                "description": "Returns {\"exists\": true|false}.",
+        "/api/v1/games/{id}/agendas/classified-document-leaks": {
+            "post": {
+                "description": "Selects a scored secret objective to become public; the scorer keeps the point but loses a secret slot.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "games"
+                    "agendas"
                 ],
-                "summary": "Check game exists",
+                "summary": "Apply \"Classified Document Leaks\"",
+                "operationId": "HandleClassifiedDocumentLeaks",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Game ID, player, and target secret objective",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ClassifiedDocumentLeaksRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "type": "boolean"
+                                "type": "string"
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "error: the game is not in the agenda phase",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "type": "boolean"
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/games/{id}/objectives": {
-            "get": {
-                "description": "Returns all public objectives for a game, including stage and round.",
+        "/api/v1/games/{id}/agendas/incentive-program": {
+            "post": {
+                "description": "Grants 1 point to all players who voted with the outcome (for or against).",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "games"
+                    "agendas"
                 ],
-                "summary": "Get game public objectives",
+                "summary": "Apply \"Incentive Program\"",
+                "operationId": "HandleIncentiveProgram",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
+                    },
+                    {
+                        "description": "Game ID and outcome",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.IncentiveProgramRequest"
+                        }
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "additionalProperties": true
+                            "$ref": "#/definitions/models.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error: the game is not in the agenda phase",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -1007,35 +1185,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{id}/players": {
-            "get": {
+        "/api/v1/games/{id}/agendas/mutiny": {
+            "post": {
+                "description": "Awards 1 point to players who voted \"for\" and finalizes the result.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "agendas"
                 ],
-                "summary": "List players in a game",
+                "summary": "Apply \"Mutiny\" agenda",
+                "operationId": "ResolveMutinyAgenda",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Game and resolution context (if applicable)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AgendaResolution"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "additionalProperties": true
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
+                    "409": {
+                        "description": "error: the game is not in the agenda phase",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -1048,16 +1257,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{id}/scores/objectives/summary": {
-            "get": {
+        "/api/v1/games/{id}/agendas/political-censure": {
+            "post": {
+                "description": "Bans the elected player from voting on the next agenda.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "scoring",
-                    "games"
+                    "agendas"
                 ],
-                "summary": "Objective score summary for a game",
+                "summary": "Apply \"Political Censure\" agenda",
+                "operationId": "HandlePoliticalCensure",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1065,18 +1278,32 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Game ID and elected player",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PoliticalCensureRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "error",
+                    "409": {
+                        "description": "error: the game is not in the agenda phase",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1084,6 +1311,12 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -1096,32 +1329,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/games/{id}/speaker/randomise": {
+        "/api/v1/games/{id}/agendas/seed-of-empire": {
             "post": {
-                "description": "Randomly selects a speaker for the game.",
+                "description": "Grants a VP to the elected player and creates a public 1-point objective for them.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "games"
+                    "agendas"
                 ],
-                "summary": "Randomise speaker",
+                "summary": "Apply \"Seed of an Empire\" agenda",
+                "operationId": "HandleSeedOfEmpire",
                 "parameters": [
                     {
-                        "type": "string",
+                        "type": "integer",
                         "description": "Game ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Game ID and elected player",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SeedOfEmpireResolution"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "speaker_id, speaker_name",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "error",
@@ -1132,36 +1374,19 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "error",
+                    "409": {
+                        "description": "error: the game is not in the agenda phase",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/objectives/public": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "objectives"
-                ],
-                "summary": "List public objectives",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "422": {
+                        "description": "invalid fields",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "additionalProperties": true
-                            }
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
                         }
                     },
                     "500": {
@@ -1176,27 +1401,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/objectives/secret": {
+        "/api/v1/games/{id}/audit": {
             "get": {
+                "description": "Lists the write requests and admin corrections made to a game, newest first.\nRequest entries carry the route, payload, response status, rows changed per table and the client\n(X-Client-ID header, IP and user agent). The total number of entries is returned in X-Total-Count.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "objectives"
+                    "games"
+                ],
+                "summary": "Game audit trail",
+                "operationId": "GetGameAudit",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (max 200)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List secret objectives",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "object",
-                                "additionalProperties": true
+                                "$ref": "#/definitions/models.AuditLog"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of entries"
                             }
                         }
                     },
-                    "500": {
+                    "400": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -1204,31 +1457,8 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/players": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "players"
-                ],
-                "summary": "List players",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "additionalProperties": true
-                            }
-                        }
                     },
-                    "500": {
+                    "404": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -1236,10 +1466,19 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/games/{id}/card-effects": {
             "post": {
+                "description": "Scores the card's victory points (or points, if given) for player_id in round_id, defaulting to the current round.\nThe score is an action_card score titled with the card name and can finish the game.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1247,27 +1486,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "scoring"
                 ],
-                "summary": "Create player",
+                "summary": "Record a card effect in a game",
+                "operationId": "RecordCardEffect",
                 "parameters": [
                     {
-                        "description": "Player (name required)",
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Card effect",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Player"
+                            "$ref": "#/definitions/models.CardEffectRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "error",
@@ -1278,7 +1521,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
+                    "409": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -1286,23 +1529,32 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/players/{id}/games": {
+        "/api/v1/games/{id}/clock": {
             "get": {
+                "description": "Time each player has spent on their turns, the running turn and who has passed this round.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "players"
+                    "clock",
+                    "games"
                 ],
-                "summary": "Get a player's games",
+                "summary": "Game clock",
+                "operationId": "GetGameClock",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Player ID",
+                        "type": "integer",
+                        "description": "Game ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1310,10 +1562,18 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "player, games",
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameClock"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
@@ -1324,24 +1584,31 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/players/{id}/scores/by-round": {
+        "/api/v1/games/{id}/exists": {
             "get": {
+                "description": "Returns {\"exists\": true|false}.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "scoring",
-                    "players"
+                    "games"
                 ],
-                "summary": "Player scores by round",
+                "summary": "Check game exists",
+                "operationId": "GetGameExists",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Player ID",
+                        "type": "integer",
+                        "description": "Game ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1351,36 +1618,33 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.ExistsResponse"
                         }
                     },
-                    "500": {
-                        "description": "error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.ExistsResponse"
                         }
                     }
                 }
             }
         },
-        "/players/{id}/scores/summary": {
+        "/api/v1/games/{id}/objectives": {
             "get": {
+                "description": "Returns all public objectives for a game, including stage and round.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "scoring",
-                    "players"
+                    "games"
                 ],
-                "summary": "Player score summary",
+                "summary": "Get game public objectives",
+                "operationId": "GetGameObjectives",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Player ID",
+                        "type": "integer",
+                        "description": "Game ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1390,11 +1654,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.GameObjective"
+                            }
                         }
                     },
-                    "404": {
+                    "500": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -1404,11 +1670,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/relic/crown": {
+            },
             "post": {
-                "description": "Grants 1 point to the specified player (one-time effect).",
+                "description": "Admin action to attach an objective to a specific game round.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1416,28 +1680,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "relics"
+                    "games"
                 ],
-                "summary": "Apply \"Crown of Emphidia\"",
+                "summary": "Manually assign a public objective to a round",
+                "operationId": "AssignObjective",
                 "parameters": [
                     {
-                        "description": "Game ID and player ID",
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assignment payload",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.RelicRequest"
+                            "$ref": "#/definitions/models.AssignObjectiveRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "message",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.MessageResponse"
                         }
                     },
                     "400": {
@@ -1449,6 +1718,12 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -1461,37 +1736,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/relic/latvina": {
-            "post": {
-                "description": "Grants a player a point for planets with 4 tech specialties.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/games/{id}/objectives/scores": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "relics"
+                    "scoring",
+                    "games"
                 ],
-                "summary": "Apply \"Book Of Latvina\"",
+                "summary": "Objective score summary for a game",
+                "operationId": "GetObjectiveScoreSummary",
                 "parameters": [
                     {
-                        "description": "Game ID and player ID",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.RelicRequest"
-                        }
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "message",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ObjectiveScoreSummary"
                             }
                         }
                     },
@@ -1504,6 +1775,12 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -1516,38 +1793,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/relic/obsidian": {
-            "post": {
-                "description": "Allows a player to score one additional secret objective this game.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/games/{id}/phase": {
+            "get": {
+                "description": "The phase of the game's current round and the phase that follows it. phase is empty for games that do not track phases.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "relics"
+                    "games"
                 ],
-                "summary": "Apply \"The Obsidian\"",
+                "summary": "Current phase",
+                "operationId": "GetPhase",
                 "parameters": [
                     {
-                        "description": "Game ID and player ID",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.RelicRequest"
-                        }
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "message",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.PhaseState"
                         }
                     },
                     "400": {
@@ -1559,7 +1829,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
+                    "404": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -1567,13 +1837,17 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/relic/shard": {
+            },
             "post": {
-                "description": "Transfers Shard; grants a point to new holder and removes from previous holder if applicable.",
+                "description": "Moves the current round to the next phase: strategy, action, status, then agenda once Custodians is claimed.\nAn empty phase moves to the next one. Games that do not track phases yet can start in any phase.\nWhile a game tracks phases, objectives, Custodians, Imperial and agendas can only be scored in their phase,\nand the round can only be advanced from its last phase.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1581,23 +1855,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "relics"
+                    "games"
                 ],
-                "summary": "Update \"Shard of the Throne\" holder",
+                "summary": "Change phase",
+                "operationId": "SetPhase",
                 "parameters": [
                     {
-                        "description": "Game ID and new holder ID",
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Phase to move to",
                         "name": "body",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.ShardRequest"
+                            "$ref": "#/definitions/models.PhaseRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "message",
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PhaseState"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1605,7 +1892,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "400": {
+                    "404": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -1614,7 +1901,7 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
+                    "409": {
                         "description": "error",
                         "schema": {
                             "type": "object",
@@ -1622,43 +1909,42 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/score": {
-            "delete": {
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/games/{id}/players": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "scoring"
+                    "players"
                 ],
-                "summary": "Delete a scored objective",
+                "summary": "List players in a game",
+                "operationId": "ListPlayersInGame",
                 "parameters": [
                     {
-                        "description": "game_id, player_id, objective_id",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object"
-                        }
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "error",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.GamePlayer"
                             }
                         }
                     },
@@ -1672,9 +1958,7 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/score/imperial": {
+            },
             "post": {
                 "consumes": [
                     "application/json"
@@ -1683,23 +1967,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "scoring"
+                    "players",
+                    "games"
                 ],
-                "summary": "Score Imperial point",
+                "summary": "Assign player to game",
+                "operationId": "AssignPlayerToGame",
                 "parameters": [
                     {
-                        "description": "game_id, player_id, round_id",
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Game ID, Player ID, Faction",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/models.AssignPlayerInput"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GamePlayer"
+                        }
                     },
                     "400": {
                         "description": "error",
@@ -1710,6 +2006,12 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -1722,32 +2024,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/score/imperial-rider": {
-            "post": {
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/games/{id}/relics": {
+            "get": {
+                "description": "Returns every relic gained in the game; holdings without lost_at are current.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "scoring"
+                    "relics"
                 ],
-                "summary": "Score Imperial Rider point",
+                "summary": "Relic holdings in a game",
+                "operationId": "GetGameRelics",
                 "parameters": [
                     {
-                        "description": "game_id, round_id, player_id",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object"
-                        }
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.RelicHoldingDTO"
+                            }
+                        }
                     },
                     "400": {
                         "description": "error",
@@ -1758,20 +2063,16 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "error",
+                    "422": {
+                        "description": "invalid fields",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/score/mecatol": {
+            },
             "post": {
+                "description": "action is gain (player_id gains the relic), lose (player_id loses it) or transfer (from player_id to to_player_id).\nVictory points from the relic are scored in the current round and can finish the game.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1779,24 +2080,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "scoring"
+                    "relics"
                 ],
-                "summary": "Score Custodians (Mecatol) point",
+                "summary": "Gain, lose or transfer a relic",
+                "operationId": "HandleRelicAction",
                 "parameters": [
                     {
-                        "description": "game_id, player_id, round_id",
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Relic action",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/models.RelicActionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.RelicHoldingDTO"
+                            }
+                        }
+                    },
                     "400": {
                         "description": "error",
                         "schema": {
@@ -1814,53 +2129,81 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/stats/objectives/difficulty": {
-            "get": {
-                "description": "Calculates and returns difficulty metrics for TI4 objectives.",
+        "/api/v1/games/{id}/relics/crown": {
+            "post": {
+                "description": "Grants 1 point to the specified player (one-time effect).",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "objectives",
-                    "stats"
+                    "relics"
                 ],
-                "summary": "Get objective difficulty",
+                "summary": "Apply \"Crown of Emphidia\"",
+                "operationId": "HandleCrownRelic",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "default": "all",
-                        "description": "Filter by stage (I, II, secret, or all)",
-                        "name": "stage",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
-                        "default": 5,
-                        "description": "Minimum appearances required to include",
-                        "name": "minAppearances",
-                        "in": "query"
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Minimum scoring opportunities required",
-                        "name": "minOpportunities",
-                        "in": "query"
+                        "description": "Game ID and player ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RelicRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ObjectiveDifficultyResponse"
+                            "$ref": "#/definitions/models.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1871,22 +2214,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/stats/overview": {
-            "get": {
-                "description": "Returns headline stats plus Custodians (Mecatol) stats per player.",
+        "/api/v1/games/{id}/relics/latvina": {
+            "post": {
+                "description": "Grants a player a point for planets with 4 tech specialties.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "stats"
+                    "relics"
+                ],
+                "summary": "Apply \"Book of Latvinia\"",
+                "operationId": "HandleLatvinaRelic",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Game ID and player ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RelicRequest"
+                        }
+                    }
                 ],
-                "summary": "Stats overview",
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
                         }
                     },
                     "500": {
@@ -1900,542 +2288,5849 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "controllers.RelicRequest": {
-            "type": "object",
-            "properties": {
-                "game_id": {
-                    "type": "integer"
-                },
-                "player_id": {
-                    "type": "integer"
-                }
-            }
-        },
-        "controllers.ShardRequest": {
-            "type": "object",
-            "properties": {
-                "game_id": {
-                    "type": "integer"
-                },
-                "new_holder_id": {
-                    "type": "integer"
-                }
-            }
         },
-        "models.AgendaResolution": {
-            "type": "object",
-            "properties": {
-                "for_votes": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
+        "/api/v1/games/{id}/relics/obsidian": {
+            "post": {
+                "description": "Allows a player to score one additional secret objective this game.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "relics"
+                ],
+                "summary": "Apply \"The Obsidian\"",
+                "operationId": "HandleObsidianRelic",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Game ID and player ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RelicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
-                },
-                "game_id": {
-                    "type": "integer"
-                },
-                "result": {
-                    "type": "string"
-                },
-                "round_id": {
-                    "type": "integer"
-                }
-            }
-        },
-        "models.AssignObjectiveRequest": {
-            "type": "object",
-            "properties": {
-                "game_id": {
-                    "type": "integer"
-                },
-                "objective_id": {
-                    "type": "integer"
-                },
-                "round_id": {
-                    "type": "integer"
-                }
-            }
-        },
-        "models.AssignPlayerInput": {
-            "type": "object",
-            "properties": {
-                "faction": {
-                    "type": "string"
-                },
-                "game_id": {
-                    "type": "integer"
-                },
-                "player_id": {
-                    "type": "integer"
                 }
             }
         },
-        "models.ClassifiedDocumentLeaksRequest": {
-            "type": "object",
+        "/api/v1/games/{id}/relics/shard": {
+            "post": {
+                "description": "Transfers Shard; grants a point to new holder and removes from previous holder if applicable.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "relics"
+                ],
+                "summary": "Update \"Shard of the Throne\" holder",
+                "operationId": "HandleShardRelic",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Game ID and new holder ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ShardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/rounds": {
+            "post": {
+                "description": "Advances the round; reveals a public objective unless none remain (then ends the game).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "games"
+                ],
+                "summary": "Advance game round",
+                "operationId": "AdvanceRound",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AdvanceRoundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error: the game tracks phases and the round is not in its last phase",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/scores": {
+            "post": {
+                "description": "Marks a player as having scored a specific objective in a game. A public objective must be revealed in the game\n(or assigned to it when the game does not use objective decks), and a player scores one per status phase unless\noverride is \"imperial\" (the Imperial strategy card, in the action phase) or \"other\" with an override_reason.\nA secret made public by Classified Document Leaks is scored as a public objective.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring"
+                ],
+                "summary": "Score an objective",
+                "operationId": "AddScore",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Objective scored",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScoreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error: not allowed in the current phase",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring"
+                ],
+                "summary": "Delete a scored objective",
+                "operationId": "DeleteScore",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Objective to unscore",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/scores/by-round": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring",
+                    "games"
+                ],
+                "summary": "Scores in a game by round",
+                "operationId": "GetScoresByRound",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.RoundScoresGroup"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/scores/imperial": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring"
+                ],
+                "summary": "Score Imperial point",
+                "operationId": "ScoreImperialPoint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player awarded the point",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error: not allowed in the current phase",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/scores/imperial-rider": {
+            "post": {
+                "description": "Shorthand for recording the Imperial Rider card effect; see POST /api/v1/games/{id}/card-effects.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring"
+                ],
+                "summary": "Score Imperial Rider point",
+                "operationId": "ScoreImperialRiderPoint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player awarded the point",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/scores/mecatol": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring"
+                ],
+                "summary": "Score Custodians (Mecatol) point",
+                "operationId": "ScoreMecatolPoint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player awarded the point",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PointRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/scores/summary": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring",
+                    "games"
+                ],
+                "summary": "Points per player in a game",
+                "operationId": "GetScoreSummary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PlayerScoreSummary"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/sessions": {
+            "get": {
+                "description": "The evenings a game has been played over and those scheduled, with RSVPs and the time played so far.\nNew games start their first session when they are created.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions",
+                    "games"
+                ],
+                "summary": "Game sessions",
+                "operationId": "GetGameSessions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameSessions"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Plans another evening of an unfinished game. The location defaults to the game's.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Schedule a session",
+                "operationId": "ScheduleSession",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Start time and place",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScheduleSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameSession"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/sessions/pause": {
+            "post": {
+                "description": "Ends the running session, and the running turn, when a game stops for the evening. Paused games do not count towards their duration, and turns cannot be started until the game is resumed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Pause a game",
+                "operationId": "PauseGame",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameSession"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/sessions/resume": {
+            "post": {
+                "description": "Starts the earliest scheduled session that has not started, or a new session if none is scheduled.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Resume a game",
+                "operationId": "ResumeGame",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameSession"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/sessions/{session_id}": {
+            "delete": {
+                "description": "Deletes a session that has not started, with its RSVPs.",
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Cancel a scheduled session",
+                "operationId": "CancelSession",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/sessions/{session_id}/rsvps": {
+            "post": {
+                "description": "Records whether a player in the game is coming: yes, no or maybe. A later answer replaces the earlier one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "RSVP to a session",
+                "operationId": "RespondToSession",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player and answer",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RSVPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameSession"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/speaker": {
+            "post": {
+                "description": "Assigns a speaker (initial or current) for a specific round.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "games"
+                ],
+                "summary": "Assign speaker",
+                "operationId": "PostAssignSpeaker",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "round_id is the round number; player_id is the game player ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AssignSpeakerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/speaker/random": {
+            "post": {
+                "description": "Randomly selects a speaker for the game.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "games"
+                ],
+                "summary": "Randomise speaker",
+                "operationId": "RandomiseSpeaker",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SpeakerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/support": {
+            "get": {
+                "description": "Every Support for the Throne given in the game; entries without ended_at are still held.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring",
+                    "games"
+                ],
+                "summary": "Support for the Throne in a game",
+                "operationId": "GetGameSupport",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SupportHoldingDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "give: owner_id's note goes to holder_id (+1 VP). return: the note goes back to its owner (-1 VP for the holder).\neliminate: player_id is eliminated; their note is purged (-1 VP for its holder) and notes they hold return to their owners.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring"
+                ],
+                "summary": "Give, return or eliminate for Support for the Throne",
+                "operationId": "HandleSupportAction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Support action",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SupportActionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SupportHoldingDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error: breaks a Support for the Throne rule",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/support/{player_id}": {
+            "post": {
+                "description": "Give or return Support for the Throne for a player in a game. \"score\" gives player_id the note of owner_id,\nwhich may be omitted when only one other player's note is available. \"unscore\" returns the note player_id\nmost recently received, or owner_id's note if given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring"
+                ],
+                "summary": "Support for the Throne",
+                "operationId": "SFTT",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "player_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "action (score|unscore), owner_id",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SupportForTheThroneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error: breaks a Support for the Throne rule",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/turns/end": {
+            "post": {
+                "description": "Stops the running clock without starting another, e.g. at the end of a phase.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clock"
+                ],
+                "summary": "End the running turn",
+                "operationId": "EndTurn",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameClock"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/turns/pass": {
+            "post": {
+                "description": "Ends the running action phase turn and marks its player as passed for the round.\nplayer_id is optional; when given it must be the player whose clock is running.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clock"
+                ],
+                "summary": "Pass",
+                "operationId": "PassTurn",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Passing player",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.TurnRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameClock"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/turns/start": {
+            "post": {
+                "description": "Starts player_id's clock in the given phase, stopping whoever's clock was running. The phase defaults to the round's phase when the game tracks phases, otherwise to action.\nA player who has passed cannot start another action phase turn in the same round.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clock"
+                ],
+                "summary": "Start a player's turn",
+                "operationId": "StartTurn",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player and phase",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TurnRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameClock"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/games/{id}/vp-breakdown": {
+            "get": {
+                "description": "Each player's points grouped by source (public, secret, mecatol, imperial, support, relic, agenda, action_card),\nlisting the objective, agenda, relic or action card behind every award.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scoring",
+                    "games"
+                ],
+                "summary": "Victory points by source for a game",
+                "operationId": "GetVPBreakdown",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Game ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PlayerVPBreakdown"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/import/games": {
+            "post": {
+                "description": "Creates finished games from a CSV (text/csv body or multipart \"file\" field) or a JSON body of the form {\"games\": [...]}.\nCSV rows are one per player per game, grouped by the game column; optional round_1..round_N columns give per-round points.\nGames without per-round points or a round count are marked partial. Nothing is imported if any row is invalid.",
+                "consumes": [
+                    "application/json",
+                    "text/csv",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "games"
+                ],
+                "summary": "Import historical games",
+                "operationId": "ImportGames",
+                "parameters": [
+                    {
+                        "description": "Games to import (JSON)",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.importGamesRequest"
+                        }
+                    },
+                    {
+                        "type": "file",
+                        "description": "Games to import (CSV)",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImportResult"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImportResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/objectives/public": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "objectives"
+                ],
+                "summary": "List public objectives",
+                "operationId": "GetAllPublicObjectives",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Objective"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/objectives/secret": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "objectives"
+                ],
+                "summary": "List secret objectives",
+                "operationId": "GetAllSecretObjectives",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Objective"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players": {
+            "get": {
+                "description": "The total number of players is returned in X-Total-Count.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "List players",
+                "operationId": "ListPlayers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (max 200)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort keys, prefix with - for descending (id, name)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated JSON fields to return (e.g. ID,Name)",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Player"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of players"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Create player",
+                "operationId": "CreatePlayer",
+                "parameters": [
+                    {
+                        "description": "Player (name required)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{id}": {
+            "get": {
+                "description": "Returns the player with their aliases and whether they are active.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get a player",
+                "operationId": "GetPlayer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{id}/aliases": {
+            "post": {
+                "description": "Aliases are matched, ignoring case, when games are created or imported.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Add a player alias",
+                "operationId": "AddPlayerAlias",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alias",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PlayerAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{id}/aliases/{alias}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Remove a player alias",
+                "operationId": "RemovePlayerAlias",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{id}/games": {
+            "get": {
+                "description": "The total number of games is returned in X-Total-Count.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Get a player's games",
+                "operationId": "GetPlayerGames",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (max 200)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort keys, prefix with - for descending (game_id, game_number, created_at, finished_at, faction, won)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated JSON fields to return for each game",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PlayerGamesResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of games for the player"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{id}/merge": {
+            "post": {
+                "description": "Moves the games, scores, speaker turns, achievements, relics and Support for the Throne of player {id} to into_player_id,\nthen deletes player {id} and keeps their name as an alias. Fails if both played in the same game.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Merge a duplicate player",
+                "operationId": "MergePlayer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player to merge away",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player to keep",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MergePlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{id}/reactivate": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Reactivate a retired player",
+                "operationId": "ReactivatePlayer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{id}/rename": {
+            "post": {
+                "description": "The new name must not belong to another player, as a name or alias. keep_alias keeps the old name as an alias.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Rename a player",
+                "operationId": "RenamePlayer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New name",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RenamePlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{id}/retire": {
+            "post": {
+                "description": "Retired players keep their games but are left off leaderboards.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "players"
+                ],
+                "summary": "Retire a player",
+                "operationId": "RetirePlayer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Player ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/relics": {
+            "get": {
+                "description": "Returns the relic catalogue with effect metadata.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "relics"
+                ],
+                "summary": "List relics",
+                "operationId": "ListRelics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Relic"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sessions/upcoming": {
+            "get": {
+                "description": "Scheduled sessions of unfinished games that have not started yet, soonest first, with RSVPs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Upcoming sessions",
+                "operationId": "GetUpcomingSessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.UpcomingSession"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sessions/upcoming.ics": {
+            "get": {
+                "description": "The upcoming sessions as a calendar feed to subscribe to. Each event lasts four hours and lists who is coming.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "sessions",
+                    "export"
+                ],
+                "summary": "Upcoming sessions as iCalendar",
+                "operationId": "ExportUpcomingSessionsICal",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/card-effects": {
+            "get": {
+                "description": "How often each action card or ability has been recorded across games and the net points it gave.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Card effect stats",
+                "operationId": "GetCardEffectStats",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CardEffectStats"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/clock": {
+            "get": {
+                "description": "Average round length by round number, players by average turn length and time spent in each phase.\nOnly games played with round timestamps or the turn timer are included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats",
+                    "clock"
+                ],
+                "summary": "Game clock stats",
+                "operationId": "GetClockStats",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ClockStats"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/objectives/difficulty": {
+            "get": {
+                "description": "Calculates and returns difficulty metrics for TI4 objectives.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "objectives",
+                    "stats"
+                ],
+                "summary": "Get objective difficulty",
+                "operationId": "GetObjectiveDifficulty",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "Filter by stage (I, II, secret, or all)",
+                        "name": "stage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Minimum appearances required to include",
+                        "name": "minAppearances",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Minimum scoring opportunities required",
+                        "name": "minOpportunities",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ObjectiveDifficultyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/overview": {
+            "get": {
+                "description": "Returns headline stats plus Custodians (Mecatol) stats per player.\nServed from a snapshot that is rebuilt after every write.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Stats overview",
+                "operationId": "GetStatsOverview",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.StatsOverview"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/support": {
+            "get": {
+                "description": "Who gives Support for the Throne to whom, how often it is returned and how often it is still held at game end.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Support for the Throne stats",
+                "operationId": "GetSupportStats",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SupportStats"
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/templates": {
+            "get": {
+                "description": "Saved game setups, by name.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Game templates",
+                "operationId": "ListGameTemplates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.GameTemplate"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "winning_points may be any target from 1 to 30. speaker_mode is random (default), first (the first listed player) or none.\nPlayers without a faction draw one from faction_pool, or from every faction when the pool is empty. Factions are stored under their catalogue names.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Save a game template",
+                "operationId": "CreateGameTemplate",
+                "parameters": [
+                    {
+                        "description": "Template",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GameTemplate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/templates/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Game template",
+                "operationId": "GetGameTemplate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameTemplate"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Games already created from the template are not changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Replace a game template",
+                "operationId": "UpdateGameTemplate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GameTemplate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GameTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Games created from the template are kept.",
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Delete a game template",
+                "operationId": "DeleteGameTemplate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "invalid fields",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "achievements.Badge": {
+            "type": "object",
+            "properties": {
+                "holders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/achievements_helper.Holder"
+                    }
+                },
+                "key": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "achievements.BadgeList": {
+            "type": "object",
+            "properties": {
+                "Count": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/achievements.Badge"
+                    }
+                }
+            }
+        },
+        "achievements_helper.Holder": {
+            "type": "object",
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "round_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controllers.RelicRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "player_id"
+            ],
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controllers.ShardRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "new_holder_id"
+            ],
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "new_holder_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controllers.importGamesRequest": {
+            "type": "object",
+            "properties": {
+                "games": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImportGame"
+                    }
+                }
+            }
+        },
+        "models.AdvanceRoundResponse": {
+            "type": "object",
+            "properties": {
+                "current_round": {
+                    "type": "integer"
+                },
+                "message": {
+                    "description": "\"round_advanced\" or \"Game Ended\"",
+                    "type": "string"
+                },
+                "revealed": {
+                    "description": "stage of the objective revealed",
+                    "type": "string"
+                },
+                "round": {
+                    "type": "integer"
+                },
+                "totalRevealed": {
+                    "type": "integer"
+                },
+                "winner_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                }
+            }
+        },
+        "models.AgendaResolution": {
+            "type": "object",
+            "required": [
+                "for_votes",
+                "game_id"
+            ],
+            "properties": {
+                "for_votes": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "game_id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "description": "empty records the agenda with no effect",
+                    "type": "string",
+                    "enum": [
+                        "for",
+                        "against"
+                    ]
+                },
+                "round_id": {
+                    "description": "0 for the current round",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AssignObjectiveRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "objective_id",
+                "round_id"
+            ],
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "objective_id": {
+                    "type": "integer"
+                },
+                "round_id": {
+                    "description": "round number",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "models.AssignPlayerInput": {
+            "type": "object",
+            "required": [
+                "faction",
+                "game_id",
+                "player_id"
+            ],
+            "properties": {
+                "faction": {
+                    "type": "string"
+                },
+                "game_id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AssignSpeakerRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "player_id",
+                "round_id"
+            ],
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "is_initial": {
+                    "description": "optional logic flag",
+                    "type": "boolean"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "round_id": {
+                    "description": "round number",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "after": {
+                    "type": "string"
+                },
+                "before": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "client_ip": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "entity": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "integer"
+                },
+                "game_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "route": {
+                    "type": "string"
+                },
+                "rows_changed": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Backup": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "file": {
+                    "type": "string"
+                },
+                "removed": {
+                    "description": "older backups deleted by rotation",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CardEffect": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "homebrew": {
+                    "description": "Homebrew cards are added through the API and are never overwritten by\nthe seeded catalogue.",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "victory_points": {
+                    "description": "VictoryPoints is the usual award; it is negative for cards that take\npoints away and can be overridden when the card is recorded.",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CardEffectRequest": {
+            "type": "object",
+            "required": [
+                "card",
+                "player_id"
+            ],
+            "properties": {
+                "card": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "points": {
+                    "description": "defaults to the card's victory_points",
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "round_id": {
+                    "description": "defaults to the current round",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CardEffectStats": {
+            "type": "object",
+            "properties": {
+                "card": {
+                    "type": "string"
+                },
+                "games": {
+                    "type": "integer"
+                },
+                "net_points": {
+                    "type": "integer"
+                },
+                "times_played": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ClassifiedDocumentLeaksRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "objective_id",
+                "player_id"
+            ],
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "objective_id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "round_id": {
+                    "description": "0 for the current round",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ClockStats": {
+            "type": "object",
+            "properties": {
+                "phases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PhaseTimeStat"
+                    }
+                },
+                "round_lengths": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RoundLengthStat"
+                    }
+                },
+                "slowest_players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerTurnStat"
+                    }
+                }
+            }
+        },
+        "models.CorrectGamePlayerRequest": {
+            "type": "object",
+            "required": [
+                "faction",
+                "reason"
+            ],
+            "properties": {
+                "faction": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CorrectScoreRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "player_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "points": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "round": {
+                    "description": "round number within the game",
+                    "type": "integer",
+                    "x-nullable": true
+                }
+            }
+        },
+        "models.CreateGameInput": {
+            "type": "object",
+            "required": [
+                "players"
+            ],
+            "properties": {
+                "location": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "players": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "minItems": 3,
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerInput"
+                    }
+                },
+                "speaker_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "track_phases": {
+                    "description": "start round 1 in the strategy phase",
+                    "type": "boolean",
+                    "x-nullable": true
+                },
+                "use_objective_decks": {
+                    "type": "boolean",
+                    "x-nullable": true
+                },
+                "use_random_speaker": {
+                    "type": "boolean",
+                    "x-nullable": true
+                },
+                "winning_points": {
+                    "description": "0 uses the configured default",
+                    "type": "integer",
+                    "enum": [
+                        10,
+                        14
+                    ]
+                }
+            }
+        },
+        "models.CreateGameResponse": {
+            "type": "object",
+            "properties": {
+                "game": {
+                    "$ref": "#/definitions/models.Game"
+                },
+                "revealed": {
+                    "description": "objectives revealed at setup",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GameObjective"
+                    }
+                }
+            }
+        },
+        "models.DeleteGameResponse": {
+            "type": "object",
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ExistsResponse": {
+            "type": "object",
+            "properties": {
+                "exists": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.Faction": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "description": "Aliases are other spellings accepted when a faction is entered by\nhand or imported, such as \"Hacan\" or \"L1\".",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                },
+                "commodities": {
+                    "type": "integer"
+                },
+                "expansion": {
+                    "type": "string"
+                },
+                "home_system": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FactionAggregateStats": {
+            "type": "object",
+            "properties": {
+                "faction": {
+                    "type": "string"
+                },
+                "totalPlays": {
+                    "type": "integer"
+                },
+                "totalPointsScored": {
+                    "type": "integer"
+                },
+                "vpHistogram": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.VPBucket"
+                    }
+                },
+                "wonCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.FactionPlayWinStat": {
+            "type": "object",
+            "properties": {
+                "playRate": {
+                    "type": "number"
+                },
+                "playedCount": {
+                    "type": "integer"
+                },
+                "winCount": {
+                    "type": "integer"
+                },
+                "winRate": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.FactionPlayerStats": {
+            "type": "object",
+            "properties": {
+                "faction": {
+                    "type": "string"
+                },
+                "playedCount": {
+                    "type": "integer"
+                },
+                "player": {
+                    "type": "string"
+                },
+                "totalPointsScored": {
+                    "type": "integer"
+                },
+                "wonCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Game": {
+            "type": "object",
+            "properties": {
+                "Partial": {
+                    "type": "boolean"
+                },
+                "SpeakerAssignments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SpeakerAssignment"
+                    }
+                },
+                "StartingSpeakerID": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "current_round": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                },
+                "game_number": {
+                    "type": "integer"
+                },
+                "game_objectives": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GameObjective"
+                    }
+                },
+                "house_rules": {
+                    "description": "HouseRules and TemplateID are set on games created from a template.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GamePlayer"
+                    }
+                },
+                "rounds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Round"
+                    }
+                },
+                "speaker": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "speaker_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "template_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "title": {
+                    "type": "string"
+                },
+                "use_objective_decks": {
+                    "type": "boolean"
+                },
+                "winner": {
+                    "$ref": "#/definitions/models.Player"
+                },
+                "winner_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "winning_points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.GameClock": {
+            "type": "object",
+            "properties": {
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerClock"
+                    }
+                },
+                "round": {
+                    "type": "integer"
+                },
+                "round_started_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                },
+                "running": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Turn"
+                        }
+                    ],
+                    "x-nullable": true
+                }
+            }
+        },
+        "models.GameDetailResponse": {
+            "type": "object",
+            "properties": {
+                "ScoresByObjective": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/models.ScoreDTO"
+                        }
+                    }
+                },
+                "all_scores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScoreDTO"
+                    }
+                },
+                "current_round": {
+                    "type": "integer"
+                },
+                "custodiansPlayerId": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "finished_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                },
+                "game_number": {
+                    "type": "integer"
+                },
+                "house_rules": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "objectives": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GameObjective"
+                    }
+                },
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GamePlayer"
+                    }
+                },
+                "relics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RelicHoldingDTO"
+                    }
+                },
+                "rounds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Round"
+                    }
+                },
+                "scores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerScoreSummary"
+                    }
+                },
+                "speaker_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "speaker_name": {
+                    "type": "string"
+                },
+                "support": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SupportHoldingDTO"
+                    }
+                },
+                "template_id": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "title": {
+                    "type": "string"
+                },
+                "use_objective_decks": {
+                    "type": "boolean"
+                },
+                "victory_path": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.VictoryPathSummary"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "winner": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Player"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "winning_points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.GameDurationStat": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "string"
+                },
+                "game_id": {
+                    "type": "integer"
+                },
+                "game_number": {
+                    "type": "integer"
+                },
+                "round_count": {
+                    "type": "integer"
+                },
+                "seconds": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "models.GameFromTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "players": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "minItems": 3,
+                    "items": {
+                        "$ref": "#/definitions/models.GameTemplatePlayer"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "models.GameLengthCategoryStats": {
+            "type": "object",
+            "properties": {
+                "average_game_time": {
+                    "type": "string"
+                },
+                "average_round_time": {
+                    "type": "string"
+                },
+                "longest_by_rounds": {
+                    "$ref": "#/definitions/models.GameDurationStat"
+                },
+                "longest_by_time": {
+                    "$ref": "#/definitions/models.GameDurationStat"
+                },
+                "shortest_by_rounds": {
+                    "$ref": "#/definitions/models.GameDurationStat"
+                },
+                "shortest_by_time": {
+                    "$ref": "#/definitions/models.GameDurationStat"
+                }
+            }
+        },
+        "models.GameLengthStats": {
+            "type": "object",
+            "properties": {
+                "all": {
+                    "$ref": "#/definitions/models.GameLengthCategoryStats"
+                },
+                "four_player": {
+                    "$ref": "#/definitions/models.GameLengthCategoryStats"
+                },
+                "three_player": {
+                    "$ref": "#/definitions/models.GameLengthCategoryStats"
+                }
+            }
+        },
+        "models.GameObjective": {
+            "type": "object",
+            "properties": {
+                "GameID": {
+                    "type": "integer"
+                },
+                "ID": {
+                    "type": "integer"
+                },
+                "IsCDL": {
+                    "type": "boolean"
+                },
+                "Objective": {
+                    "$ref": "#/definitions/models.Objective"
+                },
+                "ObjectiveID": {
+                    "type": "integer"
+                },
+                "Position": {
+                    "type": "integer"
+                },
+                "Revealed": {
+                    "type": "boolean"
+                },
+                "Round": {
+                    "$ref": "#/definitions/models.Round"
+                },
+                "RoundID": {
+                    "type": "integer"
+                },
+                "Stage": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GamePlayer": {
+            "type": "object",
+            "properties": {
+                "Eliminated": {
+                    "type": "boolean"
+                },
+                "Faction": {
+                    "description": "canonical faction name, kept for grouping in stats",
+                    "type": "string"
+                },
+                "FactionID": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "GameID": {
+                    "type": "integer"
+                },
+                "ID": {
+                    "type": "integer"
+                },
+                "Player": {
+                    "$ref": "#/definitions/models.Player"
+                },
+                "PlayerID": {
+                    "type": "integer"
+                },
+                "Won": {
+                    "type": "boolean"
+                },
+                "faction_ref": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Faction"
+                        }
+                    ],
+                    "x-nullable": true
+                }
+            }
+        },
+        "models.GameSession": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "ended_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                },
+                "game_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "rsvps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SessionRSVP"
+                    }
+                },
+                "started_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                },
+                "starts_at": {
+                    "description": "when it is scheduled for",
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                }
+            }
+        },
+        "models.GameSessions": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "string"
+                },
+                "active_seconds": {
+                    "type": "integer"
+                },
+                "running": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.GameSession"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GameSession"
+                    }
+                }
+            }
+        },
+        "models.GameTemplate": {
+            "type": "object",
+            "required": [
+                "faction_pool",
+                "house_rules",
+                "name",
+                "winning_points"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "faction_pool": {
+                    "description": "FactionPool is what players without a faction draw from; empty means\nevery faction in the catalogue.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "house_rules": {
+                    "description": "HouseRules are copied onto each game made from the template, e.g.\n\"no_custodians\" or \"14_point_stage_ii\".",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "players": {
+                    "type": "array",
+                    "maxItems": 8,
+                    "items": {
+                        "$ref": "#/definitions/models.GameTemplatePlayer"
+                    }
+                },
+                "speaker_mode": {
+                    "description": "defaults to random",
+                    "type": "string",
+                    "enum": [
+                        "random",
+                        "first",
+                        "none"
+                    ]
+                },
+                "track_phases": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "use_objective_decks": {
+                    "type": "boolean"
+                },
+                "winning_points": {
+                    "type": "integer",
+                    "maximum": 30,
+                    "minimum": 1
+                }
+            }
+        },
+        "models.GameTemplatePlayer": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "faction": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ImportError": {
+            "type": "object",
+            "properties": {
+                "game": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "player": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ImportGame": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "YYYY-MM-DD or RFC 3339",
+                    "type": "string"
+                },
+                "game": {
+                    "description": "groups CSV rows; optional in JSON",
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImportPlayer"
+                    }
+                },
+                "rounds": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "winning_points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ImportPlayer": {
+            "type": "object",
+            "properties": {
+                "faction": {
+                    "type": "string"
+                },
+                "final_points": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "name": {
+                    "type": "string"
+                },
+                "round_points": {
+                    "description": "points scored in each round, optional",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "won": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ImportResult": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImportError"
+                    }
+                },
+                "imported": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImportedGame"
+                    }
+                }
+            }
+        },
+        "models.ImportedGame": {
+            "type": "object",
+            "properties": {
+                "game": {
+                    "type": "string"
+                },
+                "game_id": {
+                    "type": "integer"
+                },
+                "game_number": {
+                    "type": "integer"
+                },
+                "partial": {
+                    "type": "boolean"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.IncentiveProgramRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "outcome"
+            ],
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "outcome": {
+                    "type": "string",
+                    "enum": [
+                        "for",
+                        "against"
+                    ]
+                }
+            }
+        },
+        "models.MergePlayerRequest": {
+            "type": "object",
+            "required": [
+                "into_player_id"
+            ],
+            "properties": {
+                "into_player_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Objective": {
+            "type": "object",
+            "properties": {
+                "ID": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ObjectiveDifficultyResponse": {
+            "type": "object",
+            "properties": {
+                "filters": {
+                    "description": "Echoed filters used to compute the response.\nExample: {\"stage\":\"I\",\"minAppearances\":\"5\",\"minOpportunities\":\"0\"}",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "\"minAppearances\"": "\"5\"",
+                        "\"minOpportunities\"": "\"0\"}",
+                        "{\"stage\"": "\"I\""
+                    }
+                },
+                "generated_at": {
+                    "description": "Timestamp when these stats were generated.",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-08-17T14:32:10Z"
+                },
+                "rows": {
+                    "description": "Rows of per-objective difficulty metrics, sorted by difficulty desc then adj_rate asc.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ObjectiveDifficultyRow"
+                    }
+                }
+            }
+        },
+        "models.ObjectiveDifficultyRow": {
+            "type": "object",
+            "properties": {
+                "adj_rate": {
+                    "description": "Bayesian-adjusted scoring rate (alpha=2, beta=5).",
+                    "type": "number",
+                    "example": 0.5714
+                },
+                "appearances": {
+                    "description": "Number of distinct games in which this objective appeared (was revealed).",
+                    "type": "integer",
+                    "example": 18
+                },
+                "avg_round": {
+                    "description": "Average round number when this objective was first scored in a game.",
+                    "type": "number",
+                    "example": 2.3
+                },
+                "difficulty": {
+                    "description": "Difficulty as 1 - RawRate (higher is harder).",
+                    "type": "number",
+                    "example": 0.4222
+                },
+                "median_round": {
+                    "description": "Median round number when this objective was first scored in a game.",
+                    "type": "number",
+                    "example": 2
+                },
+                "name": {
+                    "description": "Objective name as stored in the objectives table.",
+                    "type": "string",
+                    "example": "Expand Borders"
+                },
+                "objective_id": {
+                    "description": "Database ID of the objective.",
+                    "type": "integer",
+                    "example": 12
+                },
+                "opportunities": {
+                    "description": "Total number of player-opportunities to score this objective\n(sum of players in games where the objective appeared).",
+                    "type": "integer",
+                    "example": 90
+                },
+                "phase": {
+                    "description": "Phase in which the objective is scored (e.g., \"Status\").",
+                    "type": "string",
+                    "example": "Status"
+                },
+                "raw_rate": {
+                    "description": "Raw scoring rate S/O.",
+                    "type": "number",
+                    "example": 0.5778
+                },
+                "scores": {
+                    "description": "Total number of times players actually scored this objective.",
+                    "type": "integer",
+                    "example": 52
+                },
+                "stage": {
+                    "description": "Objective stage.\nAllowed values depend on your dataset; commonly \"I\", \"II\" (public objectives).",
+                    "type": "string",
+                    "enum": [
+                        "I",
+                        "II"
+                    ],
+                    "example": "I"
+                },
+                "wilson_hi": {
+                    "description": "Wilson score interval (upper bound, 95% CI).",
+                    "type": "number",
+                    "example": 0.68
+                },
+                "wilson_lo": {
+                    "description": "Wilson score interval (lower bound, 95% CI).",
+                    "type": "number",
+                    "example": 0.47
+                }
+            }
+        },
+        "models.ObjectiveMeta": {
+            "type": "object",
+            "properties": {
+                "averageRound": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scoredPercent": {
+                    "type": "number"
+                },
+                "timesAppeared": {
+                    "type": "integer"
+                },
+                "timesScored": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ObjectiveScoreSummary": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "objective_id": {
+                    "type": "integer"
+                },
+                "scored_by": {
+                    "description": "player names",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "stage": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ObjectiveStats": {
+            "type": "object",
+            "properties": {
+                "appearanceRate": {
+                    "type": "number"
+                },
+                "appearedCount": {
+                    "type": "integer"
+                },
+                "scoredCount": {
+                    "type": "integer"
+                },
+                "scoredWhenAppearedRate": {
+                    "type": "number"
+                },
+                "type": {
+                    "description": "\"public\" or \"secret\"",
+                    "type": "string"
+                }
+            }
+        },
+        "models.PhaseRequest": {
+            "type": "object",
+            "properties": {
+                "phase": {
+                    "description": "empty moves to the next phase",
+                    "type": "string"
+                }
+            }
+        },
+        "models.PhaseState": {
+            "type": "object",
+            "properties": {
+                "custodians_claimed": {
+                    "type": "boolean"
+                },
+                "next": {
+                    "description": "empty when the round should be advanced next",
+                    "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "round": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PhaseTimeStat": {
+            "type": "object",
+            "properties": {
+                "average_per_round": {
+                    "type": "string"
+                },
+                "average_per_round_seconds": {
+                    "type": "integer"
+                },
+                "average_turn_seconds": {
+                    "type": "integer"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "total_seconds": {
+                    "type": "integer"
+                },
+                "turns": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Player": {
+            "type": "object",
+            "properties": {
+                "Active": {
+                    "description": "retired players are left off leaderboards",
+                    "type": "boolean"
+                },
+                "Aliases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerAlias"
+                    }
+                },
+                "ID": {
+                    "type": "integer"
+                },
+                "Name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PlayerAlias": {
+            "type": "object",
+            "properties": {
+                "Alias": {
+                    "description": "stored lower case",
+                    "type": "string"
+                }
+            }
+        },
+        "models.PlayerAliasRequest": {
+            "type": "object",
+            "required": [
+                "alias"
+            ],
+            "properties": {
+                "alias": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "models.PlayerAveragePoints": {
+            "type": "object",
+            "properties": {
+                "averagePoints": {
+                    "type": "number"
+                },
+                "gamesPlayed": {
+                    "type": "integer"
+                },
+                "player": {
+                    "type": "string"
+                },
+                "stdev": {
+                    "type": "number"
+                },
+                "totalPoints": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PlayerClock": {
+            "type": "object",
+            "properties": {
+                "passed": {
+                    "description": "passed in the current round",
+                    "type": "boolean"
+                },
+                "player": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "seconds": {
+                    "type": "integer"
+                },
+                "turns": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PlayerFactionStats": {
+            "type": "object",
+            "properties": {
+                "factions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "player": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PlayerGamesResponse": {
+            "type": "object",
+            "properties": {
+                "games": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GamePlayer"
+                    }
+                },
+                "player": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PlayerInput": {
+            "type": "object",
+            "required": [
+                "Faction"
+            ],
+            "properties": {
+                "Faction": {
+                    "type": "string"
+                },
+                "ID": {
+                    "type": "string"
+                },
+                "Name": {
+                    "type": "string"
+                },
+                "New": {
+                    "description": "create the player even if the name is close to an existing one",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.PlayerMostCommonFinish": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "player": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "totalGames": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PlayerPointStdev": {
+            "type": "object",
+            "properties": {
+                "player": {
+                    "type": "string"
+                },
+                "stdev": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PlayerScoreSummary": {
+            "type": "object",
+            "properties": {
+                "player_id": {
+                    "type": "integer"
+                },
+                "player_name": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PlayerSupportStats": {
+            "type": "object",
+            "properties": {
+                "given": {
+                    "type": "integer"
+                },
+                "held_at_game_end": {
+                    "type": "integer"
+                },
+                "player": {
+                    "type": "string"
+                },
+                "received": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PlayerTurnStat": {
+            "type": "object",
+            "properties": {
+                "average_turn": {
+                    "type": "string"
+                },
+                "average_turn_seconds": {
+                    "type": "integer"
+                },
+                "player": {
+                    "type": "string"
+                },
+                "total_seconds": {
+                    "type": "integer"
+                },
+                "turns": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PlayerVPBreakdown": {
+            "type": "object",
+            "properties": {
+                "faction": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "player_name": {
+                    "type": "string"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.VPSourceBreakdown"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PlayerWinRate": {
+            "type": "object",
+            "properties": {
+                "gamesPlayed": {
+                    "type": "integer"
+                },
+                "gamesWon": {
+                    "type": "integer"
+                },
+                "player": {
+                    "type": "string"
+                },
+                "winRate": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PointRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "player_id"
+            ],
+            "properties": {
+                "game_id": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "round_id": {
+                    "description": "0 for the current round",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PoliticalCensureRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "player_id"
+            ],
             "properties": {
+                "gained": {
+                    "type": "boolean"
+                },
                 "game_id": {
                     "type": "integer"
                 },
-                "objective_id": {
+                "player_id": {
+                    "type": "integer"
+                },
+                "round_id": {
+                    "description": "0 for the current round",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RSVPRequest": {
+            "type": "object",
+            "required": [
+                "player_id",
+                "status"
+            ],
+            "properties": {
+                "player_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "yes",
+                        "no",
+                        "maybe"
+                    ]
+                }
+            }
+        },
+        "models.ReasonRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RecomputeResultRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                },
+                "winner_id": {
+                    "description": "overrides the winner worked out from scores",
+                    "type": "integer",
+                    "x-nullable": true
+                }
+            }
+        },
+        "models.Relic": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "points_follow_holder": {
+                    "description": "PointsFollowHolder means the points are lost with the relic and move\nwith it on transfer (Shard of the Throne). Otherwise they are kept.",
+                    "type": "boolean"
+                },
+                "secret_cap_modifier": {
+                    "description": "SecretCapModifier is added to the holder's secret objective limit.",
+                    "type": "integer"
+                },
+                "transferable": {
+                    "type": "boolean"
+                },
+                "victory_points": {
+                    "description": "VictoryPoints is awarded when the relic is gained.",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RelicActionRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "player_id",
+                "relic"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "gain",
+                        "lose",
+                        "transfer"
+                    ]
+                },
+                "player_id": {
+                    "description": "gaining or losing player",
                     "type": "integer"
                 },
+                "relic": {
+                    "type": "string"
+                },
+                "to_player_id": {
+                    "description": "transfer target",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RelicHoldingDTO": {
+            "type": "object",
+            "properties": {
+                "gained_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "lost_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                },
                 "player_id": {
                     "type": "integer"
                 },
+                "player_name": {
+                    "type": "string"
+                },
+                "relic": {
+                    "type": "string"
+                },
+                "relic_id": {
+                    "type": "integer"
+                },
                 "round_id": {
                     "type": "integer"
                 }
             }
         },
-        "models.CreateGameInput": {
+        "models.RenamePlayerRequest": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "players": {
+                "keep_alias": {
+                    "description": "keep the old name as an alias",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "models.Round": {
+            "type": "object",
+            "properties": {
+                "EndedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                },
+                "GameID": {
+                    "type": "integer"
+                },
+                "ID": {
+                    "type": "integer"
+                },
+                "Number": {
+                    "type": "integer"
+                },
+                "Phase": {
+                    "description": "empty unless the game tracks phases",
+                    "type": "string"
+                },
+                "Scores": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PlayerInput"
+                        "$ref": "#/definitions/models.Score"
+                    }
+                },
+                "StartedAt": {
+                    "description": "nil for rounds recorded before rounds were timed",
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                }
+            }
+        },
+        "models.RoundLengthStat": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "string"
+                },
+                "average_seconds": {
+                    "type": "integer"
+                },
+                "games": {
+                    "type": "integer"
+                },
+                "round": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RoundScore": {
+            "type": "object",
+            "properties": {
+                "player": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "round": {
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RoundScoresGroup": {
+            "type": "object",
+            "properties": {
+                "round": {
+                    "type": "integer"
+                },
+                "scores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RoundScore"
                     }
+                }
+            }
+        },
+        "models.ScheduleSessionRequest": {
+            "type": "object",
+            "required": [
+                "starts_at"
+            ],
+            "properties": {
+                "location": {
+                    "description": "defaults to the game's location",
+                    "type": "string",
+                    "maxLength": 120
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "models.Score": {
+            "type": "object",
+            "properties": {
+                "AgendaTitle": {
+                    "type": "string"
+                },
+                "CardTitle": {
+                    "description": "action card or promissory note that awarded the points",
+                    "type": "string"
+                },
+                "GameID": {
+                    "type": "integer"
+                },
+                "ID": {
+                    "type": "integer"
+                },
+                "Objective": {
+                    "$ref": "#/definitions/models.Objective"
+                },
+                "ObjectiveID": {
+                    "type": "integer"
+                },
+                "OriginallySecret": {
+                    "type": "boolean"
+                },
+                "Override": {
+                    "description": "see ScoreOverrideImperial; empty for a normal score",
+                    "type": "string"
+                },
+                "OverrideReason": {
+                    "type": "string"
+                },
+                "Phase": {
+                    "description": "phase of the round when scored",
+                    "type": "string"
+                },
+                "Player": {
+                    "$ref": "#/definitions/models.Player"
+                },
+                "PlayerID": {
+                    "type": "integer"
+                },
+                "Points": {
+                    "type": "integer"
+                },
+                "RelicTitle": {
+                    "type": "string"
+                },
+                "Round": {
+                    "$ref": "#/definitions/models.Round"
+                },
+                "RoundID": {
+                    "type": "integer"
+                },
+                "Type": {
+                    "description": "see ScoreSources",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ScoreSource"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "models.ScoreDTO": {
+            "type": "object",
+            "properties": {
+                "agenda_title": {
+                    "type": "string"
+                },
+                "card_title": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "game_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "objective_id": {
+                    "type": "integer"
+                },
+                "originally_secret": {
+                    "type": "boolean"
+                },
+                "override": {
+                    "type": "string"
+                },
+                "override_reason": {
+                    "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "relic_title": {
+                    "type": "string"
+                },
+                "round_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.ScoreSource"
+                }
+            }
+        },
+        "models.ScoreRequest": {
+            "type": "object",
+            "required": [
+                "game_id",
+                "objective_id",
+                "player_id"
+            ],
+            "properties": {
+                "game_id": {
+                    "type": "integer"
                 },
-                "speaker_id": {
+                "objective_id": {
                     "type": "integer"
                 },
-                "use_objective_decks": {
-                    "type": "boolean"
+                "override": {
+                    "description": "Override scores a public objective beyond the one per status phase:\n\"imperial\" for the Imperial strategy card, \"other\" with a reason.",
+                    "type": "string",
+                    "enum": [
+                        "imperial",
+                        "other"
+                    ]
                 },
-                "use_random_speaker": {
-                    "type": "boolean"
+                "override_reason": {
+                    "type": "string",
+                    "maxLength": 200
                 },
-                "winning_points": {
+                "player_id": {
                     "type": "integer"
                 }
             }
         },
-        "models.Game": {
+        "models.ScoreResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "message": {
+                    "description": "\"Score added\" or \"Game finished\"",
                     "type": "string"
                 },
-                "current_round": {
-                    "type": "integer"
-                },
-                "finished_at": {
+                "objective": {
                     "type": "string"
                 },
-                "game_number": {
-                    "type": "integer"
-                },
-                "game_objectives": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.GameObjective"
-                    }
-                },
-                "id": {
+                "points": {
                     "type": "integer"
                 },
-                "partial": {
-                    "type": "boolean"
-                },
-                "players": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.GamePlayer"
-                    }
-                },
-                "rounds": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Round"
-                    }
-                },
-                "speaker": {
-                    "$ref": "#/definitions/models.Player"
-                },
-                "speakerAssignments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.SpeakerAssignment"
-                    }
-                },
-                "speaker_id": {
+                "round": {
                     "type": "integer"
                 },
-                "startingSpeakerID": {
+                "total_points": {
                     "type": "integer"
                 },
-                "use_objective_decks": {
-                    "type": "boolean"
-                },
                 "winner": {
-                    "$ref": "#/definitions/models.Player"
+                    "type": "integer",
+                    "x-nullable": true
+                }
+            }
+        },
+        "models.ScoreSource": {
+            "type": "string",
+            "enum": [
+                "public",
+                "secret",
+                "mecatol",
+                "imperial",
+                "support",
+                "relic",
+                "agenda",
+                "action_card",
+                "imported"
+            ],
+            "x-enum-comments": {
+                "ScoreTypeMecatol": "Custodians token",
+                "ScoreTypeSupport": "Support for the Throne"
+            },
+            "x-enum-descriptions": [
+                "",
+                "",
+                "Custodians token",
+                "",
+                "Support for the Throne",
+                "",
+                "",
+                "",
+                ""
+            ],
+            "x-enum-varnames": [
+                "ScoreTypePublic",
+                "ScoreTypeSecret",
+                "ScoreTypeMecatol",
+                "ScoreTypeImperial",
+                "ScoreTypeSupport",
+                "ScoreTypeRelic",
+                "ScoreTypeAgenda",
+                "ScoreTypeActionCard",
+                "ScoreTypeImported"
+            ]
+        },
+        "models.SecretObjectiveRate": {
+            "type": "object",
+            "properties": {
+                "player": {
+                    "type": "string"
                 },
-                "winner_id": {
+                "secretAppeared": {
                     "type": "integer"
                 },
-                "winning_points": {
+                "secretScoreRate": {
+                    "type": "number"
+                },
+                "secretScored": {
                     "type": "integer"
                 }
             }
         },
-        "models.GameObjective": {
+        "models.SeedOfEmpireResolution": {
             "type": "object",
+            "required": [
+                "game_id",
+                "result"
+            ],
             "properties": {
-                "GameID": {
+                "game_id": {
                     "type": "integer"
                 },
-                "ID": {
-                    "type": "integer"
+                "result": {
+                    "type": "string",
+                    "enum": [
+                        "for",
+                        "against"
+                    ]
                 },
-                "IsCDL": {
-                    "type": "boolean"
+                "round_id": {
+                    "description": "0 for the current round",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SessionRSVP": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
                 },
-                "Objective": {
-                    "$ref": "#/definitions/models.Objective"
+                "player": {
+                    "type": "string"
                 },
-                "ObjectiveID": {
+                "player_id": {
                     "type": "integer"
                 },
-                "Round": {
-                    "$ref": "#/definitions/models.Round"
-                },
-                "RoundID": {
+                "session_id": {
                     "type": "integer"
                 },
-                "Stage": {
+                "status": {
                     "type": "string"
                 },
-                "position": {
-                    "type": "integer"
-                },
-                "revealed": {
-                    "type": "boolean"
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "models.GamePlayer": {
+        "models.SpeakerAssignment": {
             "type": "object",
             "properties": {
-                "faction": {
-                    "type": "string"
+                "Game": {
+                    "$ref": "#/definitions/models.Game"
                 },
-                "gameID": {
+                "GameID": {
                     "type": "integer"
                 },
-                "id": {
+                "ID": {
                     "type": "integer"
                 },
-                "player": {
+                "Player": {
                     "$ref": "#/definitions/models.Player"
                 },
-                "playerID": {
+                "PlayerID": {
                     "type": "integer"
                 },
-                "won": {
-                    "type": "boolean"
+                "Round": {
+                    "$ref": "#/definitions/models.Round"
+                },
+                "RoundID": {
+                    "type": "integer"
                 }
             }
         },
-        "models.IncentiveProgramRequest": {
+        "models.SpeakerResponse": {
             "type": "object",
             "properties": {
-                "game_id": {
+                "speaker_id": {
                     "type": "integer"
                 },
-                "outcome": {
+                "speaker_name": {
                     "type": "string"
                 }
             }
         },
-        "models.Objective": {
+        "models.SupportActionRequest": {
             "type": "object",
+            "required": [
+                "action"
+            ],
             "properties": {
-                "description": {
-                    "type": "string"
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "give",
+                        "return",
+                        "eliminate"
+                    ]
                 },
-                "id": {
+                "holder_id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
-                },
-                "phase": {
-                    "type": "string"
-                },
-                "points": {
+                "owner_id": {
+                    "description": "give needs both sides; return needs either, which the service checks.",
                     "type": "integer"
                 },
-                "stage": {
-                    "type": "string"
-                },
-                "type": {
-                    "type": "string"
+                "player_id": {
+                    "description": "eliminated player",
+                    "type": "integer"
                 }
             }
         },
-        "models.ObjectiveDifficultyResponse": {
+        "models.SupportForTheThroneRequest": {
             "type": "object",
+            "required": [
+                "action"
+            ],
             "properties": {
-                "filters": {
-                    "description": "Echoed filters used to compute the response.\nExample: {\"stage\":\"I\",\"minAppearances\":\"5\",\"minOpportunities\":\"0\"}",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    },
-                    "example": {
-                        "\"minAppearances\"": "\"5\"",
-                        "\"minOpportunities\"": "\"0\"}",
-                        "{\"stage\"": "\"I\""
-                    }
-                },
-                "generated_at": {
-                    "description": "Timestamp when these stats were generated.",
+                "action": {
                     "type": "string",
-                    "format": "date-time",
-                    "example": "2025-08-17T14:32:10Z"
+                    "enum": [
+                        "score",
+                        "unscore"
+                    ]
                 },
-                "rows": {
-                    "description": "Rows of per-objective difficulty metrics, sorted by difficulty desc then adj_rate asc.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ObjectiveDifficultyRow"
-                    }
+                "owner_id": {
+                    "type": "integer"
+                },
+                "round_id": {
+                    "type": "integer"
                 }
             }
         },
-        "models.ObjectiveDifficultyRow": {
+        "models.SupportHoldingDTO": {
             "type": "object",
             "properties": {
-                "adj_rate": {
-                    "description": "Bayesian-adjusted scoring rate (alpha=2, beta=5).",
-                    "type": "number",
-                    "example": 0.5714
-                },
-                "appearances": {
-                    "description": "Number of distinct games in which this objective appeared (was revealed).",
-                    "type": "integer",
-                    "example": 18
-                },
-                "avg_round": {
-                    "description": "Average round number when this objective was first scored in a game.",
-                    "type": "number",
-                    "example": 2.3
-                },
-                "difficulty": {
-                    "description": "Difficulty as 1 - RawRate (higher is harder).",
-                    "type": "number",
-                    "example": 0.4222
+                "end_reason": {
+                    "type": "string"
                 },
-                "median_round": {
-                    "description": "Median round number when this objective was first scored in a game.",
-                    "type": "number",
-                    "example": 2
+                "ended_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
                 },
-                "name": {
-                    "description": "Objective name as stored in the objectives table.",
+                "given_at": {
                     "type": "string",
-                    "example": "Expand Borders"
+                    "format": "date-time"
                 },
-                "objective_id": {
-                    "description": "Database ID of the objective.",
-                    "type": "integer",
-                    "example": 12
+                "holder_id": {
+                    "type": "integer"
                 },
-                "opportunities": {
-                    "description": "Total number of player-opportunities to score this objective\n(sum of players in games where the objective appeared).",
-                    "type": "integer",
-                    "example": 90
+                "holder_name": {
+                    "type": "string"
                 },
-                "phase": {
-                    "description": "Phase in which the objective is scored (e.g., \"Status\").",
-                    "type": "string",
-                    "example": "Status"
+                "owner_id": {
+                    "type": "integer",
+                    "x-nullable": true
                 },
-                "raw_rate": {
-                    "description": "Raw scoring rate S/O.",
-                    "type": "number",
-                    "example": 0.5778
+                "owner_name": {
+                    "type": "string"
                 },
-                "scores": {
-                    "description": "Total number of times players actually scored this objective.",
-                    "type": "integer",
-                    "example": 52
+                "round_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SupportPairStats": {
+            "type": "object",
+            "properties": {
+                "held_at_end": {
+                    "type": "integer"
                 },
-                "stage": {
-                    "description": "Objective stage.\nAllowed values depend on your dataset; commonly \"I\", \"II\" (public objectives).",
-                    "type": "string",
-                    "enum": [
-                        "I",
-                        "II"
-                    ],
-                    "example": "I"
+                "holder": {
+                    "type": "string"
                 },
-                "wilson_hi": {
-                    "description": "Wilson score interval (upper bound, 95% CI).",
-                    "type": "number",
-                    "example": 0.68
+                "owner": {
+                    "type": "string"
                 },
-                "wilson_lo": {
-                    "description": "Wilson score interval (lower bound, 95% CI).",
-                    "type": "number",
-                    "example": 0.47
+                "returned": {
+                    "type": "integer"
+                },
+                "times_given": {
+                    "type": "integer"
                 }
             }
         },
-        "models.Player": {
+        "models.SupportStats": {
+            "type": "object",
+            "properties": {
+                "pairs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SupportPairStats"
+                    }
+                },
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerSupportStats"
+                    }
+                }
+            }
+        },
+        "models.Turn": {
             "type": "object",
             "properties": {
-                "gameID": {
+                "ended_at": {
+                    "type": "string",
+                    "format": "date-time",
+                    "x-nullable": true
+                },
+                "game_id": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
+                "passed": {
+                    "type": "boolean"
+                },
+                "phase": {
                     "type": "string"
+                },
+                "player_id": {
+                    "type": "integer"
+                },
+                "round_id": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "models.PlayerInput": {
+        "models.TurnRequest": {
             "type": "object",
+            "required": [
+                "player_id"
+            ],
             "properties": {
-                "faction": {
+                "phase": {
+                    "description": "defaults to the round's phase, or action",
                     "type": "string"
                 },
-                "id": {
+                "player_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.UnknownPlayerResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
                     "type": "string"
                 },
-                "name": {
+                "player": {
                     "type": "string"
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "models.PoliticalCensureRequest": {
+        "models.UpcomingSession": {
             "type": "object",
             "properties": {
-                "gained": {
-                    "type": "boolean"
-                },
-                "game_id": {
+                "game_number": {
                     "type": "integer"
                 },
-                "player_id": {
-                    "type": "integer"
+                "game_title": {
+                    "type": "string"
                 },
-                "round_id": {
-                    "type": "integer"
+                "session": {
+                    "$ref": "#/definitions/models.GameSession"
                 }
             }
         },
-        "models.Round": {
+        "models.VPBucket": {
             "type": "object",
             "properties": {
-                "gameID": {
+                "count": {
                     "type": "integer"
                 },
-                "id": {
+                "vp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.VPProvenance": {
+            "type": "object",
+            "properties": {
+                "points": {
                     "type": "integer"
                 },
-                "number": {
+                "round": {
                     "type": "integer"
                 },
-                "scores": {
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.VPSourceBreakdown": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Score"
+                        "$ref": "#/definitions/models.VPProvenance"
                     }
+                },
+                "label": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "source": {
+                    "$ref": "#/definitions/models.ScoreSource"
                 }
             }
         },
-        "models.Score": {
+        "models.ValidationErrorResponse": {
             "type": "object",
             "properties": {
-                "agendaTitle": {
-                    "type": "string"
-                },
-                "created_at": {
+                "error": {
                     "type": "string"
                 },
-                "gameID": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FieldError"
+                    }
+                }
+            }
+        },
+        "models.VictoryPath": {
+            "type": "object",
+            "properties": {
+                "action_card": {
                     "type": "integer"
                 },
-                "id": {
+                "agenda": {
                     "type": "integer"
                 },
-                "objective": {
-                    "$ref": "#/definitions/models.Objective"
-                },
-                "objectiveID": {
+                "custodians": {
                     "type": "integer"
                 },
-                "originallySecret": {
-                    "type": "boolean"
-                },
-                "player": {
-                    "$ref": "#/definitions/models.Player"
-                },
-                "playerID": {
+                "imperial": {
                     "type": "integer"
                 },
-                "points": {
+                "relics": {
                     "type": "integer"
                 },
-                "relicTitle": {
-                    "type": "string"
+                "secrets": {
+                    "type": "integer"
                 },
-                "round": {
-                    "$ref": "#/definitions/models.Round"
+                "stage1": {
+                    "type": "integer"
                 },
-                "roundID": {
+                "stage2scored": {
                     "type": "integer"
                 },
-                "type": {
-                    "description": "e.g. objective, imperial, support",
-                    "type": "string"
+                "support": {
+                    "type": "integer"
                 }
             }
         },
-        "models.SeedOfEmpireResolution": {
+        "models.VictoryPathSummary": {
             "type": "object",
             "properties": {
-                "game_id": {
+                "frequency": {
                     "type": "integer"
                 },
-                "result": {
-                    "description": "\"for\" or \"against\"",
-                    "type": "string"
+                "path": {
+                    "$ref": "#/definitions/models.VictoryPath"
                 },
-                "round_id": {
+                "uniqueness_percent": {
                     "type": "integer"
                 }
             }
         },
-        "models.SpeakerAssignment": {
+        "services.PlayerCustodiansStats": {
             "type": "object",
             "properties": {
-                "game": {
-                    "$ref": "#/definitions/models.Game"
+                "custodians_taken": {
+                    "type": "integer"
                 },
-                "gameID": {
+                "custodians_win_percentage": {
                     "type": "integer"
                 },
-                "id": {
+                "custodians_wins": {
                     "type": "integer"
                 },
-                "player": {
-                    "$ref": "#/definitions/models.Player"
+                "games_played": {
+                    "type": "integer"
                 },
-                "playerID": {
+                "games_won": {
                     "type": "integer"
                 },
-                "round": {
-                    "$ref": "#/definitions/models.Round"
+                "player_id": {
+                    "type": "integer"
+                },
+                "player_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "services.StatsOverview": {
+            "type": "object",
+            "properties": {
+                "averageGameRounds": {
+                    "type": "number"
+                },
+                "averagePlayerPoints": {
+                    "type": "number"
+                },
+                "commonVictoryPaths": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "custodiansStats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PlayerCustodiansStats"
+                    }
+                },
+                "factionAggregateStats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FactionAggregateStats"
+                    }
+                },
+                "factionObjectiveStats": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "$ref": "#/definitions/models.ObjectiveStats"
+                        }
+                    }
+                },
+                "factionPlayWinDistribution": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.FactionPlayWinStat"
+                    }
+                },
+                "factionPlayerStats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FactionPlayerStats"
+                    }
+                },
+                "gameLengthDistribution": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "gameLengthStats": {
+                    "$ref": "#/definitions/models.GameLengthStats"
+                },
+                "gamesPlayedByFaction": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "gamesWonByFaction": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "generatedAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "mostPlayedFaction": {
+                    "type": "string"
+                },
+                "mostVictoriousFaction": {
+                    "type": "string"
+                },
+                "objectiveAppearanceStats": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.ObjectiveStats"
+                    }
+                },
+                "objectiveFrequency": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "objectiveMetaStats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ObjectiveMeta"
+                    }
+                },
+                "objectiveStats": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "playerAveragePoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerAveragePoints"
+                    }
+                },
+                "playerMostCommonFinishes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerMostCommonFinish"
+                    }
+                },
+                "playerPointStdevs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerPointStdev"
+                    }
+                },
+                "playerWinRates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerWinRate"
+                    }
+                },
+                "pointSpreadDistribution": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "publicObjectiveFrequency": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "publicSecretFrequency": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "secretObjectiveRates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SecretObjectiveRate"
+                    }
+                },
+                "topFactionsPerPlayer": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlayerFactionStats"
+                    }
+                },
+                "totalGames": {
+                    "type": "integer"
                 },
-                "roundID": {
+                "totalUniquePlayers": {
                     "type": "integer"
+                },
+                "winRateByFaction": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
                 }
             }
         }
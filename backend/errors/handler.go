@@ -16,7 +16,23 @@ func Handle(c *gin.Context, err error) {
 		return
 	}
 
-	if err == gorm.ErrRecordNotFound {
+	var invalid *ValidationError
+	if errors.As(err, &invalid) {
+		c.JSON(http.StatusUnprocessableEntity, invalid.Response())
+		return
+	}
+	var bad *badRequest
+	if errors.As(err, &bad) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": bad.Error()})
+		return
+	}
+	var rule *RuleError
+	if errors.As(err, &rule) {
+		c.JSON(http.StatusConflict, gin.H{"error": rule.Error()})
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
 		return
 	}
@@ -26,11 +42,13 @@ func Handle(c *gin.Context, err error) {
 	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 }
 
+// ParseID reads a positive integer path parameter. A missing or invalid
+// value is a ValidationError naming the parameter.
 func ParseID(c *gin.Context, param string) (uint, error) {
 	idStr := c.Param(param)
 	idInt, err := strconv.Atoi(idStr)
 	if err != nil || idInt <= 0 {
-		return 0, errors.New("invalid ID")
+		return 0, Invalid(param, "must be a positive integer")
 	}
 	return uint(idInt), nil
 }
@@ -0,0 +1,28 @@
+package handle
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RuleError is a well-formed request that breaks a game rule or does not
+// fit the state of the game, such as scoring outside the status phase or
+// pausing a game that is not running. Handle answers it with 409.
+type RuleError struct {
+	err error
+}
+
+func (e *RuleError) Error() string { return e.err.Error() }
+func (e *RuleError) Unwrap() error { return e.err }
+
+// Rule returns a RuleError with a message formatted as by fmt.Errorf, so a
+// sentinel wrapped with %w can still be matched with errors.Is.
+func Rule(format string, args ...any) *RuleError {
+	return &RuleError{err: fmt.Errorf(format, args...)}
+}
+
+// IsRule reports whether err is or wraps a *RuleError.
+func IsRule(err error) bool {
+	var r *RuleError
+	return errors.As(err, &r)
+}
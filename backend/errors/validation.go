@@ -0,0 +1,54 @@
+package handle
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// ValidationError is a request whose fields are not valid, either by the
+// binding tags on its struct or because they name something that is not
+// part of the game. Handle answers it with 422 and the fields.
+type ValidationError struct {
+	Fields []models.FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		parts[i] = f.Field + " " + f.Message
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Response is the 422 body for the error.
+func (e *ValidationError) Response() models.ValidationErrorResponse {
+	return models.ValidationErrorResponse{Error: "validation failed", Fields: e.Fields}
+}
+
+// Invalid returns a ValidationError for one field.
+func Invalid(field, format string, args ...any) *ValidationError {
+	return &ValidationError{Fields: []models.FieldError{{Field: field, Message: fmt.Sprintf(format, args...)}}}
+}
+
+// IsValidation reports whether err is or wraps a *ValidationError.
+func IsValidation(err error) bool {
+	var v *ValidationError
+	return errors.As(err, &v)
+}
+
+// badRequest is a body that could not be read at all, such as malformed
+// JSON. Handle answers it with 400.
+type badRequest struct {
+	err error
+}
+
+func (e *badRequest) Error() string { return e.err.Error() }
+func (e *badRequest) Unwrap() error { return e.err }
+
+// BadRequest marks err as a request the server could not read.
+func BadRequest(err error) error {
+	return &badRequest{err: err}
+}
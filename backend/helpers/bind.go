@@ -1,17 +1,133 @@
 package helpers
 
 import (
-	"net/http"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
 
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 )
 
-// BindJSON is a generic wrapper around ShouldBindJSON that handles errors
+func init() {
+	// Report fields by the names clients send, not the Go field names.
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			switch name {
+			case "-":
+				return ""
+			case "":
+				return f.Name
+			}
+			return name
+		})
+	}
+}
+
+// BindJSON decodes and validates the request body. On failure it writes
+// 400 for a body that is not JSON, or 422 listing the invalid fields.
 func BindJSON[T any](c *gin.Context) (*T, bool) {
 	var obj T
-	if err := c.ShouldBindJSON(&obj); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	err := DecodeJSON(c, &obj)
+	if err == nil {
+		err = Validate(&obj)
+	}
+	if err != nil {
+		handle.Handle(c, err)
 		return nil, false
 	}
 	return &obj, true
 }
+
+// DecodeJSON reads the request body into obj without validating it, so the
+// caller can fill in fields from the path first. An empty body decodes as
+// {}. Malformed JSON is a bad request; a value of the wrong type is a
+// ValidationError for its field.
+func DecodeJSON(c *gin.Context, obj any) error {
+	if c.Request.Body == nil {
+		return nil
+	}
+	err := json.NewDecoder(c.Request.Body).Decode(obj)
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil, errors.Is(err, io.EOF):
+		return nil
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		return handle.Invalid(typeErr.Field, "must be %s", jsonKind(typeErr.Type))
+	default:
+		return handle.BadRequest(fmt.Errorf("invalid JSON body: %w", err))
+	}
+}
+
+// Validate checks obj against the binding tags on its fields.
+func Validate(obj any) error {
+	err := binding.Validator.ValidateStruct(obj)
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+	invalid := &handle.ValidationError{}
+	for _, fe := range fieldErrs {
+		field := fe.Namespace()
+		if _, rest, ok := strings.Cut(field, "."); ok {
+			field = rest // drop the struct name
+		}
+		invalid.Fields = append(invalid.Fields, models.FieldError{Field: field, Message: fieldMessage(fe)})
+	}
+	return invalid
+}
+
+func fieldMessage(fe validator.FieldError) string {
+	list := fe.Kind() == reflect.Slice || fe.Kind() == reflect.Map
+	switch fe.Tag() {
+	case "required", "required_if", "required_unless", "required_with", "required_without":
+		return "is required"
+	case "oneof":
+		return "must be one of " + strings.Join(strings.Fields(fe.Param()), ", ")
+	case "min", "gte":
+		if list {
+			return fmt.Sprintf("must have at least %s entries", fe.Param())
+		}
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must be at least %s characters", fe.Param())
+		}
+		return "must be at least " + fe.Param()
+	case "max", "lte":
+		if list {
+			return fmt.Sprintf("must have at most %s entries", fe.Param())
+		}
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must be at most %s characters", fe.Param())
+		}
+		return "must be at most " + fe.Param()
+	case "excluded_unless", "excluded_if":
+		return "is not allowed here"
+	}
+	return fmt.Sprintf("is not valid (%s)", fe.Tag())
+}
+
+// jsonKind describes a Go type the way a JSON client would see it.
+func jsonKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "a whole number, 0 or more"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "a whole number"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Bool:
+		return "true or false"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	}
+	return "an object"
+}
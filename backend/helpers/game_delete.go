@@ -1,12 +1,13 @@
 package helpers
 
 import (
+	"context"
 	"github.com/arphillips06/TI4-stats/database"
 	"github.com/arphillips06/TI4-stats/models"
 )
 
-func DeleteGame(gameID uint) error {
-	tx := database.DB.Begin()
+func DeleteGame(ctx context.Context, gameID uint) error {
+	tx := database.DB.WithContext(ctx).Begin()
 
 	// Delete children in correct order
 	if err := tx.Where("game_id = ?", gameID).Delete(&models.Score{}).Error; err != nil {
@@ -33,6 +34,27 @@ func DeleteGame(gameID uint) error {
 		tx.Rollback()
 		return err
 	}
+	if err := tx.Where("game_id = ?", gameID).Delete(&models.Turn{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Where("game_id = ?", gameID).Delete(&models.RelicHolding{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Where("game_id = ?", gameID).Delete(&models.SupportHolding{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Where("session_id IN (?)", tx.Model(&models.GameSession{}).Select("id").Where("game_id = ?", gameID)).
+		Delete(&models.SessionRSVP{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Where("game_id = ?", gameID).Delete(&models.GameSession{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
 
 	// Finally delete the game
 	if err := tx.Delete(&models.Game{}, gameID).Error; err != nil {
@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strconv"
@@ -10,10 +11,6 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-const (
-	ScoreTypeAgenda = "agenda"
-)
-
 func GetCurrentRoundID(gameID uint) (uint, error) {
 	var game models.Game
 	if err := database.DB.Select("id, current_round").First(&game, gameID).Error; err != nil {
@@ -99,10 +96,11 @@ func GetUnfinishedGame(gameID uint) (*models.Game, error) {
 	return &game, nil
 }
 
-func CreateGamePlayer(gameID, playerID uint, faction string) error {
-	return database.DB.Create(&models.GamePlayer{
-		GameID:   gameID,
-		PlayerID: playerID,
-		Faction:  faction,
+func CreateGamePlayer(ctx context.Context, gameID, playerID uint, faction models.Faction) error {
+	return database.DB.WithContext(ctx).Create(&models.GamePlayer{
+		GameID:    gameID,
+		PlayerID:  playerID,
+		Faction:   faction.Name,
+		FactionID: &faction.ID,
 	}).Error
 }
@@ -0,0 +1,215 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+// Page is the slice of a list requested with ?page=&page_size=. Pages start
+// at 1. A Size of 0 means the whole list.
+type Page struct {
+	Number int
+	Size   int
+}
+
+// ParsePage reads the page and page_size query parameters. defaultSize is
+// used when only page is given, or always when it is non-zero.
+func ParsePage(c *gin.Context, defaultSize int) (Page, error) {
+	p := Page{Number: 1, Size: defaultSize}
+
+	if v := c.Query("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return p, fmt.Errorf("page must be a positive integer")
+		}
+		p.Number = n
+		if p.Size == 0 {
+			p.Size = DefaultPageSize
+		}
+	}
+	if v := c.Query("page_size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > MaxPageSize {
+			return p, fmt.Errorf("page_size must be between 1 and %d", MaxPageSize)
+		}
+		p.Size = n
+	}
+	return p, nil
+}
+
+func (p Page) Offset() int {
+	return (p.Number - 1) * p.Size
+}
+
+// Apply limits the query to the requested page.
+func (p Page) Apply(db *gorm.DB) *gorm.DB {
+	if p.Size == 0 {
+		return db
+	}
+	return db.Offset(p.Offset()).Limit(p.Size)
+}
+
+// SetPageHeaders reports the total number of rows and the page served.
+func SetPageHeaders(c *gin.Context, p Page, total int64) {
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.Header("X-Page", strconv.Itoa(p.Number))
+	if p.Size > 0 {
+		c.Header("X-Page-Size", strconv.Itoa(p.Size))
+	}
+}
+
+// ListOptions holds the paging, ordering and projection requested for a list
+// endpoint.
+type ListOptions struct {
+	Page   Page
+	Order  string   // validated ORDER BY clause, empty for the default order
+	Fields []string // JSON fields to return, empty for all
+}
+
+// ParseListOptions reads page, page_size, sort and fields from the query.
+//
+// sort is a comma separated list of keys from sortable, each optionally
+// prefixed with "-" for descending order, e.g. sort=-finished_at,game_number.
+// sortable maps the public key to its column. fields is a comma separated
+// list of JSON field names of item.
+func ParseListOptions(c *gin.Context, sortable map[string]string, item any) (ListOptions, error) {
+	var opts ListOptions
+
+	page, err := ParsePage(c, 0)
+	if err != nil {
+		return opts, err
+	}
+	opts.Page = page
+
+	if s := strings.TrimSpace(c.Query("sort")); s != "" {
+		var clauses []string
+		for _, key := range strings.Split(s, ",") {
+			key = strings.TrimSpace(key)
+			dir := "ASC"
+			if strings.HasPrefix(key, "-") {
+				dir = "DESC"
+				key = key[1:]
+			}
+			col, ok := sortable[key]
+			if !ok {
+				return opts, fmt.Errorf("cannot sort by %q", key)
+			}
+			clauses = append(clauses, col+" "+dir)
+		}
+		opts.Order = strings.Join(clauses, ", ")
+	}
+
+	if f := strings.TrimSpace(c.Query("fields")); f != "" {
+		known := jsonFieldNames(reflect.TypeOf(item))
+		for _, name := range strings.Split(f, ",") {
+			name = strings.TrimSpace(name)
+			if !known[name] {
+				return opts, fmt.Errorf("unknown field %q", name)
+			}
+			opts.Fields = append(opts.Fields, name)
+		}
+	}
+
+	return opts, nil
+}
+
+// Apply orders and pages the query. defaultOrder is used when no sort was
+// requested.
+func (o ListOptions) Apply(db *gorm.DB, defaultOrder string) *gorm.DB {
+	order := o.Order
+	if order == "" {
+		order = defaultOrder
+	}
+	if order != "" {
+		db = db.Order(order)
+	}
+	return o.Page.Apply(db)
+}
+
+// Wants reports whether field is part of the response. It is used to skip
+// preloads that the client did not ask for.
+func (o ListOptions) Wants(field string) bool {
+	if len(o.Fields) == 0 {
+		return true
+	}
+	for _, f := range o.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Project reduces each item to the requested fields. Items are returned
+// unchanged when no fields were requested.
+func (o ListOptions) Project(items any) (any, error) {
+	if len(o.Fields) == 0 {
+		return items, nil
+	}
+
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	var rows []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return nil, err
+	}
+
+	out := make([]map[string]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		projected := make(map[string]json.RawMessage, len(o.Fields))
+		for _, f := range o.Fields {
+			if v, ok := row[f]; ok {
+				projected[f] = v
+			}
+		}
+		out = append(out, projected)
+	}
+	return out, nil
+}
+
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	names := make(map[string]bool)
+	if t.Kind() != reflect.Struct {
+		return names
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		// Fields of embedded structs are promoted, as by encoding/json,
+		// even when the struct type itself is unexported.
+		if field.Anonymous && tag == "" {
+			for name := range jsonFieldNames(field.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+	return names
+}
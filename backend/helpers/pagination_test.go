@@ -0,0 +1,127 @@
+package helpers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func queryContext(query string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/?"+query, nil)
+	return c
+}
+
+func TestParsePage(t *testing.T) {
+	tests := []struct {
+		query       string
+		defaultSize int
+		want        Page
+		wantErr     bool
+	}{
+		{"", 0, Page{Number: 1, Size: 0}, false},
+		{"", 20, Page{Number: 1, Size: 20}, false},
+		{"page=2", 0, Page{Number: 2, Size: DefaultPageSize}, false},
+		{"page=2", 20, Page{Number: 2, Size: 20}, false},
+		{"page=3&page_size=10", 0, Page{Number: 3, Size: 10}, false},
+		{"page_size=1", 0, Page{Number: 1, Size: 1}, false},
+		{"page_size=200", 0, Page{Number: 1, Size: MaxPageSize}, false},
+		{"page=0", 0, Page{}, true},
+		{"page=-1", 0, Page{}, true},
+		{"page=x", 0, Page{}, true},
+		{"page_size=0", 0, Page{}, true},
+		{"page_size=201", 0, Page{}, true},
+		{"page_size=ten", 0, Page{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePage(queryContext(tt.query), tt.defaultSize)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParsePage(%q) = %+v, want an error", tt.query, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParsePage(%q, %d) = %+v, %v; want %+v", tt.query, tt.defaultSize, got, err, tt.want)
+		}
+	}
+
+	if off := (Page{Number: 3, Size: 10}).Offset(); off != 20 {
+		t.Errorf("Offset of page 3 of 10 = %d, want 20", off)
+	}
+}
+
+type listItem struct {
+	listBase
+	Title    string `json:"title"`
+	Notes    string `json:"notes,omitempty"`
+	Secret   string `json:"-"`
+	Untagged int
+}
+
+type listBase struct {
+	ID uint `json:"id"`
+}
+
+func TestParseListOptions(t *testing.T) {
+	sortable := map[string]string{"game_number": "games.game_number", "finished_at": "games.finished_at"}
+
+	opts, err := ParseListOptions(queryContext("sort=-finished_at,game_number&fields=id,title,Untagged&page=2"), sortable, listItem{})
+	if err != nil {
+		t.Fatalf("ParseListOptions: %v", err)
+	}
+	if want := "games.finished_at DESC, games.game_number ASC"; opts.Order != want {
+		t.Errorf("Order = %q, want %q", opts.Order, want)
+	}
+	if want := []string{"id", "title", "Untagged"}; !reflect.DeepEqual(opts.Fields, want) {
+		t.Errorf("Fields = %v, want %v", opts.Fields, want)
+	}
+	if opts.Page != (Page{Number: 2, Size: DefaultPageSize}) {
+		t.Errorf("Page = %+v, want page 2 of %d", opts.Page, DefaultPageSize)
+	}
+	if !opts.Wants("title") || opts.Wants("notes") {
+		t.Error("Wants should report only the requested fields")
+	}
+
+	for _, query := range []string{
+		"sort=winner",
+		"sort=-",
+		"fields=id,nope",
+		"fields=Secret",
+		"fields=Title", // the JSON name is title
+		"page=0",
+	} {
+		if _, err := ParseListOptions(queryContext(query), sortable, &listItem{}); err == nil {
+			t.Errorf("ParseListOptions(%q): want an error", query)
+		}
+	}
+}
+
+func TestProject(t *testing.T) {
+	items := []listItem{{listBase: listBase{ID: 1}, Title: "First", Notes: "long"}, {listBase: listBase{ID: 2}, Title: "Second"}}
+
+	all, err := ListOptions{}.Project(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(all, items) {
+		t.Errorf("Project without fields changed the items: %v", all)
+	}
+
+	projected, err := ListOptions{Fields: []string{"id", "notes"}}.Project(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := json.Marshal(projected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// notes is omitted from the second item, so it is not projected either.
+	if want := `[{"id":1,"notes":"long"},{"id":2}]`; string(raw) != want {
+		t.Errorf("Project = %s, want %s", raw, want)
+	}
+}
@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"context"
 	"log"
 
 	"github.com/arphillips06/TI4-stats/database"
@@ -26,18 +27,7 @@ func AggregatePlayerScores(scores []models.Score) []models.PlayerScoreSummary {
 	return summaries
 }
 
-func CreateRelicScore(gameID, playerID uint, points int, relicTitle string) error {
-	score := models.Score{
-		GameID:     gameID,
-		PlayerID:   playerID,
-		Points:     points,
-		Type:       "relic",
-		RelicTitle: relicTitle,
-	}
-	return CreateGenericScore(score)
-}
-
-func CreateBasicScore(gameID, roundID, playerID uint, points int, scoreType string) error {
+func CreateBasicScore(ctx context.Context, gameID, roundID, playerID uint, points int, scoreType models.ScoreSource) error {
 	score := models.Score{
 		GameID:   gameID,
 		RoundID:  roundID,
@@ -45,7 +35,7 @@ func CreateBasicScore(gameID, roundID, playerID uint, points int, scoreType stri
 		Points:   points,
 		Type:     scoreType,
 	}
-	return CreateGenericScore(score)
+	return CreateGenericScore(ctx, score)
 }
 
 func GetPlayerTotalPoints(gameID, playerID uint) (int, error) {
@@ -56,23 +46,23 @@ func GetPlayerTotalPoints(gameID, playerID uint) (int, error) {
 	return total, err
 }
 
-func CreateGenericScore(score models.Score) error {
+func CreateGenericScore(ctx context.Context, score models.Score) error {
 	log.Printf("Creating score: Game %d, Player %d, Type %s, Points %d", score.GameID, score.PlayerID, score.Type, score.Points)
 
-	return database.DB.Create(&score).Error
+	return database.DB.WithContext(ctx).Create(&score).Error
 }
 
-func CreateAgendaScore(gameID, roundID, playerID, points int, agendaTitle string, objectiveID uint) error {
+func CreateAgendaScore(ctx context.Context, gameID, roundID, playerID, points int, agendaTitle string, objectiveID uint) error {
 	score := models.Score{
 		GameID:      uint(gameID),
 		RoundID:     uint(roundID),
 		PlayerID:    uint(playerID),
 		Points:      points,
-		Type:        "agenda",
+		Type:        models.ScoreTypeAgenda,
 		AgendaTitle: agendaTitle,
 		ObjectiveID: objectiveID,
 	}
-	return CreateGenericScore(score)
+	return CreateGenericScore(ctx, score)
 }
 
 func GetPlayerScoresMap(gameID uint) (map[uint]int, error) {
@@ -100,25 +90,3 @@ func GetPlayerScoresMap(gameID uint) (map[uint]int, error) {
 
 	return playerTotals, nil
 }
-
-func CreateObjectiveScore(gameID, roundID, playerID, objectiveID uint, points int) error {
-	var obj models.Objective
-	if err := database.DB.First(&obj, objectiveID).Error; err != nil {
-		return err
-	}
-
-	scoreType := "public"
-	if obj.Stage == "Secret" {
-		scoreType = "secret"
-	}
-
-	score := models.Score{
-		GameID:      gameID,
-		RoundID:     roundID,
-		PlayerID:    playerID,
-		ObjectiveID: objectiveID,
-		Points:      points,
-		Type:        scoreType,
-	}
-	return database.DB.Create(&score).Error
-}
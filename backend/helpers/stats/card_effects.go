@@ -0,0 +1,23 @@
+package stats
+
+import (
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// CalculateCardEffectStats totals action card and ability scores by card.
+func CalculateCardEffectStats() ([]models.CardEffectStats, error) {
+	out := []models.CardEffectStats{}
+	err := database.DB.
+		Model(&models.Score{}).
+		Select(`
+		card_title AS card,
+		COUNT(*) AS times_played,
+		SUM(points) AS net_points,
+		COUNT(DISTINCT game_id) AS games`).
+		Where("type = ? AND card_title <> ''", models.ScoreTypeActionCard).
+		Group("card_title").
+		Order("times_played DESC, card_title").
+		Scan(&out).Error
+	return out, err
+}
@@ -0,0 +1,114 @@
+package stats
+
+import (
+	"sort"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// CalculateClockStats summarises round timestamps and turn timers. Only
+// finished rounds and turns count; games recorded before rounds were timed
+// are left out.
+func CalculateClockStats() (models.ClockStats, error) {
+	out := models.ClockStats{
+		RoundLengths:   []models.RoundLengthStat{},
+		SlowestPlayers: []models.PlayerTurnStat{},
+		Phases:         []models.PhaseTimeStat{},
+	}
+
+	var rounds []models.Round
+	if err := database.DB.
+		Where("started_at IS NOT NULL AND ended_at IS NOT NULL").
+		Find(&rounds).Error; err != nil {
+		return out, err
+	}
+	roundTotals := map[int]*models.RoundLengthStat{}
+	for _, r := range rounds {
+		s := roundTotals[r.Number]
+		if s == nil {
+			s = &models.RoundLengthStat{Round: r.Number}
+			roundTotals[r.Number] = s
+		}
+		s.Games++
+		s.AverageSeconds += int64(r.EndedAt.Sub(*r.StartedAt).Seconds())
+	}
+	for _, s := range roundTotals {
+		s.AverageSeconds /= int64(s.Games)
+		s.Average = FormatDuration(time.Duration(s.AverageSeconds) * time.Second)
+		out.RoundLengths = append(out.RoundLengths, *s)
+	}
+	sort.Slice(out.RoundLengths, func(i, j int) bool {
+		return out.RoundLengths[i].Round < out.RoundLengths[j].Round
+	})
+
+	var turns []struct {
+		RoundID   uint
+		Phase     string
+		Name      string
+		Active    bool
+		StartedAt time.Time
+		EndedAt   time.Time
+	}
+	if err := database.DB.
+		Table("turns AS t").
+		Select("t.round_id, t.phase, p.name, p.active, t.started_at, t.ended_at").
+		Joins("JOIN players p ON p.id = t.player_id").
+		Where("t.ended_at IS NOT NULL").
+		Scan(&turns).Error; err != nil {
+		return out, err
+	}
+
+	players := map[string]*models.PlayerTurnStat{}
+	phases := map[string]*models.PhaseTimeStat{}
+	phaseRounds := map[string]map[uint]bool{}
+	for _, t := range turns {
+		seconds := int64(t.EndedAt.Sub(t.StartedAt).Seconds())
+
+		if t.Active {
+			p := players[t.Name]
+			if p == nil {
+				p = &models.PlayerTurnStat{Player: t.Name}
+				players[t.Name] = p
+			}
+			p.Turns++
+			p.TotalSeconds += seconds
+		}
+
+		ph := phases[t.Phase]
+		if ph == nil {
+			ph = &models.PhaseTimeStat{Phase: t.Phase}
+			phases[t.Phase] = ph
+			phaseRounds[t.Phase] = map[uint]bool{}
+		}
+		ph.Turns++
+		ph.TotalSeconds += seconds
+		phaseRounds[t.Phase][t.RoundID] = true
+	}
+
+	for _, p := range players {
+		p.AverageTurnSeconds = p.TotalSeconds / int64(p.Turns)
+		p.AverageTurn = FormatDuration(time.Duration(p.AverageTurnSeconds) * time.Second)
+		out.SlowestPlayers = append(out.SlowestPlayers, *p)
+	}
+	sort.Slice(out.SlowestPlayers, func(i, j int) bool {
+		a, b := out.SlowestPlayers[i], out.SlowestPlayers[j]
+		if a.AverageTurnSeconds != b.AverageTurnSeconds {
+			return a.AverageTurnSeconds > b.AverageTurnSeconds
+		}
+		return a.Player < b.Player
+	})
+
+	for _, phase := range models.Phases {
+		ph := phases[phase]
+		if ph == nil {
+			continue
+		}
+		ph.AverageTurnSeconds = ph.TotalSeconds / int64(ph.Turns)
+		ph.AveragePerRoundSeconds = ph.TotalSeconds / int64(len(phaseRounds[phase]))
+		ph.AveragePerRound = FormatDuration(time.Duration(ph.AveragePerRoundSeconds) * time.Second)
+		out.Phases = append(out.Phases, *ph)
+	}
+	return out, nil
+}
@@ -24,6 +24,7 @@ func CalculateTopFactionsPerPlayer() ([]models.PlayerFactionStats, error) {
 		Table("game_players AS gp").
 		Select("p.name, gp.faction, COUNT(*) as count").
 		Joins("JOIN players p ON p.id = gp.player_id").
+		Where("p.active = ?", true).
 		Group("p.name, gp.faction").
 		Scan(&rows).Error
 	if err != nil {
@@ -302,7 +303,7 @@ func CalculateFactionObjectiveStats() (map[string]map[string]models.ObjectiveSta
 		for _, round := range game.Rounds {
 			for _, score := range round.Scores {
 				name := score.Objective.Name
-				typ := score.Type
+				typ := string(score.Type)
 				faction := playerFaction[score.PlayerID]
 				if faction == "" {
 					continue
@@ -14,7 +14,9 @@ func CountTotalGames() (int64, error) {
 	err := database.DB.Model(&models.Game{}).Count(&count).Error
 	return count, err
 }
-func formatDuration(d time.Duration) string {
+
+// FormatDuration writes a duration as hours and minutes, e.g. "3h 05m".
+func FormatDuration(d time.Duration) string {
 	h := int(d.Hours())
 	m := int(d.Minutes()) % 60
 	if h > 0 {
@@ -22,20 +24,55 @@ func formatDuration(d time.Duration) string {
 	}
 	return fmt.Sprintf("%dm", m)
 }
-func computeStats(games []models.Game) models.GameLengthCategoryStats {
+
+// playedTime is the time a game spent in its sessions and when the first
+// one started.
+type playedTime struct {
+	total time.Duration
+	start time.Time
+}
+
+// sessionTimes sums the finished sessions of every game. Games played over
+// several evenings are only timed while a session runs.
+func sessionTimes() (map[uint]playedTime, error) {
+	var sessions []models.GameSession
+	if err := database.DB.
+		Where("started_at IS NOT NULL AND ended_at IS NOT NULL").
+		Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	out := map[uint]playedTime{}
+	for _, s := range sessions {
+		p, ok := out[s.GameID]
+		if !ok || s.StartedAt.Before(p.start) {
+			p.start = *s.StartedAt
+		}
+		p.total += s.EndedAt.Sub(*s.StartedAt)
+		out[s.GameID] = p
+	}
+	return out, nil
+}
+
+// computeStats times games by their sessions, or from creation to finish
+// for games recorded without any.
+func computeStats(games []models.Game, sessions map[uint]playedTime) models.GameLengthCategoryStats {
 	var durations []models.GameDurationStat
 	var totalRoundSeconds int64
 	var totalRounds int
 
 	for _, game := range games {
 		duration := game.FinishedAt.Sub(game.CreatedAt)
+		startedAt := game.CreatedAt
+		if played, ok := sessions[game.ID]; ok {
+			duration, startedAt = played.total, played.start
+		}
 
 		stat := models.GameDurationStat{
 			GameID:     game.ID,
 			GameNumber: game.GameNumber,
-			Duration:   formatDuration(duration),
+			Duration:   FormatDuration(duration),
 			Seconds:    int64(duration.Seconds()),
-			StartedAt:  game.CreatedAt,
+			StartedAt:  startedAt,
 		}
 
 		if !game.Partial {
@@ -60,7 +97,7 @@ func computeStats(games []models.Game) models.GameLengthCategoryStats {
 		totalGameSeconds += d.Seconds
 	}
 
-	avgGame := formatDuration(time.Duration(totalGameSeconds/int64(len(durations))) * time.Second)
+	avgGame := FormatDuration(time.Duration(totalGameSeconds/int64(len(durations))) * time.Second)
 
 	var sortByRounds, sortByTime []models.GameDurationStat
 	for _, d := range durations {
@@ -86,7 +123,7 @@ func computeStats(games []models.Game) models.GameLengthCategoryStats {
 	averageRound := ""
 	if totalRounds > 0 {
 		avgRound := totalRoundSeconds / int64(totalRounds)
-		averageRound = formatDuration(time.Duration(avgRound) * time.Second)
+		averageRound = FormatDuration(time.Duration(avgRound) * time.Second)
 	}
 
 	return models.GameLengthCategoryStats{
@@ -107,6 +144,10 @@ func GetGameLengthStats() (models.GameLengthStats, error) {
 	if err != nil {
 		return models.GameLengthStats{}, err
 	}
+	sessions, err := sessionTimes()
+	if err != nil {
+		return models.GameLengthStats{}, err
+	}
 
 	var (
 		allGames         []models.Game
@@ -130,9 +171,9 @@ func GetGameLengthStats() (models.GameLengthStats, error) {
 	}
 
 	return models.GameLengthStats{
-		All:         computeStats(allGames),
-		ThreePlayer: computeStats(threePlayerGames),
-		FourPlayer:  computeStats(fourPlayerGames),
+		All:         computeStats(allGames, sessions),
+		ThreePlayer: computeStats(threePlayerGames, sessions),
+		FourPlayer:  computeStats(fourPlayerGames, sessions),
 	}, nil
 }
 
@@ -12,33 +12,38 @@ func CalculateObjectiveCounts() (map[string]int, error) {
 
 	var secretCount, stage1Count, stage2Count, cdlCount int64
 
+	// Distinct (game, objective) pairs are counted through a subquery, as
+	// COUNT(DISTINCT a, b) is not available in SQLite.
 	err := database.DB.
-		Table("scores").
-		Select("COUNT(DISTINCT game_id || '-' || objective_id)").
-		Where("type = ?", "secret").
-		Scan(&secretCount).Error
+		Table("(?) AS scored", database.DB.
+			Table("scores").
+			Distinct("game_id", "objective_id").
+			Where("type = ?", models.ScoreTypeSecret)).
+		Count(&secretCount).Error
 	if err != nil {
 		return nil, err
 	}
 	result["secretScored"] = int(secretCount)
 
 	err = database.DB.
-		Table("scores").
-		Joins("JOIN objectives ON scores.objective_id = objectives.id").
-		Select("COUNT(DISTINCT scores.game_id || '-' || scores.objective_id)").
-		Where("objectives.stage = ?", "I").
-		Scan(&stage1Count).Error
+		Table("(?) AS scored", database.DB.
+			Table("scores").
+			Joins("JOIN objectives ON scores.objective_id = objectives.id").
+			Distinct("scores.game_id", "scores.objective_id").
+			Where("objectives.stage = ?", "I")).
+		Count(&stage1Count).Error
 	if err != nil {
 		return nil, err
 	}
 	result["stage1Scored"] = int(stage1Count)
 
 	err = database.DB.
-		Table("scores").
-		Joins("JOIN objectives ON scores.objective_id = objectives.id").
-		Select("COUNT(DISTINCT scores.game_id || '-' || scores.objective_id)").
-		Where("objectives.stage = ?", "II").
-		Scan(&stage2Count).Error
+		Table("(?) AS scored", database.DB.
+			Table("scores").
+			Joins("JOIN objectives ON scores.objective_id = objectives.id").
+			Distinct("scores.game_id", "scores.objective_id").
+			Where("objectives.stage = ?", "II")).
+		Count(&stage2Count).Error
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +77,7 @@ func CalculateObjectiveFrequencies() (map[string]int, map[string]int, error) {
 		Joins("JOIN objectives ON scores.objective_id = objectives.id").
 		Joins("JOIN games ON games.id = scores.game_id").
 		Select("objectives.name, COUNT(DISTINCT scores.game_id) as count").
-		Where("objectives.stage IN ('I', 'II') AND games.partial = false").
+		Where("objectives.stage IN ('I', 'II') AND games.partial = ?", false).
 		Group("objectives.name").
 		Scan(&publicRows).Error
 	if err != nil {
@@ -87,7 +92,7 @@ func CalculateObjectiveFrequencies() (map[string]int, map[string]int, error) {
 		Joins("JOIN objectives ON scores.objective_id = objectives.id").
 		Joins("JOIN games ON games.id = scores.game_id").
 		Select("objectives.name, COUNT(DISTINCT scores.game_id) as count").
-		Where("objectives.stage = 'S' AND games.partial = false").
+		Where("objectives.stage = 'S' AND games.partial = ?", false).
 		Group("objectives.name").
 		Scan(&secretRows).Error
 	if err != nil {
@@ -120,7 +125,7 @@ func CalculateObjectiveAppearanceStats(totalGames int64) (map[string]models.Obje
 		Select("objectives.name, objectives.type, COUNT(DISTINCT game_objectives.game_id) as game_count").
 		Joins("JOIN objectives ON game_objectives.objective_id = objectives.id").
 		Joins("JOIN games ON game_objectives.game_id = games.id").
-		Where("games.partial = false AND game_objectives.revealed = true AND objectives.type != ?", "secret").
+		Where("games.partial = ? AND game_objectives.revealed = ? AND objectives.type != ?", false, true, "secret").
 		Group("objectives.name, objectives.type").
 		Scan(&appearances).Error
 	if err != nil {
@@ -133,7 +138,7 @@ func CalculateObjectiveAppearanceStats(totalGames int64) (map[string]models.Obje
 		Select("objectives.name, objectives.type, COUNT(DISTINCT scores.game_id) as game_count").
 		Joins("JOIN objectives ON scores.objective_id = objectives.id").
 		Joins("JOIN games ON scores.game_id = games.id").
-		Where("games.partial = false AND objectives.type != ?", "secret").
+		Where("games.partial = ? AND objectives.type != ?", false, "secret").
 		Group("objectives.name, objectives.type").
 		Scan(&scored).Error
 	if err != nil {
@@ -205,7 +210,7 @@ func CalculateSecretObjectiveRates() ([]models.SecretObjectiveRate, error) {
 	subGamesPlayed := database.DB.
 		Table("game_players").
 		Joins("JOIN games ON games.id = game_players.game_id").
-		Where("games.partial = false").
+		Where("games.partial = ?", false).
 		Select("player_id, COUNT(DISTINCT game_id) AS games_played").
 		Group("player_id")
 
@@ -213,7 +218,7 @@ func CalculateSecretObjectiveRates() ([]models.SecretObjectiveRate, error) {
 	subSecrets := database.DB.
 		Table("scores").
 		Joins("JOIN games ON games.id = scores.game_id").
-		Where("games.partial = false AND type = ?", "secret").
+		Where("games.partial = ? AND type = ?", false, models.ScoreTypeSecret).
 		Select("player_id, COUNT(DISTINCT scores.id) AS secret_scored").
 		Group("player_id")
 
@@ -223,6 +228,7 @@ func CalculateSecretObjectiveRates() ([]models.SecretObjectiveRate, error) {
 		Select("p.name, COALESCE(gp.games_played, 0) AS games_played, COALESCE(ss.secret_scored, 0) AS secret_scored").
 		Joins("LEFT JOIN (?) AS gp ON p.id = gp.player_id", subGamesPlayed).
 		Joins("LEFT JOIN (?) AS ss ON p.id = ss.player_id", subSecrets).
+		Where("p.active = ?", true).
 		Scan(&rows).Error
 	if err != nil {
 		return nil, err
@@ -302,7 +308,7 @@ func CalculateObjectiveMetaStats() ([]models.ObjectiveMeta, error) {
 		Joins("JOIN objectives ON game_objectives.objective_id = objectives.id").
 		Joins("JOIN games ON game_objectives.game_id = games.id").
 		Where("games.partial = ? AND game_objectives.revealed = ?", false, true).
-		Group("objectives.name").
+		Group("objectives.name, objectives.type").
 		Scan(&appearances).Error
 	if err != nil {
 		return nil, err
@@ -22,6 +22,7 @@ func CalculatePlayerWinRates() ([]models.PlayerWinRate, error) {
 		COUNT(DISTINCT CASE WHEN g.winner_id = gp.player_id THEN gp.game_id END) AS games_won`).
 		Joins("JOIN players p ON p.id = gp.player_id").
 		Joins("JOIN games g ON g.id = gp.game_id").
+		Where("p.active = ?", true).
 		Group("p.name").
 		Scan(&rows).Error
 
@@ -61,6 +62,7 @@ func CalculatePlayerAverages() ([]models.PlayerAveragePoints, error) {
 		COALESCE(SUM(s.points), 0) AS total_points`).
 		Joins("JOIN players p ON p.id = gp.player_id").
 		Joins("LEFT JOIN scores s ON s.player_id = gp.player_id AND s.game_id = gp.game_id").
+		Where("p.active = ?", true).
 		Group("p.name").
 		Scan(&rows).Error
 	if err != nil {
@@ -109,7 +111,7 @@ func CalculateAveragePlayerPoints() (float64, error) {
 
 	err := database.DB.
 		Table("(?) as sub", subQuery).
-		Select("AVG(total)").
+		Select("COALESCE(AVG(total), 0)").
 		Scan(&avg).Error
 
 	return avg, err
@@ -129,12 +131,7 @@ func CalculateMostCommonFinishes() ([]models.PlayerMostCommonFinish, error) {
 				gp.game_id,
 				p.name AS player,
 				gp.player_id,
-				SUM(
-					CASE 
-						WHEN s.type IN ('public','secret','agenda','mecatol','support','imperial')
-						THEN s.points ELSE 0
-					END
-				) AS score
+				COALESCE(SUM(s.points), 0) AS score
 			FROM game_players gp
 			JOIN players p ON gp.player_id = p.id
 			LEFT JOIN scores s 
@@ -145,6 +142,7 @@ func CalculateMostCommonFinishes() ([]models.PlayerMostCommonFinish, error) {
 		ranked_with_position AS (
 			SELECT
 				player,
+				player_id,
 				game_id,
 				DENSE_RANK() OVER (PARTITION BY game_id ORDER BY score DESC) AS position
 			FROM ranked_players
@@ -155,9 +153,10 @@ func CalculateMostCommonFinishes() ([]models.PlayerMostCommonFinish, error) {
 			COUNT(*) as count,
 			(SELECT COUNT(*) FROM ranked_with_position WHERE player = r.player) as total_games
 		FROM ranked_with_position r
+		WHERE r.player_id IN (SELECT id FROM players WHERE active = ?)
 		GROUP BY player, position
 		ORDER BY player, count DESC
-	`).Scan(&positionData).Error
+	`, true).Scan(&positionData).Error
 	if err != nil {
 		return nil, err
 	}
@@ -190,6 +189,7 @@ func CalculatePointStandardDeviations() ([]models.PlayerPointStdev, error) {
 		Select("p.name, gp.game_id AS game, COALESCE(SUM(s.points), 0) AS total").
 		Joins("JOIN players p ON p.id = gp.player_id").
 		Joins("LEFT JOIN scores s ON s.player_id = gp.player_id AND s.game_id = gp.game_id").
+		Where("p.active = ?", true).
 		Group("p.name, gp.game_id").
 		Scan(&rows).Error
 	if err != nil {
@@ -1,9 +1,7 @@
 package stats
 
 import (
-	"fmt"
 	"sort"
-	"strings"
 
 	"github.com/arphillips06/TI4-stats/database"
 	"github.com/arphillips06/TI4-stats/models"
@@ -42,35 +40,33 @@ func CalculateVictoryPointSpreads() (map[int]int, error) {
 
 	return spreads, nil
 }
+
+// CalculateCommonVictoryPaths counts how often each victory path was used by a
+// game's winner. All winners' scores are loaded in a single query.
 func CalculateCommonVictoryPaths() (map[string]int, error) {
-	var games []models.Game
-	err := database.DB.Find(&games).Error
+	var scores []models.Score
+	err := database.DB.
+		Preload("Objective").
+		Joins("JOIN game_players gp ON gp.game_id = scores.game_id AND gp.player_id = scores.player_id").
+		Where("gp.won = ?", true).
+		Find(&scores).Error
 	if err != nil {
 		return nil, err
 	}
 
-	pathCounts := make(map[string]int)
-
-	for _, game := range games {
-
-		var winner models.GamePlayer
-		err := database.DB.
-			Where("game_id = ? AND won = ?", game.ID, true).
-			First(&winner).Error
-		if err != nil {
-			continue // skip if no winner or error
-		}
-
-		path, err := CalculateVictoryPath(game.ID, winner.PlayerID)
-		if err != nil {
-			continue
+	paths := make(map[uint]*models.VictoryPath)
+	for _, score := range scores {
+		vp, ok := paths[score.GameID]
+		if !ok {
+			vp = &models.VictoryPath{}
+			paths[score.GameID] = vp
 		}
+		addToVictoryPath(vp, score)
+	}
 
-		key := FormatVictoryPathKey(path)
-
-		pathCounts[key] += 1
-		fmt.Println("Generated Key:", key)
-
+	pathCounts := make(map[string]int)
+	for _, vp := range paths {
+		pathCounts[FormatVictoryPathKey(*vp)]++
 	}
 
 	return pathCounts, nil
@@ -88,33 +84,38 @@ func CalculateVictoryPath(gameID uint, playerID uint) (models.VictoryPath, error
 
 	vp := models.VictoryPath{}
 	for _, score := range scores {
-		switch strings.ToLower(score.Type) {
-		case "public":
-			if score.OriginallySecret {
-				vp.SecretPoints += score.Points
-			} else if score.Objective.ID != 0 && score.Objective.Stage != "" {
-				switch score.Objective.Stage {
-				case "I":
-					vp.Stage1Points += score.Points
-				case "II":
-					vp.Stage2Scored += 1
-				}
-			}
-		case "secret":
+		addToVictoryPath(&vp, score)
+	}
+	return vp, nil
+}
+
+func addToVictoryPath(vp *models.VictoryPath, score models.Score) {
+	source, _ := models.ParseScoreSource(string(score.Type))
+	switch source {
+	case models.ScoreTypePublic:
+		if score.OriginallySecret {
 			vp.SecretPoints += score.Points
-		case "custodians", "mecatol":
-			vp.Custodians += score.Points
-		case "imperial":
-			vp.Imperial += score.Points
-		case "relic":
-			vp.Relics += score.Points
-		case "agenda":
-			vp.Agenda += score.Points
-		case "imperial_rider":
-			vp.ActionCard += score.Points
-		case "support":
-			vp.Support += score.Points
+		} else if score.Objective.ID != 0 && score.Objective.Stage != "" {
+			switch score.Objective.Stage {
+			case "I":
+				vp.Stage1Points += score.Points
+			case "II":
+				vp.Stage2Scored += 1
+			}
 		}
+	case models.ScoreTypeSecret:
+		vp.SecretPoints += score.Points
+	case models.ScoreTypeMecatol:
+		vp.Custodians += score.Points
+	case models.ScoreTypeImperial:
+		vp.Imperial += score.Points
+	case models.ScoreTypeRelic:
+		vp.Relics += score.Points
+	case models.ScoreTypeAgenda:
+		vp.Agenda += score.Points
+	case models.ScoreTypeActionCard:
+		vp.ActionCard += score.Points
+	case models.ScoreTypeSupport:
+		vp.Support += score.Points
 	}
-	return vp, nil
 }
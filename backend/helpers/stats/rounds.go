@@ -18,7 +18,7 @@ func CalculateAverageRounds() (float64, error) {
 		Table("(?) as game_rounds", subQuery).
 		Select("AVG(round_count)").
 		Joins("JOIN games ON games.id = game_rounds.game_id").
-		Where("games.partial = false").
+		Where("games.partial = ?", false).
 		Scan(&avg).Error
 
 	if err != nil {
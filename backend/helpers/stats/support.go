@@ -0,0 +1,60 @@
+package stats
+
+import (
+	"sort"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// CalculateSupportStats counts who gives Support for the Throne to whom,
+// how often it was returned and how often it was still held when the game
+// finished.
+func CalculateSupportStats() (models.SupportStats, error) {
+	var pairs []models.SupportPairStats
+	err := database.DB.
+		Table("support_holdings AS sh").
+		Select(`
+		o.name AS owner,
+		h.name AS holder,
+		COUNT(*) AS times_given,
+		SUM(CASE WHEN sh.end_reason = ? THEN 1 ELSE 0 END) AS returned,
+		SUM(CASE WHEN sh.ended_at IS NULL AND g.finished_at IS NOT NULL THEN 1 ELSE 0 END) AS held_at_end`,
+			models.SupportReturned).
+		Joins("JOIN players o ON o.id = sh.owner_id").
+		Joins("JOIN players h ON h.id = sh.holder_id").
+		Joins("JOIN games g ON g.id = sh.game_id").
+		Group("o.name, h.name").
+		Order("times_given DESC, o.name, h.name").
+		Scan(&pairs).Error
+	if err != nil {
+		return models.SupportStats{}, err
+	}
+
+	byPlayer := make(map[string]*models.PlayerSupportStats)
+	get := func(name string) *models.PlayerSupportStats {
+		if p, ok := byPlayer[name]; ok {
+			return p
+		}
+		p := &models.PlayerSupportStats{Player: name}
+		byPlayer[name] = p
+		return p
+	}
+	for _, p := range pairs {
+		get(p.Owner).Given += p.TimesGiven
+		holder := get(p.Holder)
+		holder.Received += p.TimesGiven
+		holder.HeldAtGameEnd += p.HeldAtEnd
+	}
+
+	players := make([]models.PlayerSupportStats, 0, len(byPlayer))
+	for _, p := range byPlayer {
+		players = append(players, *p)
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i].Player < players[j].Player })
+
+	if pairs == nil {
+		pairs = []models.SupportPairStats{}
+	}
+	return models.SupportStats{Pairs: pairs, Players: players}, nil
+}
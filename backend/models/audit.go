@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// AuditLog records a change made to the data. Corrections store the row
+// before and after as JSON along with the reason given. Every other write
+// request is logged with Action "request", its route and payload, the rows
+// it changed per table and who sent it.
+type AuditLog struct {
+	ID          uint             `gorm:"primaryKey" json:"id"`
+	GameID      *uint            `gorm:"index" json:"game_id,omitempty" extensions:"x-nullable"`
+	Action      string           `gorm:"size:64" json:"action"`
+	Entity      string           `gorm:"size:32" json:"entity,omitempty"`
+	EntityID    uint             `json:"entity_id,omitempty"`
+	Before      string           `json:"before,omitempty"`
+	After       string           `json:"after,omitempty"`
+	Reason      string           `json:"reason,omitempty"`
+	Method      string           `gorm:"size:8" json:"method,omitempty"`
+	Route       string           `gorm:"size:128" json:"route,omitempty"`
+	Path        string           `gorm:"size:255" json:"path,omitempty"`
+	Payload     string           `json:"payload,omitempty"`
+	Status      int              `json:"status,omitempty"`
+	RowsChanged map[string]int64 `gorm:"serializer:json" json:"rows_changed,omitempty"`
+	ClientID    string           `gorm:"size:64" json:"client_id,omitempty"`
+	ClientIP    string           `gorm:"size:64" json:"client_ip,omitempty"`
+	UserAgent   string           `gorm:"size:255" json:"user_agent,omitempty"`
+	CreatedAt   time.Time        `gorm:"index" json:"created_at" format:"date-time"`
+}
+
+// AuditActionRequest marks entries written for a write request rather than
+// by a correction.
+const AuditActionRequest = "request"
+
+// CorrectGamePlayerRequest is the body of POST /admin/games/:id/players/:player_id.
+type CorrectGamePlayerRequest struct {
+	Faction string `json:"faction" binding:"required"`
+	Reason  string `json:"reason" binding:"required"`
+}
+
+// CorrectScoreRequest is the body of POST /admin/scores/:id. Omitted fields
+// are left as they are.
+type CorrectScoreRequest struct {
+	PlayerID *uint  `json:"player_id" extensions:"x-nullable"`
+	Round    *int   `json:"round" extensions:"x-nullable"` // round number within the game
+	Points   *int   `json:"points" extensions:"x-nullable"`
+	Reason   string `json:"reason" binding:"required"`
+}
+
+// RecomputeResultRequest is the body of POST /admin/games/:id/recompute.
+type RecomputeResultRequest struct {
+	WinnerID *uint  `json:"winner_id" extensions:"x-nullable"` // overrides the winner worked out from scores
+	Reason   string `json:"reason" binding:"required"`
+}
+
+type ReasonRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Backup is a copy of the SQLite database written by the backup command,
+// POST /admin/backup or the backup schedule.
+type Backup struct {
+	File      string    `json:"file"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+	Removed   []string  `json:"removed,omitempty"` // older backups deleted by rotation
+}
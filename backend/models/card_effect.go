@@ -0,0 +1,39 @@
+package models
+
+// Kinds of card effect in the registry.
+const (
+	CardKindActionCard = "action_card"
+	CardKindAbility    = "ability"
+	CardKindPromissory = "promissory_note"
+)
+
+// CardEffect is a card or ability that grants or removes victory points
+// outside objectives, agendas and relics. Recording one writes an
+// action_card score titled with the card's name.
+type CardEffect struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	Name        string `gorm:"uniqueIndex;size:100" json:"name"`
+	Kind        string `gorm:"size:20" json:"kind"`
+	Description string `json:"description"`
+	// VictoryPoints is the usual award; it is negative for cards that take
+	// points away and can be overridden when the card is recorded.
+	VictoryPoints int `json:"victory_points"`
+	// Homebrew cards are added through the API and are never overwritten by
+	// the seeded catalogue.
+	Homebrew bool `json:"homebrew"`
+}
+
+// CardEffectRequest is the body of POST /games/:id/card-effects.
+type CardEffectRequest struct {
+	Card     string `json:"card" binding:"required"`
+	PlayerID uint   `json:"player_id" binding:"required"`
+	RoundID  uint   `json:"round_id"`                       // defaults to the current round
+	Points   *int   `json:"points" extensions:"x-nullable"` // defaults to the card's victory_points
+}
+
+type CardEffectStats struct {
+	Card        string `json:"card"`
+	TimesPlayed int    `json:"times_played"`
+	NetPoints   int    `json:"net_points"`
+	Games       int    `json:"games"`
+}
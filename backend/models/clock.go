@@ -0,0 +1,71 @@
+package models
+
+import "time"
+
+// Turn is a stretch of time on one player's clock, chess-clock style. A game
+// has at most one running turn, the one with a nil EndedAt. Passed marks the
+// turn on which the player passed for the rest of the action phase.
+type Turn struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	GameID    uint       `gorm:"index" json:"game_id"`
+	RoundID   uint       `gorm:"index" json:"round_id"`
+	PlayerID  uint       `gorm:"index" json:"player_id"`
+	Player    Player     `gorm:"foreignKey:PlayerID" json:"-"`
+	Phase     string     `gorm:"type:VARCHAR(10)" json:"phase"`
+	StartedAt time.Time  `json:"started_at" format:"date-time"`
+	EndedAt   *time.Time `json:"ended_at,omitempty" extensions:"x-nullable" format:"date-time"`
+	Passed    bool       `json:"passed"`
+}
+
+// TurnRequest is the body of POST /games/:game_id/turns/start and /pass.
+type TurnRequest struct {
+	PlayerID uint   `json:"player_id" binding:"required"`
+	Phase    string `json:"phase"` // defaults to the round's phase, or action
+}
+
+type PlayerClock struct {
+	PlayerID uint   `json:"player_id"`
+	Player   string `json:"player"`
+	Turns    int    `json:"turns"`
+	Seconds  int64  `json:"seconds"`
+	Passed   bool   `json:"passed"` // passed in the current round
+}
+
+// GameClock is the state of a game's turn timer. Seconds include the time
+// on the running turn so far.
+type GameClock struct {
+	Round          int           `json:"round"`
+	RoundStartedAt *time.Time    `json:"round_started_at,omitempty" extensions:"x-nullable" format:"date-time"`
+	Running        *Turn         `json:"running,omitempty" extensions:"x-nullable"`
+	Players        []PlayerClock `json:"players"`
+}
+
+type RoundLengthStat struct {
+	Round          int    `json:"round"`
+	Games          int    `json:"games"`
+	AverageSeconds int64  `json:"average_seconds"`
+	Average        string `json:"average"`
+}
+
+type PlayerTurnStat struct {
+	Player             string `json:"player"`
+	Turns              int    `json:"turns"`
+	TotalSeconds       int64  `json:"total_seconds"`
+	AverageTurnSeconds int64  `json:"average_turn_seconds"`
+	AverageTurn        string `json:"average_turn"`
+}
+
+type PhaseTimeStat struct {
+	Phase                  string `json:"phase"`
+	Turns                  int    `json:"turns"`
+	TotalSeconds           int64  `json:"total_seconds"`
+	AverageTurnSeconds     int64  `json:"average_turn_seconds"`
+	AveragePerRoundSeconds int64  `json:"average_per_round_seconds"`
+	AveragePerRound        string `json:"average_per_round"`
+}
+
+type ClockStats struct {
+	RoundLengths   []RoundLengthStat `json:"round_lengths"`
+	SlowestPlayers []PlayerTurnStat  `json:"slowest_players"`
+	Phases         []PhaseTimeStat   `json:"phases"`
+}
@@ -1,16 +1,99 @@
 package models
 
+import "strings"
+
 const (
-	ScoreTypePublic   = "public"
-	ScoreTypeSecret   = "secret"
-	SFTT              = "Support"
-	AgendaMutiny      = "Mutiny"
-	AgendaCDL         = "Classified Document Leaks"
-	AgendaSeed        = "Seed of an Empire"
-	AgendaCensure     = "Political Censure"
-	AgendaIncentive   = "Incentive Program"
-	ScoreTypeRelic    = "relic"
-	ScoreTypeImperial = "imperial"
-	ScoreTypeMecatol  = "mecatol"
-	ScoreTypeAgenda   = "agenda"
+	AgendaMutiny    = "Mutiny"
+	AgendaCDL       = "Classified Document Leaks"
+	AgendaSeed      = "Seed of an Empire"
+	AgendaCensure   = "Political Censure"
+	AgendaIncentive = "Incentive Program"
+
+	ActionCardImperialRider = "Imperial Rider"
 )
+
+// Overrides let a public objective be scored outside the usual limit of
+// one per player in each status phase. They are stored on the score.
+const (
+	// ScoreOverrideImperial is the Imperial strategy card's primary
+	// ability, which scores a public objective during the action phase.
+	ScoreOverrideImperial = "imperial"
+	// ScoreOverrideOther is any other exception, such as a house rule or a
+	// score recorded late. It needs a reason.
+	ScoreOverrideOther = "other"
+)
+
+// ScoreSource is where a score's points came from. It is stored in
+// Score.Type; only the values below are written.
+type ScoreSource string
+
+const (
+	ScoreTypePublic     ScoreSource = "public"
+	ScoreTypeSecret     ScoreSource = "secret"
+	ScoreTypeMecatol    ScoreSource = "mecatol" // Custodians token
+	ScoreTypeImperial   ScoreSource = "imperial"
+	ScoreTypeSupport    ScoreSource = "support" // Support for the Throne
+	ScoreTypeRelic      ScoreSource = "relic"
+	ScoreTypeAgenda     ScoreSource = "agenda"
+	ScoreTypeActionCard ScoreSource = "action_card"
+	ScoreTypeImported   ScoreSource = "imported"
+)
+
+// ScoreSources lists every canonical source in display order.
+var ScoreSources = []ScoreSource{
+	ScoreTypePublic,
+	ScoreTypeSecret,
+	ScoreTypeMecatol,
+	ScoreTypeImperial,
+	ScoreTypeSupport,
+	ScoreTypeRelic,
+	ScoreTypeAgenda,
+	ScoreTypeActionCard,
+	ScoreTypeImported,
+}
+
+// scoreSourceAliases maps spellings written by older releases.
+var scoreSourceAliases = map[string]ScoreSource{
+	"custodians":     ScoreTypeMecatol,
+	"sftt":           ScoreTypeSupport,
+	"imperial_rider": ScoreTypeActionCard,
+	"imperial rider": ScoreTypeActionCard,
+	"objective":      ScoreTypePublic,
+}
+
+// ParseScoreSource returns the canonical source for s, accepting any case
+// and legacy spellings such as "Support" or "imperial_rider".
+func ParseScoreSource(s string) (ScoreSource, bool) {
+	key := strings.ToLower(strings.TrimSpace(s))
+	for _, src := range ScoreSources {
+		if key == string(src) {
+			return src, true
+		}
+	}
+	src, ok := scoreSourceAliases[key]
+	return src, ok
+}
+
+func (s ScoreSource) Label() string {
+	switch s {
+	case ScoreTypePublic:
+		return "Public Objective"
+	case ScoreTypeSecret:
+		return "Secret Objective"
+	case ScoreTypeMecatol:
+		return "Custodians"
+	case ScoreTypeImperial:
+		return "Imperial Point"
+	case ScoreTypeSupport:
+		return "Support for the Throne"
+	case ScoreTypeRelic:
+		return "Relic"
+	case ScoreTypeAgenda:
+		return "Agenda"
+	case ScoreTypeActionCard:
+		return "Action Card"
+	case ScoreTypeImported:
+		return "Imported"
+	}
+	return string(s)
+}
@@ -0,0 +1,22 @@
+package models
+
+// Expansions a faction can come from.
+const (
+	ExpansionBase  = "base"
+	ExpansionPoK   = "pok"
+	ExpansionCodex = "codex"
+)
+
+// Faction is an entry in the faction catalogue. GamePlayer.Faction keeps the
+// canonical name so stats can group by it; FactionID links the metadata.
+type Faction struct {
+	ID        uint   `gorm:"primaryKey" json:"id"`
+	Name      string `gorm:"uniqueIndex;size:64" json:"name"`
+	Code      string `gorm:"uniqueIndex;size:16" json:"code"`
+	Expansion string `gorm:"index;size:16" json:"expansion"`
+	// Aliases are other spellings accepted when a faction is entered by
+	// hand or imported, such as "Hacan" or "L1".
+	Aliases     []string `gorm:"serializer:json" json:"aliases"`
+	HomeSystem  string   `json:"home_system"`
+	Commodities int      `json:"commodities"`
+}
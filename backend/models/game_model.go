@@ -1,15 +1,19 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 //these structs are to be used with the SQL database
 //Game represents a single game
 type Game struct {
 	ID                 uint            `gorm:"primaryKey" json:"id"`
 	GameNumber         int             `json:"game_number"`
-	CreatedAt          time.Time       `json:"created_at"`
-	FinishedAt         *time.Time      `json:"finished_at"`
-	WinnerID           *uint           `json:"winner_id"`
+	CreatedAt          time.Time       `json:"created_at" format:"date-time"`
+	FinishedAt         *time.Time      `json:"finished_at" extensions:"x-nullable" format:"date-time"`
+	WinnerID           *uint           `json:"winner_id" extensions:"x-nullable"`
 	Winner             Player          `gorm:"foreignKey:WinnerID" json:"winner"`
 	Rounds             []Round         `gorm:"foreignKey:GameID" json:"rounds"`
 	GamePlayers        []GamePlayer    `gorm:"foreignKey:GameID" json:"players"`
@@ -18,26 +22,43 @@ type Game struct {
 	GameObjectives     []GameObjective `json:"game_objectives"`
 	UseObjectiveDecks  bool            `json:"use_objective_decks"`
 	Partial            bool            `gorm:"default:false"`
-	SpeakerID          *uint           `json:"speaker_id"`
-	Speaker            *Player         `json:"speaker,omitempty"`
-	StartingSpeakerID  *uint
+	SpeakerID          *uint           `json:"speaker_id" extensions:"x-nullable"`
+	Speaker            *Player         `json:"speaker,omitempty" extensions:"x-nullable"`
+	StartingSpeakerID  *uint           `extensions:"x-nullable"`
 	SpeakerAssignments []SpeakerAssignment
+	Title              string `gorm:"type:VARCHAR(120)" json:"title"`
+	Notes              string `json:"notes"`
+	Location           string `gorm:"type:VARCHAR(120)" json:"location"`
+	// HouseRules and TemplateID are set on games created from a template.
+	HouseRules []string `gorm:"serializer:json" json:"house_rules"`
+	TemplateID *uint    `json:"template_id" extensions:"x-nullable"`
 }
 
 //Single player
 type Player struct {
-	ID     uint `gorm:"primaryKey"`
-	Name   string
-	Games  []GamePlayer `gorm:"foreignKey:PlayerID" json:"-"`
-	GameID uint         `gorm:"index"`
+	ID      uint `gorm:"primaryKey"`
+	Name    string
+	Active  bool          `gorm:"default:true"` //retired players are left off leaderboards
+	Aliases []PlayerAlias `gorm:"foreignKey:PlayerID" json:",omitempty"`
+	Games   []GamePlayer  `gorm:"foreignKey:PlayerID" json:"-"`
+}
+
+//other name a player is found by when games are created or imported
+type PlayerAlias struct {
+	ID       uint   `gorm:"primaryKey" json:"-"`
+	PlayerID uint   `gorm:"index" json:"-"`
+	Alias    string `gorm:"uniqueIndex;size:64"` //stored lower case
 }
 
 //Round counter
 type Round struct {
-	ID     uint `gorm:"primaryKey"`
-	GameID uint
-	Number int     `gorm:"column:number"`
-	Scores []Score `gorm:"foreignKey:RoundID"`
+	ID        uint `gorm:"primaryKey"`
+	GameID    uint
+	Number    int        `gorm:"column:number"`
+	Scores    []Score    `gorm:"foreignKey:RoundID"`
+	StartedAt *time.Time `format:"date-time" extensions:"x-nullable"` //nil for rounds recorded before rounds were timed
+	EndedAt   *time.Time `format:"date-time" extensions:"x-nullable"`
+	Phase     string     `gorm:"type:VARCHAR(10)"` //empty unless the game tracks phases
 }
 
 //Scoring information
@@ -49,13 +70,29 @@ type Score struct {
 	ObjectiveID      uint `gorm:"not null"`
 	Objective        Objective
 	Points           int
-	Round            Round     `gorm:"foreignKey:RoundID"`
-	Player           Player    `gorm:"foreignKey:PlayerID"`
-	Type             string    `gorm:"type:VARCHAR(20)"` //e.g. objective, imperial, support
-	AgendaTitle      string    `gorm:"type:VARCHAR(100)"`
-	RelicTitle       string    `gorm:"type:VARCHAR(20)"`
-	CreatedAt        time.Time `json:"created_at"`
-	OriginallySecret bool      `gorm:"default:false"`
+	Round            Round       `gorm:"foreignKey:RoundID"`
+	Player           Player      `gorm:"foreignKey:PlayerID"`
+	Type             ScoreSource `gorm:"type:VARCHAR(20)"` //see ScoreSources
+	AgendaTitle      string      `gorm:"type:VARCHAR(100)"`
+	RelicTitle       string      `gorm:"type:VARCHAR(64)"`
+	CardTitle        string      `gorm:"type:VARCHAR(100)"` //action card or promissory note that awarded the points
+	CreatedAt        time.Time   `json:"created_at" format:"date-time"`
+	OriginallySecret bool        `gorm:"default:false"`
+	Phase            string      `gorm:"type:VARCHAR(10)"` //phase of the round when scored
+	Override         string      `gorm:"type:VARCHAR(20)"` //see ScoreOverrideImperial; empty for a normal score
+	OverrideReason   string      `gorm:"type:VARCHAR(200)"`
+}
+
+//stamps the score with the phase its round is in
+func (s *Score) BeforeCreate(tx *gorm.DB) error {
+	if s.Phase != "" || s.RoundID == 0 {
+		return nil
+	}
+	return tx.Session(&gorm.Session{NewDB: true}).
+		Model(&Round{}).
+		Where("id = ?", s.RoundID).
+		Select("phase").
+		Scan(&s.Phase).Error
 }
 
 //Objective information
@@ -71,13 +108,16 @@ type Objective struct {
 
 //links game and player together into one struct
 type GamePlayer struct {
-	ID       uint `gorm:"PrimaryKey"`
-	GameID   uint
-	PlayerID uint
-	Faction  string
-	Player   Player `gorm:"foreignKey:PlayerID"`
-	Game     Game   `gorm:"foreignKey:GameID;references:ID" json:"-"`
-	Won      bool
+	ID         uint `gorm:"PrimaryKey"`
+	GameID     uint
+	PlayerID   uint
+	Faction    string   //canonical faction name, kept for grouping in stats
+	FactionID  *uint    `gorm:"index" extensions:"x-nullable"`
+	FactionRef *Faction `gorm:"foreignKey:FactionID" json:"faction_ref,omitempty" extensions:"x-nullable"`
+	Player     Player   `gorm:"foreignKey:PlayerID"`
+	Game       Game     `gorm:"foreignKey:GameID;references:ID" json:"-"`
+	Won        bool
+	Eliminated bool `gorm:"default:false"`
 }
 
 //links game and ovjective into one struct
@@ -96,12 +136,13 @@ type GameObjective struct {
 
 type PlayerInput struct {
 	ID      string
-	Name    string
-	Faction string
+	Name    string `binding:"required_without=ID"`
+	Faction string `binding:"required"`
+	New     bool //create the player even if the name is close to an existing one
 }
 
 type AssignObjectiveRequest struct {
-	GameID      uint `json:"game_id"`
-	RoundID     int  `json:"round_id"`
-	ObjectiveID uint `json:"objective_id"`
+	GameID      uint `json:"game_id" binding:"required"`
+	RoundID     int  `json:"round_id" binding:"required,min=1"` // round number
+	ObjectiveID uint `json:"objective_id" binding:"required"`
 }
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// How a game created from a template picks its speaker.
+const (
+	SpeakerModeRandom = "random" // a random player
+	SpeakerModeFirst  = "first"  // the first player listed
+	SpeakerModeNone   = "none"   // set by hand later
+)
+
+// Points targets a template, or an imported game, may play to. The
+// GameTemplate binding tag repeats them.
+const (
+	MinWinningPoints = 1
+	MaxWinningPoints = 30
+)
+
+// GameTemplate is a saved game setup: the usual group, the factions they
+// draw from, the points target and house rules. Unlike CreateGame, a
+// template may play to any number of points.
+type GameTemplate struct {
+	ID                uint                 `gorm:"primaryKey" json:"id"`
+	Name              string               `gorm:"uniqueIndex;size:100" json:"name" binding:"required,max=100"`
+	Description       string               `json:"description"`
+	WinningPoints     int                  `json:"winning_points" binding:"required,min=1,max=30"`
+	UseObjectiveDecks bool                 `json:"use_objective_decks"`
+	TrackPhases       bool                 `json:"track_phases"`
+	SpeakerMode       string               `gorm:"size:20" json:"speaker_mode" binding:"omitempty,oneof=random first none"` // defaults to random
+	Players           []GameTemplatePlayer `gorm:"serializer:json" json:"players" binding:"max=8,dive"`
+	// FactionPool is what players without a faction draw from; empty means
+	// every faction in the catalogue.
+	FactionPool []string `gorm:"serializer:json" json:"faction_pool" binding:"dive,required"`
+	// HouseRules are copied onto each game made from the template, e.g.
+	// "no_custodians" or "14_point_stage_ii".
+	HouseRules []string  `gorm:"serializer:json" json:"house_rules" binding:"dive,required,max=60"`
+	CreatedAt  time.Time `json:"created_at" format:"date-time"`
+	UpdatedAt  time.Time `json:"updated_at" format:"date-time"`
+}
+
+// GameTemplatePlayer is a seat in a template. A player without a faction
+// draws one at random from the pool when the game is created.
+type GameTemplatePlayer struct {
+	Name    string `json:"name" binding:"required"`
+	Faction string `json:"faction,omitempty"`
+}
+
+// GameFromTemplateRequest adjusts a game created from a template. Players,
+// when given, replace the template's seats.
+type GameFromTemplateRequest struct {
+	Players  []GameTemplatePlayer `json:"players" binding:"omitempty,min=3,max=8,dive"`
+	Title    string               `json:"title" binding:"max=200"`
+	Notes    string               `json:"notes"`
+	Location string               `json:"location" binding:"max=200"`
+}
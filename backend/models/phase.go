@@ -0,0 +1,25 @@
+package models
+
+const (
+	PhaseStrategy = "strategy"
+	PhaseAction   = "action"
+	PhaseStatus   = "status"
+	PhaseAgenda   = "agenda"
+)
+
+// Phases lists the phases of a round in play order.
+var Phases = []string{PhaseStrategy, PhaseAction, PhaseStatus, PhaseAgenda}
+
+// PhaseRequest is the body of POST /games/:game_id/phase.
+type PhaseRequest struct {
+	Phase string `json:"phase"` // empty moves to the next phase
+}
+
+// PhaseState is where a game is within its current round. Phase is empty
+// for games that do not track phases.
+type PhaseState struct {
+	Round             int    `json:"round"`
+	Phase             string `json:"phase"`
+	Next              string `json:"next,omitempty"` // empty when the round should be advanced next
+	CustodiansClaimed bool   `json:"custodians_claimed"`
+}
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// Relic is an entry in the relic catalogue. Effects are described by data so
+// a single engine can apply every relic.
+type Relic struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	Name        string `gorm:"uniqueIndex;size:64" json:"name"`
+	Description string `json:"description"`
+	// VictoryPoints is awarded when the relic is gained.
+	VictoryPoints int `json:"victory_points"`
+	// PointsFollowHolder means the points are lost with the relic and move
+	// with it on transfer (Shard of the Throne). Otherwise they are kept.
+	PointsFollowHolder bool `json:"points_follow_holder"`
+	Transferable       bool `json:"transferable"`
+	// SecretCapModifier is added to the holder's secret objective limit.
+	SecretCapModifier int `json:"secret_cap_modifier"`
+}
+
+// RelicHolding records a player holding a relic in a game. A holding with a
+// nil LostAt is current; earlier holdings are kept as history.
+type RelicHolding struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	GameID    uint       `gorm:"index" json:"game_id"`
+	RelicID   uint       `gorm:"index" json:"relic_id"`
+	Relic     Relic      `gorm:"foreignKey:RelicID" json:"relic"`
+	PlayerID  uint       `gorm:"index" json:"player_id"`
+	Player    Player     `gorm:"foreignKey:PlayerID" json:"-"`
+	RoundID   uint       `json:"round_id"`
+	CreatedAt time.Time  `json:"gained_at" format:"date-time"`
+	LostAt    *time.Time `json:"lost_at,omitempty" extensions:"x-nullable" format:"date-time"`
+}
+
+// RelicActionRequest is the body of POST /games/:id/relics.
+type RelicActionRequest struct {
+	Action     string `json:"action" binding:"required,oneof=gain lose transfer"`
+	Relic      string `json:"relic" binding:"required"`
+	PlayerID   uint   `json:"player_id" binding:"required"`                       // gaining or losing player
+	ToPlayerID uint   `json:"to_player_id" binding:"required_if=Action transfer"` // transfer target
+}
+
+type RelicHoldingDTO struct {
+	RelicID    uint       `json:"relic_id"`
+	Relic      string     `json:"relic"`
+	PlayerID   uint       `json:"player_id"`
+	PlayerName string     `json:"player_name"`
+	RoundID    uint       `json:"round_id"`
+	GainedAt   time.Time  `json:"gained_at" format:"date-time"`
+	LostAt     *time.Time `json:"lost_at,omitempty" extensions:"x-nullable" format:"date-time"`
+}
@@ -0,0 +1,81 @@
+package models
+
+// Responses for endpoints that do not return a stored model directly. The
+// JSON names are what the frontend already reads, so they are not all in
+// the same case.
+
+// MessageResponse is returned by actions that have nothing else to report.
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// ErrorResponse is the body of every 4xx and 5xx response.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// UnknownPlayerResponse is the 409 from creating a game with a player name
+// that looks like a typo of an existing player.
+type UnknownPlayerResponse struct {
+	Error       string   `json:"error"`
+	Player      string   `json:"player"`
+	Suggestions []string `json:"suggestions"`
+}
+
+type ExistsResponse struct {
+	Exists bool `json:"exists"`
+}
+
+type CreateGameResponse struct {
+	Game     Game            `json:"game"`
+	Revealed []GameObjective `json:"revealed"` // objectives revealed at setup
+}
+
+// AdvanceRoundResponse reports the new round, or the last round when the
+// game ended because no objectives were left to reveal.
+type AdvanceRoundResponse struct {
+	Message       string `json:"message"` // "round_advanced" or "Game Ended"
+	CurrentRound  int    `json:"current_round,omitempty"`
+	Round         int    `json:"round,omitempty"`
+	Revealed      string `json:"revealed,omitempty"` // stage of the objective revealed
+	TotalRevealed int64  `json:"totalRevealed"`
+	WinnerID      *uint  `json:"winner_id,omitempty" extensions:"x-nullable"`
+}
+
+type ScoreResponse struct {
+	Message     string `json:"message"` // "Score added" or "Game finished"
+	Objective   string `json:"objective"`
+	Points      int    `json:"points"`
+	Round       int    `json:"round"`
+	TotalPoints int    `json:"total_points"`
+	Winner      *uint  `json:"winner,omitempty" extensions:"x-nullable"`
+}
+
+type SpeakerResponse struct {
+	SpeakerID   uint   `json:"speaker_id"`
+	SpeakerName string `json:"speaker_name"`
+}
+
+type DeleteGameResponse struct {
+	Status string `json:"status"`
+	GameID int    `json:"game_id"`
+}
+
+type PlayerGamesResponse struct {
+	Player string       `json:"player"`
+	Games  []GamePlayer `json:"games"`
+}
+
+// FieldError is one invalid field of a request, named as in the JSON body
+// or path, e.g. "player_id" or "players[1].Faction".
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationErrorResponse is the body of a 422: the request was well formed
+// but the listed fields are not valid.
+type ValidationErrorResponse struct {
+	Error  string       `json:"error"`
+	Fields []FieldError `json:"fields"`
+}
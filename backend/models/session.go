@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+// Answers a player can give to a session invitation.
+const (
+	RSVPYes   = "yes"
+	RSVPNo    = "no"
+	RSVPMaybe = "maybe"
+)
+
+// GameSession is one sitting of a game, for games played over several
+// evenings. A session is scheduled while only StartsAt is set, running once
+// StartedAt is set and over once EndedAt is. A game has at most one running
+// session: pausing the game ends it and resuming starts the next.
+type GameSession struct {
+	ID        uint          `gorm:"primaryKey" json:"id"`
+	GameID    uint          `gorm:"index" json:"game_id"`
+	StartsAt  *time.Time    `gorm:"index" json:"starts_at" extensions:"x-nullable" format:"date-time"` // when it is scheduled for
+	StartedAt *time.Time    `json:"started_at" extensions:"x-nullable" format:"date-time"`
+	EndedAt   *time.Time    `json:"ended_at" extensions:"x-nullable" format:"date-time"`
+	Location  string        `gorm:"type:VARCHAR(120)" json:"location"`
+	Notes     string        `json:"notes"`
+	RSVPs     []SessionRSVP `gorm:"foreignKey:SessionID" json:"rsvps"`
+	CreatedAt time.Time     `json:"created_at" format:"date-time"`
+}
+
+// SessionRSVP is a player's answer to a scheduled session.
+type SessionRSVP struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	SessionID uint      `gorm:"uniqueIndex:idx_session_rsvp" json:"session_id"`
+	PlayerID  uint      `gorm:"uniqueIndex:idx_session_rsvp" json:"player_id"`
+	Player    string    `gorm:"-" json:"player"`
+	Status    string    `gorm:"type:VARCHAR(10)" json:"status"`
+	UpdatedAt time.Time `json:"updated_at" format:"date-time"`
+}
+
+// ScheduleSessionRequest is the body of POST /games/:id/sessions.
+type ScheduleSessionRequest struct {
+	StartsAt time.Time `json:"starts_at" binding:"required" format:"date-time"`
+	Location string    `json:"location" binding:"max=120"` // defaults to the game's location
+	Notes    string    `json:"notes"`
+}
+
+// RSVPRequest is the body of POST /games/:id/sessions/:session_id/rsvps.
+type RSVPRequest struct {
+	PlayerID uint   `json:"player_id" binding:"required"`
+	Status   string `json:"status" binding:"required,oneof=yes no maybe"`
+}
+
+// GameSessions lists a game's sessions. ActiveSeconds is the time played:
+// the finished sessions plus the running one so far.
+type GameSessions struct {
+	Sessions      []GameSession `json:"sessions"`
+	Running       *GameSession  `json:"running,omitempty" extensions:"x-nullable"`
+	ActiveSeconds int64         `json:"active_seconds"`
+	Active        string        `json:"active"`
+}
+
+// UpcomingSession is a scheduled session with the game it belongs to.
+type UpcomingSession struct {
+	Session    GameSession `json:"session"`
+	GameNumber int         `json:"game_number"`
+	GameTitle  string      `json:"game_title"`
+}
@@ -3,11 +3,15 @@ package models
 import "time"
 
 type CreateGameInput struct {
-	WinningPoints     int           `json:"winning_points"`
-	UseObjectiveDecks *bool         `json:"use_objective_decks"`
-	Players           []PlayerInput `json:"players"`
-	UseRandomSpeaker  *bool         `json:"use_random_speaker"`
-	SpeakerID         *uint         `json:"speaker_id"`
+	WinningPoints     int           `json:"winning_points" binding:"omitempty,oneof=10 14"` // 0 uses the configured default
+	UseObjectiveDecks *bool         `json:"use_objective_decks" extensions:"x-nullable"`
+	Players           []PlayerInput `json:"players" binding:"required,min=3,max=8,dive"`
+	UseRandomSpeaker  *bool         `json:"use_random_speaker" extensions:"x-nullable"`
+	SpeakerID         *uint         `json:"speaker_id" extensions:"x-nullable"`
+	Title             string        `json:"title" binding:"max=200"`
+	Notes             string        `json:"notes"`
+	Location          string        `json:"location" binding:"max=200"`
+	TrackPhases       *bool         `json:"track_phases" extensions:"x-nullable"` // start round 1 in the strategy phase
 }
 
 type PlayerScoreSummary struct {
@@ -16,10 +20,13 @@ type PlayerScoreSummary struct {
 	Points     int    `json:"points"`
 }
 
+// RoundScore is one award in a round; Source is the objective, agenda,
+// relic or card it came from.
 type RoundScore struct {
-	Player    string `json:"player"`
-	Objective string `json:"objective"`
-	Points    int    `json:"points"`
+	Round  int    `json:"round"`
+	Player string `json:"player"`
+	Source string `json:"source"`
+	Points int    `json:"points"`
 }
 
 type RoundScoresGroup struct {
@@ -32,24 +39,31 @@ type GameDetailResponse struct {
 	GameNumber         int                  `json:"game_number"`
 	WinningPoints      int                  `json:"winning_points"`
 	CurrentRound       int                  `json:"current_round"`
-	FinishedAt         *time.Time           `json:"finished_at"`
+	FinishedAt         *time.Time           `json:"finished_at" extensions:"x-nullable" format:"date-time"`
 	UseObjectiveDecks  bool                 `json:"use_objective_decks"`
 	Players            []GamePlayer         `json:"players"`
 	Rounds             []Round              `json:"rounds"`
 	Objectives         []GameObjective      `json:"objectives"`
 	Scores             []PlayerScoreSummary `json:"scores"`
 	AllScores          []ScoreDTO           `json:"all_scores"`
-	Winner             *Player              `json:"winner"`
-	CustodiansPlayerID *uint                `json:"custodiansPlayerId,omitempty"`
+	Winner             *Player              `json:"winner" extensions:"x-nullable"`
+	CustodiansPlayerID *uint                `json:"custodiansPlayerId,omitempty" extensions:"x-nullable"`
 	ScoresByObjective  map[uint][]ScoreDTO  `json:"ScoresByObjective"`
-	WinnerVictoryPath  *VictoryPathSummary  `json:"victory_path,omitempty"`
-	SpeakerID          *uint                `json:"speaker_id"`
+	WinnerVictoryPath  *VictoryPathSummary  `json:"victory_path,omitempty" extensions:"x-nullable"`
+	SpeakerID          *uint                `json:"speaker_id" extensions:"x-nullable"`
 	SpeakerName        string               `json:"speaker_name,omitempty"`
+	Title              string               `json:"title"`
+	Notes              string               `json:"notes"`
+	Location           string               `json:"location"`
+	HouseRules         []string             `json:"house_rules"`
+	TemplateID         *uint                `json:"template_id" extensions:"x-nullable"`
+	Relics             []RelicHoldingDTO    `json:"relics"`
+	Support            []SupportHoldingDTO  `json:"support"`
 }
 
 type SelectedPlayersWithFaction struct {
 	Player  Player
-	Faction string
+	Faction Faction
 }
 
 type ScoredObjective struct {
@@ -66,30 +80,30 @@ type ObjectiveScoreSummary struct {
 }
 
 type AgendaResolution struct {
-	GameID   uint   `json:"game_id"`
-	RoundID  uint   `json:"round_id"`
-	Result   string `json:"result"`
-	ForVotes []uint `json:"for_votes"`
+	GameID   uint   `json:"game_id" binding:"required"`
+	RoundID  uint   `json:"round_id"`                                     // 0 for the current round
+	Result   string `json:"result" binding:"omitempty,oneof=for against"` // empty records the agenda with no effect
+	ForVotes []uint `json:"for_votes" binding:"dive,required"`
 }
 
 type PoliticalCensureRequest struct {
-	GameID   uint `json:"game_id"`
-	RoundID  uint `json:"round_id"`
-	PlayerID uint `json:"player_id"`
+	GameID   uint `json:"game_id" binding:"required"`
+	RoundID  uint `json:"round_id"` // 0 for the current round
+	PlayerID uint `json:"player_id" binding:"required"`
 	Gained   bool `json:"gained"`
 }
 
 type SeedOfEmpireResolution struct {
-	GameID  uint   `json:"game_id"`
-	RoundID uint   `json:"round_id"`
-	Result  string `json:"result"` // "for" or "against"
+	GameID  uint   `json:"game_id" binding:"required"`
+	RoundID uint   `json:"round_id"` // 0 for the current round
+	Result  string `json:"result" binding:"required,oneof=for against"`
 }
 
 type ClassifiedDocumentLeaksRequest struct {
-	GameID      uint `json:"game_id"`
-	RoundID     uint `json:"round_id"`
-	PlayerID    uint `json:"player_id"`
-	ObjectiveID uint `json:"objective_id"`
+	GameID      uint `json:"game_id" binding:"required"`
+	RoundID     uint `json:"round_id"` // 0 for the current round
+	PlayerID    uint `json:"player_id" binding:"required"`
+	ObjectiveID uint `json:"objective_id" binding:"required"`
 }
 
 type ObjectiveWithMetadata struct {
@@ -98,8 +112,8 @@ type ObjectiveWithMetadata struct {
 }
 
 type IncentiveProgramRequest struct {
-	GameID  uint   `json:"game_id"`
-	Outcome string `json:"outcome"`
+	GameID  uint   `json:"game_id" binding:"required"`
+	Outcome string `json:"outcome" binding:"required,oneof=for against"`
 }
 
 type ObjectiveDeck struct {
@@ -113,23 +127,51 @@ type ObjectiveDeck struct {
 }
 
 type AssignPlayerInput struct {
-	GameID   uint   `json:"game_id"`
-	PlayerID uint   `json:"player_id"`
-	Faction  string `json:"faction"`
+	GameID   uint   `json:"game_id" binding:"required"`
+	PlayerID uint   `json:"player_id" binding:"required"`
+	Faction  string `json:"faction" binding:"required"`
+}
+
+type ScoreRequest struct {
+	GameID      uint `json:"game_id" binding:"required"`
+	PlayerID    uint `json:"player_id" binding:"required"`
+	ObjectiveID uint `json:"objective_id" binding:"required"`
+	// Override scores a public objective beyond the one per status phase:
+	// "imperial" for the Imperial strategy card, "other" with a reason.
+	Override       string `json:"override,omitempty" binding:"omitempty,oneof=imperial other"`
+	OverrideReason string `json:"override_reason,omitempty" binding:"required_if=Override other,max=200"`
+}
+
+// PointRequest awards a point that is not tied to an objective, such as an
+// Imperial or Custodians point.
+type PointRequest struct {
+	GameID   uint `json:"game_id" binding:"required"`
+	PlayerID uint `json:"player_id" binding:"required"`
+	RoundID  uint `json:"round_id"` // 0 for the current round
+}
+
+type SupportForTheThroneRequest struct {
+	RoundID uint   `json:"round_id"`
+	Action  string `json:"action" binding:"required,oneof=score unscore"`
+	OwnerID uint   `json:"owner_id"`
 }
 
 type ScoreDTO struct {
-	ID               uint      `json:"id"`
-	GameID           uint      `json:"game_id"`
-	RoundID          uint      `json:"round_id"`
-	PlayerID         uint      `json:"player_id"`
-	ObjectiveID      uint      `json:"objective_id,omitempty"`
-	Points           int       `json:"points"`
-	Type             string    `json:"type"`
-	AgendaTitle      string    `json:"agenda_title,omitempty"`
-	RelicTitle       string    `json:"relic_title,omitempty"`
-	OriginallySecret bool      `json:"originally_secret,omitempty"`
-	CreatedAt        time.Time `json:"created_at"`
+	ID               uint        `json:"id"`
+	GameID           uint        `json:"game_id"`
+	RoundID          uint        `json:"round_id"`
+	PlayerID         uint        `json:"player_id"`
+	ObjectiveID      uint        `json:"objective_id,omitempty"`
+	Points           int         `json:"points"`
+	Type             ScoreSource `json:"type"`
+	AgendaTitle      string      `json:"agenda_title,omitempty"`
+	RelicTitle       string      `json:"relic_title,omitempty"`
+	CardTitle        string      `json:"card_title,omitempty"`
+	OriginallySecret bool        `json:"originally_secret,omitempty"`
+	Override         string      `json:"override,omitempty"`
+	OverrideReason   string      `json:"override_reason,omitempty"`
+	Phase            string      `json:"phase,omitempty"`
+	CreatedAt        time.Time   `json:"created_at" format:"date-time"`
 }
 
 type SpeakerAssignment struct {
@@ -142,9 +184,87 @@ type SpeakerAssignment struct {
 	Player   Player `gorm:"foreignKey:PlayerID"`
 }
 
+type RenamePlayerRequest struct {
+	Name      string `json:"name" binding:"required,max=100"`
+	KeepAlias bool   `json:"keep_alias"` // keep the old name as an alias
+}
+
+type MergePlayerRequest struct {
+	IntoPlayerID uint `json:"into_player_id" binding:"required"`
+}
+
+type PlayerAliasRequest struct {
+	Alias string `json:"alias" binding:"required,max=100"`
+}
+
 type AssignSpeakerRequest struct {
-	GameID    uint `json:"game_id"`
-	RoundID   uint `json:"round_id"`
-	PlayerID  uint `json:"player_id"`
+	GameID    uint `json:"game_id" binding:"required"`
+	RoundID   uint `json:"round_id" binding:"required"` // round number
+	PlayerID  uint `json:"player_id" binding:"required"`
 	IsInitial bool `json:"is_initial"` // optional logic flag
 }
+
+// ImportGame describes a historical game for POST /import/games.
+type ImportGame struct {
+	Row           int            `json:"-"`
+	Key           string         `json:"game"` // groups CSV rows; optional in JSON
+	Date          string         `json:"date"` // YYYY-MM-DD or RFC 3339
+	Title         string         `json:"title"`
+	Location      string         `json:"location"`
+	Notes         string         `json:"notes"`
+	WinningPoints int            `json:"winning_points"`
+	Rounds        int            `json:"rounds"`
+	Players       []ImportPlayer `json:"players"`
+}
+
+type ImportPlayer struct {
+	Row         int    `json:"-"`
+	Name        string `json:"name"`
+	Faction     string `json:"faction"`
+	FinalPoints *int   `json:"final_points" extensions:"x-nullable"`
+	Won         bool   `json:"won"`
+	RoundPoints []int  `json:"round_points"` // points scored in each round, optional
+}
+
+type ImportError struct {
+	Row     int    `json:"row"`
+	Game    string `json:"game,omitempty"`
+	Player  string `json:"player,omitempty"`
+	Message string `json:"message"`
+}
+
+type ImportedGame struct {
+	Row        int    `json:"row"`
+	Game       string `json:"game,omitempty"`
+	GameID     uint   `json:"game_id"`
+	GameNumber int    `json:"game_number"`
+	Partial    bool   `json:"partial"`
+}
+
+type ImportResult struct {
+	Imported []ImportedGame `json:"imported,omitempty"`
+	Errors   []ImportError  `json:"errors,omitempty"`
+}
+
+// VPProvenance is a single award of points and what it was for: the
+// objective, agenda, relic or action card, or the source label otherwise.
+type VPProvenance struct {
+	Title  string `json:"title"`
+	Round  int    `json:"round"`
+	Points int    `json:"points"`
+}
+
+type VPSourceBreakdown struct {
+	Source ScoreSource    `json:"source"`
+	Label  string         `json:"label"`
+	Points int            `json:"points"`
+	Items  []VPProvenance `json:"items"`
+}
+
+type PlayerVPBreakdown struct {
+	PlayerID   uint                `json:"player_id"`
+	PlayerName string              `json:"player_name"`
+	Faction    string              `json:"faction"`
+	Total      int                 `json:"total"`
+	Sources    []VPSourceBreakdown `json:"sources"`
+}
@@ -90,7 +90,7 @@ type GameDurationStat struct {
 	RoundCount int       `json:"round_count"`
 	Duration   string    `json:"duration"`
 	Seconds    int64     `json:"seconds"`
-	StartedAt  time.Time `json:"started_at"`
+	StartedAt  time.Time `json:"started_at" format:"date-time"`
 }
 
 type GameLengthStats struct {
@@ -0,0 +1,68 @@
+package models
+
+import "time"
+
+const (
+	SupportReturned         = "returned"
+	SupportOwnerEliminated  = "owner_eliminated"
+	SupportHolderEliminated = "holder_eliminated"
+
+	SupportForTheThrone = "Support for the Throne"
+)
+
+// SupportHolding records that OwnerID's Support for the Throne promissory
+// note is in HolderID's play area. The holder has 1 VP from it while
+// EndedAt is nil. OwnerID is nil for notes backfilled from games recorded
+// before owners were tracked.
+type SupportHolding struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	GameID    uint       `gorm:"index" json:"game_id"`
+	OwnerID   *uint      `gorm:"index" json:"owner_id" extensions:"x-nullable"`
+	Owner     Player     `gorm:"foreignKey:OwnerID" json:"-"`
+	HolderID  uint       `gorm:"index" json:"holder_id"`
+	Holder    Player     `gorm:"foreignKey:HolderID" json:"-"`
+	RoundID   uint       `json:"round_id"`
+	CreatedAt time.Time  `json:"given_at" format:"date-time"`
+	EndedAt   *time.Time `json:"ended_at,omitempty" extensions:"x-nullable" format:"date-time"`
+	EndReason string     `gorm:"type:VARCHAR(20)" json:"end_reason,omitempty"`
+}
+
+// SupportActionRequest is the body of POST /games/:game_id/support.
+type SupportActionRequest struct {
+	Action string `json:"action" binding:"required,oneof=give return eliminate"`
+	// give needs both sides; return needs either, which the service checks.
+	OwnerID  uint `json:"owner_id" binding:"required_if=Action give"`
+	HolderID uint `json:"holder_id" binding:"required_if=Action give"`
+	PlayerID uint `json:"player_id" binding:"required_if=Action eliminate"` // eliminated player
+}
+
+type SupportHoldingDTO struct {
+	OwnerID    *uint      `json:"owner_id" extensions:"x-nullable"`
+	OwnerName  string     `json:"owner_name"`
+	HolderID   uint       `json:"holder_id"`
+	HolderName string     `json:"holder_name"`
+	RoundID    uint       `json:"round_id"`
+	GivenAt    time.Time  `json:"given_at" format:"date-time"`
+	EndedAt    *time.Time `json:"ended_at,omitempty" extensions:"x-nullable" format:"date-time"`
+	EndReason  string     `json:"end_reason,omitempty"`
+}
+
+type SupportPairStats struct {
+	Owner      string `json:"owner"`
+	Holder     string `json:"holder"`
+	TimesGiven int    `json:"times_given"`
+	Returned   int    `json:"returned"`
+	HeldAtEnd  int    `json:"held_at_end"`
+}
+
+type PlayerSupportStats struct {
+	Player        string `json:"player"`
+	Given         int    `json:"given"`
+	Received      int    `json:"received"`
+	HeldAtGameEnd int    `json:"held_at_game_end"`
+}
+
+type SupportStats struct {
+	Pairs   []SupportPairStats   `json:"pairs"`
+	Players []PlayerSupportStats `json:"players"`
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	"github.com/arphillips06/TI4-stats/apispec"
+	"github.com/arphillips06/TI4-stats/config"
+	"github.com/arphillips06/TI4-stats/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// apiV1 is the prefix of the versioned API.
+const apiV1 = "/api/v1"
+
+// route is an endpoint under /api/v1 and the routes it replaced, which
+// still work as deprecated aliases until clients have moved over.
+type route struct {
+	method  string
+	path    string // relative to apiV1
+	handler gin.HandlerFunc
+	legacy  []string // "METHOD /path"
+}
+
+// apiRoutes lists every endpoint enabled in cfg. Game resources are nested
+// under /games/:id, and the game always comes from the path.
+func apiRoutes(cfg config.Config) []route {
+	w := controllers.Wrap
+	routes := []route{
+		//player management
+		{"GET", "/players", w(controllers.ListPlayers), []string{"GET /players"}},
+		{"POST", "/players", w(controllers.CreatePlayer), []string{"POST /players"}},
+		{"GET", "/players/:id", w(controllers.GetPlayer), []string{"GET /players/:id"}},
+		{"GET", "/players/:id/games", w(controllers.GetPlayerGames), []string{"GET /players/:id/games"}},
+		{"POST", "/players/:id/rename", w(controllers.RenamePlayer), []string{"POST /players/:id/rename"}},
+		{"POST", "/players/:id/merge", w(controllers.MergePlayer), []string{"POST /players/:id/merge"}},
+		{"POST", "/players/:id/aliases", w(controllers.AddPlayerAlias), []string{"POST /players/:id/aliases"}},
+		{"DELETE", "/players/:id/aliases/:alias", w(controllers.RemovePlayerAlias), []string{"DELETE /players/:id/aliases/:alias"}},
+		{"POST", "/players/:id/retire", w(controllers.RetirePlayer), []string{"POST /players/:id/retire"}},
+		{"POST", "/players/:id/reactivate", w(controllers.ReactivatePlayer), []string{"POST /players/:id/reactivate"}},
+
+		// games
+		{"GET", "/games", w(controllers.ListGames), []string{"GET /games"}},
+		{"POST", "/games", w(controllers.CreateGame), []string{"POST /games"}},
+		{"GET", "/games/:id", w(controllers.GetGameByID), []string{"GET /games/:id"}},
+		{"DELETE", "/games/:id", controllers.DeleteGameHandler, []string{"DELETE /games/:id"}},
+		{"GET", "/games/:id/exists", w(controllers.GetGameExists), []string{"GET /api/games/:id/exists"}},
+		{"GET", "/games/:id/audit", w(controllers.GetGameAudit), []string{"GET /games/:id/audit"}},
+		{"GET", "/games/:id/players", w(controllers.ListPlayersInGame), []string{"GET /games/:id/players"}},
+		{"POST", "/games/:id/players", w(controllers.AssignPlayerToGame), []string{"POST /gameplayers"}},
+		{"GET", "/games/:id/vp-breakdown", w(controllers.GetVPBreakdown), []string{"GET /games/:id/vp-breakdown"}},
+		{"GET", "/games/:id/achievements", w(controllers.GetGameAchievements), []string{"GET /games/:id/achievements"}},
+		{"POST", "/games/from-template/:id", w(controllers.CreateGameFromTemplate), nil},
+
+		// sessions
+		{"GET", "/games/:id/sessions", w(controllers.GetGameSessions), nil},
+		{"POST", "/games/:id/sessions", w(controllers.ScheduleSession), nil},
+		{"POST", "/games/:id/sessions/pause", w(controllers.PauseGame), nil},
+		{"POST", "/games/:id/sessions/resume", w(controllers.ResumeGame), nil},
+		{"DELETE", "/games/:id/sessions/:session_id", w(controllers.CancelSession), nil},
+		{"POST", "/games/:id/sessions/:session_id/rsvps", w(controllers.RespondToSession), nil},
+		{"GET", "/sessions/upcoming", w(controllers.GetUpcomingSessions), nil},
+		{"GET", "/sessions/upcoming.ics", controllers.ExportUpcomingSessionsICal, nil},
+
+		// game templates
+		{"GET", "/templates", w(controllers.ListGameTemplates), nil},
+		{"POST", "/templates", w(controllers.CreateGameTemplate), nil},
+		{"GET", "/templates/:id", w(controllers.GetGameTemplate), nil},
+		{"PUT", "/templates/:id", w(controllers.UpdateGameTemplate), nil},
+		{"DELETE", "/templates/:id", w(controllers.DeleteGameTemplate), nil},
+
+		// rounds, phases and the speaker
+		{"POST", "/games/:id/rounds", w(controllers.AdvanceRound), []string{"POST /games/:id/advance-round"}},
+		{"GET", "/games/:id/phase", w(controllers.GetPhase), []string{"GET /games/:id/phase"}},
+		{"POST", "/games/:id/phase", w(controllers.SetPhase), []string{"POST /games/:id/phase"}},
+		{"POST", "/games/:id/speaker", w(controllers.PostAssignSpeaker), []string{"POST /games/:id/speaker"}},
+		{"POST", "/games/:id/speaker/random", w(controllers.RandomiseSpeaker), []string{"POST /game/:id/randomise-speaker"}},
+
+		// objectives
+		{"GET", "/objectives/public", w(controllers.GetAllPublicObjectives), []string{"GET /objectives/public/all"}},
+		{"GET", "/objectives/secret", w(controllers.GetAllSecretObjectives), []string{"GET /objectives/secrets/all"}},
+		{"GET", "/games/:id/objectives", w(controllers.GetGameObjectives), []string{"GET /games/:id/objectives"}},
+		{"POST", "/games/:id/objectives", w(controllers.AssignObjective), []string{"POST /assign_objective"}},
+		{"GET", "/games/:id/objectives/scores", w(controllers.GetObjectiveScoreSummary), []string{"GET /games/:id/objectives/scores"}},
+
+		//scoring
+		{"POST", "/games/:id/scores", w(controllers.AddScore), []string{"POST /score"}},
+		{"DELETE", "/games/:id/scores", w(controllers.DeleteScore), []string{"POST /unscore"}},
+		{"GET", "/games/:id/scores/summary", w(controllers.GetScoreSummary), []string{"GET /games/:id/score-summary"}},
+		{"GET", "/games/:id/scores/by-round", w(controllers.GetScoresByRound), []string{"GET /games/:id/scores-by-round"}},
+		{"POST", "/games/:id/scores/imperial", w(controllers.ScoreImperialPoint), []string{"POST /score/imperial"}},
+		{"POST", "/games/:id/scores/mecatol", w(controllers.ScoreMecatolPoint), []string{"POST /score/mecatol"}},
+		{"POST", "/games/:id/scores/imperial-rider", w(controllers.ScoreImperialRiderPoint), []string{"POST /score/imperial-rider"}},
+		{"GET", "/card-effects", w(controllers.ListCardEffects), []string{"GET /card-effects"}},
+		{"POST", "/card-effects", w(controllers.CreateCardEffect), []string{"POST /card-effects"}},
+		{"POST", "/games/:id/card-effects", w(controllers.RecordCardEffect), []string{"POST /games/:id/card-effects"}},
+		{"GET", "/games/:id/support", w(controllers.GetGameSupport), []string{"GET /games/:id/support"}},
+		{"POST", "/games/:id/support", w(controllers.HandleSupportAction), []string{"POST /games/:id/support"}},
+		{"POST", "/games/:id/support/:player_id", w(controllers.SFTT), []string{"POST /games/:id/support/:player_id"}},
+
+		//agendas
+		{"POST", "/games/:id/agendas/mutiny", controllers.ResolveMutinyAgenda, []string{"POST /agenda/mutiny"}},
+		{"POST", "/games/:id/agendas/political-censure", controllers.HandlePoliticalCensure, []string{"POST /agenda/political-censure"}},
+		{"POST", "/games/:id/agendas/seed-of-empire", controllers.HandleSeedOfEmpire, []string{"POST /agenda/seed"}},
+		{"POST", "/games/:id/agendas/classified-document-leaks", controllers.HandleClassifiedDocumentLeaks, []string{"POST /agenda/classified-document-leaks"}},
+		{"POST", "/games/:id/agendas/incentive-program", controllers.HandleIncentiveProgram, []string{"POST /agenda/incentive-program"}},
+
+		//relics
+		{"GET", "/relics", w(controllers.ListRelics), []string{"GET /relics"}},
+		{"GET", "/games/:id/relics", w(controllers.GetGameRelics), []string{"GET /games/:id/relics"}},
+		{"POST", "/games/:id/relics", w(controllers.HandleRelicAction), []string{"POST /games/:id/relics"}},
+		{"POST", "/games/:id/relics/shard", w(controllers.HandleShardRelic), []string{"POST /relic/shard"}},
+		{"POST", "/games/:id/relics/crown", w(controllers.HandleCrownRelic), []string{"POST /relic/crown"}},
+		{"POST", "/games/:id/relics/obsidian", w(controllers.HandleObsidianRelic), []string{"POST /relic/obsidian"}},
+		{"POST", "/games/:id/relics/latvina", w(controllers.HandleLatvinaRelic), []string{"POST /relic/latvina"}},
+
+		//expose factions to API
+		{"GET", "/factions", w(controllers.GetFactions), []string{"GET /api/factions"}},
+
+		//stats
+		{"GET", "/stats/overview", w(controllers.GetStatsOverview), []string{"GET /stats/overview"}},
+		{"GET", "/stats/objectives/difficulty", w(controllers.GetObjectiveDifficulty), []string{"GET /stats/objectives/difficulty"}},
+		{"GET", "/stats/support", w(controllers.GetSupportStats), []string{"GET /stats/support"}},
+		{"GET", "/stats/card-effects", w(controllers.GetCardEffectStats), []string{"GET /stats/card-effects"}},
+		{"GET", "/stats/clock", w(controllers.GetClockStats), []string{"GET /stats/clock"}},
+		{"GET", "/achievements", w(controllers.GetGlobalAchievements), []string{"GET /achievements"}},
+
+		//export
+		{"GET", "/export/games.csv", controllers.ExportGamesCSV, []string{"GET /export/games.csv"}},
+		{"GET", "/export/scores.csv", controllers.ExportScoresCSV, []string{"GET /export/scores.csv"}},
+		{"GET", "/export/stats.csv", controllers.ExportStatsCSV, []string{"GET /export/stats.csv"}},
+		{"GET", "/export/workbook.xlsx", controllers.ExportWorkbook, []string{"GET /export/workbook.xlsx"}},
+	}
+
+	//turn timer
+	if cfg.Features.TurnTimer {
+		routes = append(routes,
+			route{"GET", "/games/:id/clock", w(controllers.GetGameClock), []string{"GET /games/:id/clock"}},
+			route{"POST", "/games/:id/turns/start", w(controllers.StartTurn), []string{"POST /games/:id/turns/start"}},
+			route{"POST", "/games/:id/turns/pass", w(controllers.PassTurn), []string{"POST /games/:id/turns/pass"}},
+			route{"POST", "/games/:id/turns/end", w(controllers.EndTurn), []string{"POST /games/:id/turns/end"}},
+		)
+	}
+	if cfg.Features.Import {
+		routes = append(routes, route{"POST", "/import/games", w(controllers.ImportGames), []string{"POST /import/games"}})
+	}
+
+	//admin corrections
+	if cfg.Features.Admin {
+		routes = append(routes,
+			route{"POST", "/admin/games/:id/players/:player_id", w(controllers.CorrectGamePlayer), []string{"POST /admin/games/:id/players/:player_id"}},
+			route{"POST", "/admin/games/:id/reopen", w(controllers.ReopenGame), []string{"POST /admin/games/:id/reopen"}},
+			route{"POST", "/admin/games/:id/recompute", w(controllers.RecomputeGameResult), []string{"POST /admin/games/:id/recompute"}},
+			route{"POST", "/admin/scores/:id", w(controllers.CorrectScore), []string{"POST /admin/scores/:id"}},
+			route{"POST", "/admin/backup", w(controllers.CreateBackup), []string{"POST /admin/backup"}},
+			route{"GET", "/admin/backups", w(controllers.ListBackups), []string{"GET /admin/backups"}},
+		)
+	}
+	return routes
+}
+
+// legacyRoutes returns the deprecated aliases in apiRoutes as
+// "METHOD /path".
+func legacyRoutes(cfg config.Config) map[string]bool {
+	aliases := map[string]bool{}
+	for _, rt := range apiRoutes(cfg) {
+		for _, l := range rt.legacy {
+			aliases[l] = true
+		}
+	}
+	return aliases
+}
+
+// deprecated marks a response from a legacy route and links to the /api/v1
+// route that replaces it.
+func deprecated(successor string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		link := successor
+		for _, p := range c.Params {
+			link = strings.Replace(link, ":"+p.Key, p.Value, 1)
+		}
+		c.Header("Deprecation", "true")
+		c.Header("Link", "<"+apispec.SpecPath(link)+`>; rel="successor-version"`)
+		c.Next()
+	}
+}
+
+// newRouter registers the middleware and every route enabled in cfg. It does
+// not touch the database, so the routes can be listed without one.
+func newRouter(cfg config.Config) *gin.Engine {
+	// Setup Gin router
+	r := gin.Default()
+
+	r.Use(func(c *gin.Context) {
+		origin := c.Request.Header.Get("Origin")
+
+		if cfg.Server.OriginAllowed(origin) {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, "+controllers.ClientIDHeader)
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "X-Total-Count, X-Page, X-Page-Size, Deprecation, Link")
+
+		if c.Request.Method == "OPTIONS" {
+			c.AbortWithStatus(204)
+			return
+		}
+
+		c.Next()
+	})
+	if cfg.Features.AuditLog {
+		r.Use(controllers.AuditRequests())
+	}
+	r.Use(controllers.RefreshStatsOnWrite())
+
+	v1 := r.Group(apiV1)
+	for _, rt := range apiRoutes(cfg) {
+		v1.Handle(rt.method, rt.path, rt.handler)
+		for _, l := range rt.legacy {
+			method, path, _ := strings.Cut(l, " ")
+			r.Handle(method, path, deprecated(apiV1+rt.path), rt.handler)
+		}
+	}
+
+	// Serve static frontend files from the build directory
+	indexFile := filepath.Join(cfg.Server.StaticDir, "index.html")
+	r.Static("/static", filepath.Join(cfg.Server.StaticDir, "static")) // serve JS/CSS etc.
+
+	// Serve index.html on root and fallback for SPA routing
+	r.GET("/", func(c *gin.Context) {
+		c.File(indexFile)
+	})
+
+	//swagger
+	if cfg.Features.Swagger {
+		r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	}
+
+	// For any unmatched route (client side routing), serve index.html
+	r.NoRoute(func(c *gin.Context) {
+		// Only serve index.html for paths that are not API routes
+		if !strings.HasPrefix(c.Request.URL.Path, "/api") && !strings.HasPrefix(c.Request.URL.Path, "/games") && !strings.HasPrefix(c.Request.URL.Path, "/players") {
+			c.File(indexFile)
+		} else {
+			c.JSON(http.StatusNotFound, gin.H{"message": "Not Found"})
+		}
+	})
+	return r
+}
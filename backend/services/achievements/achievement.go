@@ -4,8 +4,8 @@ import achievements_helper "github.com/arphillips06/TI4-stats/helpers/achievemen
 
 type Holder struct {
 	PlayerID uint  `json:"player_id"`
-	GameID   *uint `json:"game_id,omitempty"`
-	RoundID  *uint `json:"round_id,omitempty"`
+	GameID   *uint `json:"game_id,omitempty" extensions:"x-nullable"`
+	RoundID  *uint `json:"round_id,omitempty" extensions:"x-nullable"`
 }
 
 type Badge struct {
@@ -16,6 +16,13 @@ type Badge struct {
 	Holders []achievements_helper.Holder `json:"holders"`
 }
 
+// BadgeList is the body of the achievements endpoints. Count is
+// capitalised because the frontend reads it that way.
+type BadgeList struct {
+	Value []Badge `json:"value"`
+	Count int     `json:"Count"`
+}
+
 type intVal struct{ Value *int }
 
 type roundTotal struct {
@@ -0,0 +1,82 @@
+package achievements
+
+import (
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// TestAchievementQueries runs the badge queries on each driver against one
+// finished game: Alice won in 3 rounds, 10 points to Bob's and Carol's 4,
+// and Alice (round 1) and Carol (round 2) each took 4 points in a round.
+func TestAchievementQueries(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T) {
+		game := dbtest.SeedFinishedGame(t)
+		var alice, carol models.Player
+		if err := database.DB.Where("name = ?", "Alice").First(&alice).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := database.DB.Where("name = ?", "Carol").First(&carol).Error; err != nil {
+			t.Fatal(err)
+		}
+
+		gameBadges, err := ComputeGameAchievements(database.DB, game.ID)
+		if err != nil {
+			t.Fatalf("ComputeGameAchievements: %v", err)
+		}
+		globalBadges, err := ComputeGlobalAchievements(database.DB)
+		if err != nil {
+			t.Fatalf("ComputeGlobalAchievements: %v", err)
+		}
+
+		tests := []struct {
+			badges  []Badge
+			key     string
+			value   int
+			holders []uint
+		}{
+			{gameBadges, "fastest_win", 3, []uint{alice.ID}},
+			{gameBadges, "most_points_in_round", 4, []uint{alice.ID, carol.ID}},
+			{gameBadges, "largest_win_margin", 6, []uint{alice.ID}},
+			{globalBadges, "fastest_win", 3, []uint{alice.ID}},
+			{globalBadges, "most_points_in_round", 4, []uint{alice.ID, carol.ID}},
+			{globalBadges, "largest_win_margin", 6, []uint{alice.ID}},
+			{globalBadges, "current_winning_streak", 1, []uint{alice.ID}},
+			{globalBadges, "longest_winning_streak", 1, []uint{alice.ID}},
+		}
+		for _, tt := range tests {
+			b, ok := findBadge(tt.badges, tt.key)
+			if !ok {
+				t.Errorf("no %s badge in %+v", tt.key, tt.badges)
+				continue
+			}
+			if b.Value != tt.value {
+				t.Errorf("%s = %d, want %d", tt.key, b.Value, tt.value)
+			}
+			holders := map[uint]bool{}
+			for _, h := range b.Holders {
+				holders[h.PlayerID] = true
+			}
+			if len(holders) != len(tt.holders) {
+				t.Errorf("%s holders = %+v, want players %v", tt.key, b.Holders, tt.holders)
+				continue
+			}
+			for _, id := range tt.holders {
+				if !holders[id] {
+					t.Errorf("%s holders = %+v, want players %v", tt.key, b.Holders, tt.holders)
+				}
+			}
+		}
+	})
+}
+
+func findBadge(badges []Badge, key string) (Badge, bool) {
+	for _, b := range badges {
+		if b.Key == key {
+			return b, true
+		}
+	}
+	return Badge{}, false
+}
@@ -115,7 +115,7 @@ func getGameBestRoundTotals(db *gorm.DB, gameID uint) ([]roundTotal, error) {
 		Select("scores.player_id, r.number AS round_id, SUM(scores.points) AS total").
 		Joins("JOIN games ON games.id = scores.game_id").
 		Joins("JOIN rounds r ON r.id = scores.round_id").
-		Where("scores.game_id = ? AND games.partial = FALSE", gameID).
+		Where("scores.game_id = ? AND games.partial = ?", gameID, false).
 		Group("scores.player_id, r.number").
 		Having("SUM(scores.points) IS NOT NULL").
 		Order("total DESC").
@@ -130,7 +130,7 @@ func getAllTimeMaxRoundPoints(db *gorm.DB) (*int, error) {
 		Select("scores.game_id, scores.player_id, r.number AS round_id, SUM(scores.points) AS total").
 		Joins("JOIN games ON games.id = scores.game_id").
 		Joins("JOIN rounds r ON r.id = scores.round_id").
-		Where("games.partial = FALSE AND games.finished_at IS NOT NULL").
+		Where("games.partial = ? AND games.finished_at IS NOT NULL", false).
 		Group("scores.game_id, scores.player_id, r.number")
 
 	var rec intVal
@@ -184,7 +184,7 @@ func getGameFinalTotals(db *gorm.DB, gameID uint) ([]playerTotal, error) {
 	if err := db.Model(&models.Score{}).
 		Select("scores.player_id, SUM(scores.points) AS total").
 		Joins("JOIN games ON games.id = scores.game_id").
-		Where("scores.game_id = ? AND games.partial = FALSE", gameID).
+		Where("scores.game_id = ? AND games.partial = ?", gameID, false).
 		Group("scores.player_id").
 		Having("SUM(scores.points) IS NOT NULL").
 		Order("total DESC").
@@ -204,7 +204,7 @@ func getAllTimeMaxWinningMargin(db *gorm.DB) (*int, error) {
 	if err := db.Model(&models.Score{}).
 		Select("scores.game_id, scores.player_id, SUM(scores.points) AS total").
 		Joins("JOIN games ON games.id = scores.game_id").
-		Where("games.partial = FALSE AND games.finished_at IS NOT NULL").
+		Where("games.partial = ? AND games.finished_at IS NOT NULL", false).
 		Group("scores.game_id, scores.player_id").
 		Having("SUM(scores.points) IS NOT NULL").
 		Order("scores.game_id ASC, total DESC").
@@ -105,7 +105,7 @@ func globalMostPointsInRound(db *gorm.DB) (Badge, bool, error) {
 		Select("scores.game_id, scores.player_id, r.number AS round_id, SUM(scores.points) AS total").
 		Joins("JOIN games ON games.id = scores.game_id").
 		Joins("JOIN rounds r ON r.id = scores.round_id").
-		Where("games.partial = FALSE AND games.finished_at IS NOT NULL").
+		Where("games.partial = ? AND games.finished_at IS NOT NULL", false).
 		Group("scores.game_id, scores.player_id, r.number")
 
 	var max struct{ Value *int }
@@ -160,7 +160,7 @@ func globalLargestWinMargin(db *gorm.DB) (Badge, bool, error) {
 	if err := db.Model(&models.Score{}).
 		Select("scores.game_id, scores.player_id, SUM(scores.points) AS total").
 		Joins("JOIN games ON games.id = scores.game_id").
-		Where("games.partial = FALSE AND games.finished_at IS NOT NULL").
+		Where("games.partial = ? AND games.finished_at IS NOT NULL", false).
 		Group("scores.game_id, scores.player_id").
 		Having("SUM(scores.points) IS NOT NULL").
 		Order("scores.game_id ASC, total DESC").
@@ -245,7 +245,7 @@ func globalLargestWinMargin(db *gorm.DB) (Badge, bool, error) {
 func globalComebackKid(db *gorm.DB) (Badge, bool, error) {
 	var games []models.Game
 	if err := db.
-		Where("partial = FALSE AND finished_at IS NOT NULL").
+		Where("partial = ? AND finished_at IS NOT NULL", false).
 		Preload("GamePlayers.Player").
 		Preload("Rounds.Scores").
 		Find(&games).Error; err != nil {
@@ -359,7 +359,7 @@ func globalCurrentWinningStreak(db *gorm.DB) (Badge, bool, error) {
 	err := db.Table("game_players gp").
 		Select("gp.player_id, g.id as game_id, g.finished_at, gp.won").
 		Joins("JOIN games g ON g.id = gp.game_id").
-		Where("g.partial = FALSE AND g.finished_at IS NOT NULL").
+		Where("g.partial = ? AND g.finished_at IS NOT NULL", false).
 		Order("g.finished_at DESC").
 		Scan(&lastPlays).Error
 	if err != nil {
@@ -381,7 +381,7 @@ func globalCurrentWinningStreak(db *gorm.DB) (Badge, bool, error) {
 		if err := db.Table("game_players gp").
 			Select("gp.won").
 			Joins("JOIN games g ON g.id = gp.game_id").
-			Where("gp.player_id = ? AND g.partial = FALSE AND g.finished_at IS NOT NULL", lp.PlayerID).
+			Where("gp.player_id = ? AND g.partial = ? AND g.finished_at IS NOT NULL", lp.PlayerID, false).
 			Order("g.finished_at DESC").
 			Scan(&wins).Error; err != nil {
 			return Badge{}, false, err
@@ -435,7 +435,7 @@ func globalLongestWinningStreak(db *gorm.DB) (Badge, bool, error) {
 	if err := db.Table("game_players gp").
 		Select("gp.player_id, gp.won, g.finished_at").
 		Joins("JOIN games g ON g.id = gp.game_id").
-		Where("g.partial = FALSE AND g.finished_at IS NOT NULL").
+		Where("g.partial = ? AND g.finished_at IS NOT NULL", false).
 		Order("gp.player_id, g.finished_at").
 		Scan(&rows).Error; err != nil {
 		return Badge{}, false, err
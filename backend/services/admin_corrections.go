@@ -0,0 +1,330 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+func requireReason(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return handle.Invalid("reason", "is required")
+	}
+	return nil
+}
+
+// CorrectGamePlayerFaction changes the faction a player had in a game,
+// finished or not.
+func CorrectGamePlayerFaction(ctx context.Context, gameID, playerID uint, req models.CorrectGamePlayerRequest) (models.GamePlayer, error) {
+	var gp models.GamePlayer
+	if err := requireReason(req.Reason); err != nil {
+		return gp, err
+	}
+	faction, err := ResolveFaction(req.Faction)
+	if err != nil {
+		return gp, handle.Rule("%v", err)
+	}
+
+	err = database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("game_id = ? AND player_id = ?", gameID, playerID).First(&gp).Error; err != nil {
+			return err
+		}
+		var taken int64
+		if err := tx.Model(&models.GamePlayer{}).
+			Where("game_id = ? AND player_id <> ? AND faction = ?", gameID, playerID, faction.Name).
+			Count(&taken).Error; err != nil {
+			return err
+		}
+		if taken > 0 {
+			return handle.Rule("%s is already played by someone else in this game", faction.Name)
+		}
+
+		before := gamePlayerFaction(gp)
+		gp.Faction = faction.Name
+		gp.FactionID = &faction.ID
+		if err := tx.Model(&gp).Updates(map[string]any{"faction": gp.Faction, "faction_id": gp.FactionID}).Error; err != nil {
+			return err
+		}
+		return recordAudit(tx, models.AuditLog{
+			GameID:   &gameID,
+			Action:   "correct_faction",
+			Entity:   "game_player",
+			EntityID: gp.ID,
+			Reason:   req.Reason,
+		}, before, gamePlayerFaction(gp))
+	})
+	if err != nil {
+		return gp, err
+	}
+
+	return gp, nil
+}
+
+// CorrectScore moves a score to another player or round, or changes its
+// points. The game result is not recomputed; call RecomputeGameResult
+// afterwards if the winner may have changed.
+func CorrectScore(ctx context.Context, scoreID uint, req models.CorrectScoreRequest) (models.Score, error) {
+	var score models.Score
+	if err := requireReason(req.Reason); err != nil {
+		return score, err
+	}
+	if req.PlayerID == nil && req.Round == nil && req.Points == nil {
+		return score, handle.Rule("nothing to change: set player_id, round or points")
+	}
+
+	if err := database.DB.WithContext(ctx).First(&score, scoreID).Error; err != nil {
+		return score, err
+	}
+	if req.PlayerID != nil {
+		if err := requirePlayerInGame(score.GameID, *req.PlayerID, "player_id"); err != nil {
+			return score, err
+		}
+	}
+
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&score, scoreID).Error; err != nil {
+			return err
+		}
+		before := scoreAttribution(score)
+
+		if req.PlayerID != nil {
+			// Only objective scores are one per player; the others have no
+			// objective and a player may hold any number of them.
+			if *req.PlayerID != score.PlayerID && score.ObjectiveID != 0 {
+				var held int64
+				if err := tx.Model(&models.Score{}).
+					Where("game_id = ? AND player_id = ? AND objective_id = ?", score.GameID, *req.PlayerID, score.ObjectiveID).
+					Count(&held).Error; err != nil {
+					return err
+				}
+				if held > 0 {
+					return handle.Rule("%w", ErrScoreExists)
+				}
+			}
+			score.PlayerID = *req.PlayerID
+		}
+		if req.Round != nil {
+			var round models.Round
+			err := tx.Where("game_id = ? AND number = ?", score.GameID, *req.Round).First(&round).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return handle.Rule("game %d has no round %d", score.GameID, *req.Round)
+			}
+			if err != nil {
+				return err
+			}
+			score.RoundID = round.ID
+		}
+		if req.Points != nil {
+			score.Points = *req.Points
+		}
+
+		if err := tx.Model(&score).Updates(map[string]any{
+			"player_id": score.PlayerID,
+			"round_id":  score.RoundID,
+			"points":    score.Points,
+		}).Error; err != nil {
+			return err
+		}
+		return recordAudit(tx, models.AuditLog{
+			GameID:   &score.GameID,
+			Action:   "correct_score",
+			Entity:   "score",
+			EntityID: score.ID,
+			Reason:   req.Reason,
+		}, before, scoreAttribution(score))
+	})
+	if err != nil {
+		return score, err
+	}
+
+	return score, nil
+}
+
+// ReopenGame clears the result of a finished game so play can continue. A
+// game timed by sessions starts its next one, as finishing ended the last.
+func ReopenGame(ctx context.Context, gameID uint, reason string) (models.Game, error) {
+	var game models.Game
+	if err := requireReason(reason); err != nil {
+		return game, err
+	}
+
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&game, gameID).Error; err != nil {
+			return err
+		}
+		if game.FinishedAt == nil {
+			return handle.Rule("game %d is not finished", gameID)
+		}
+		before := gameResult(game)
+
+		if err := tx.Model(&game).Updates(map[string]any{"finished_at": nil, "winner_id": nil}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.GamePlayer{}).Where("game_id = ?", gameID).Update("won", false).Error; err != nil {
+			return err
+		}
+		// Play carries on in the round the game ended in.
+		if err := tx.Model(&models.Round{}).
+			Where("game_id = ? AND number = ?", gameID, game.CurrentRound).
+			Update("ended_at", nil).Error; err != nil {
+			return err
+		}
+		game.FinishedAt = nil
+		game.WinnerID = nil
+
+		if paused, err := isPaused(tx, gameID); err != nil {
+			return err
+		} else if paused {
+			if _, err := startNextSession(tx, game, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		return recordAudit(tx, models.AuditLog{
+			GameID:   &gameID,
+			Action:   "reopen_game",
+			Entity:   "game",
+			EntityID: gameID,
+			Reason:   reason,
+		}, before, gameResult(game))
+	})
+	if err != nil {
+		return game, err
+	}
+
+	return game, nil
+}
+
+// RecomputeGameResult works out WinnerID, Won and FinishedAt again from the
+// scores. A player at or over the winning points wins; a finished game with
+// nobody there goes to the highest total. Ties must be settled with
+// WinnerID, which also overrides the scores outright.
+func RecomputeGameResult(ctx context.Context, gameID uint, req models.RecomputeResultRequest) (models.Game, error) {
+	var game models.Game
+	if err := requireReason(req.Reason); err != nil {
+		return game, err
+	}
+	if req.WinnerID != nil {
+		if err := requirePlayerInGame(gameID, *req.WinnerID, "winner_id"); err != nil {
+			return game, err
+		}
+	}
+
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&game, gameID).Error; err != nil {
+			return err
+		}
+		before := gameResult(game)
+
+		winnerID, err := resultWinner(tx, game, req.WinnerID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case winnerID != nil && game.FinishedAt == nil:
+			now := time.Now()
+			game.FinishedAt = &now
+			if err := stopGameClock(tx, &game, now); err != nil {
+				return err
+			}
+			if err := endRunningSession(tx, game.ID, now); err != nil {
+				return err
+			}
+		case winnerID == nil:
+			game.FinishedAt = nil
+		}
+		game.WinnerID = winnerID
+
+		if err := tx.Model(&game).Updates(map[string]any{
+			"finished_at": game.FinishedAt,
+			"winner_id":   game.WinnerID,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.GamePlayer{}).Where("game_id = ?", gameID).Update("won", false).Error; err != nil {
+			return err
+		}
+		if winnerID != nil {
+			if err := tx.Model(&models.GamePlayer{}).
+				Where("game_id = ? AND player_id = ?", gameID, *winnerID).
+				Update("won", true).Error; err != nil {
+				return err
+			}
+		}
+
+		return recordAudit(tx, models.AuditLog{
+			GameID:   &gameID,
+			Action:   "recompute_result",
+			Entity:   "game",
+			EntityID: gameID,
+			Reason:   req.Reason,
+		}, before, gameResult(game))
+	})
+	if err != nil {
+		return game, err
+	}
+
+	return game, nil
+}
+
+func resultWinner(tx *gorm.DB, game models.Game, override *uint) (*uint, error) {
+	if override != nil {
+		return override, nil
+	}
+
+	var totals []struct {
+		PlayerID uint
+		Points   int
+	}
+	if err := tx.Table("game_players AS gp").
+		Select("gp.player_id, COALESCE(SUM(s.points), 0) AS points").
+		Joins("LEFT JOIN scores s ON s.game_id = gp.game_id AND s.player_id = gp.player_id").
+		Where("gp.game_id = ?", game.ID).
+		Group("gp.player_id").
+		Order("points DESC").
+		Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+	if len(totals) == 0 {
+		return nil, nil
+	}
+
+	top := totals[0]
+	if top.Points < game.WinningPoints && game.FinishedAt == nil {
+		return nil, nil
+	}
+	if len(totals) > 1 && totals[1].Points == top.Points {
+		return nil, handle.Rule("players %d and %d are tied on %d points; pass winner_id", top.PlayerID, totals[1].PlayerID, top.Points)
+	}
+	return &top.PlayerID, nil
+}
+
+// gameResult is the part of a game that corrections to its result change.
+func gameResult(g models.Game) map[string]any {
+	return map[string]any{
+		"finished_at": g.FinishedAt,
+		"winner_id":   g.WinnerID,
+	}
+}
+
+func gamePlayerFaction(gp models.GamePlayer) map[string]any {
+	return map[string]any{
+		"faction":    gp.Faction,
+		"faction_id": gp.FactionID,
+	}
+}
+
+func scoreAttribution(s models.Score) map[string]any {
+	return map[string]any{
+		"player_id": s.PlayerID,
+		"round_id":  s.RoundID,
+		"points":    s.Points,
+	}
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+func TestCorrectScorePlayer(t *testing.T) {
+	dbtest.Open(t, "sqlite")
+	game := dbtest.SeedFinishedGame(t)
+	ctx := context.Background()
+
+	var carol models.Player
+	if err := database.DB.Where("name = ?", "Carol").First(&carol).Error; err != nil {
+		t.Fatal(err)
+	}
+	imperial := models.Score{GameID: game.ID, PlayerID: carol.ID, Points: 1, Type: models.ScoreTypeImperial}
+	if err := database.DB.Create(&imperial).Error; err != nil {
+		t.Fatal(err)
+	}
+	var custodians, carolsObjective, alicesCopy models.Score
+	if err := database.DB.Where("game_id = ? AND type = ?", game.ID, models.ScoreTypeMecatol).First(&custodians).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := database.DB.Where("game_id = ? AND player_id = ? AND objective_id <> 0", game.ID, carol.ID).First(&carolsObjective).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := database.DB.Where("game_id = ? AND player_id <> ? AND objective_id = ?", game.ID, carol.ID, carolsObjective.ObjectiveID).First(&alicesCopy).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// Carol already has an Imperial point, which has no objective either.
+	moved, err := CorrectScore(ctx, custodians.ID, models.CorrectScoreRequest{PlayerID: &carol.ID, Reason: "wrong player"})
+	if err != nil {
+		t.Fatalf("moving the Custodians point: %v", err)
+	}
+	if moved.PlayerID != carol.ID {
+		t.Errorf("Custodians point is with player %d, want %d", moved.PlayerID, carol.ID)
+	}
+
+	_, err = CorrectScore(ctx, alicesCopy.ID, models.CorrectScoreRequest{PlayerID: &carol.ID, Reason: "wrong player"})
+	if !errors.Is(err, ErrScoreExists) {
+		t.Errorf("moving an objective to a player who holds it: got %v, want ErrScoreExists", err)
+	}
+}
+
+func TestReopenGameStartsSession(t *testing.T) {
+	dbtest.Open(t, "sqlite")
+	ctx := context.Background()
+
+	// The seeded game was recorded without sessions and stays that way.
+	untimed := dbtest.SeedFinishedGame(t)
+	if _, err := ReopenGame(ctx, untimed.ID, "finished too early"); err != nil {
+		t.Fatalf("ReopenGame: %v", err)
+	}
+	var sessions int64
+	if err := database.DB.Model(&models.GameSession{}).Where("game_id = ?", untimed.ID).Count(&sessions).Error; err != nil {
+		t.Fatal(err)
+	}
+	if sessions != 0 {
+		t.Errorf("reopening a game without sessions started %d", sessions)
+	}
+
+	timed := untimed
+	timed.ID, timed.GameNumber = 0, 2
+	if err := database.DB.Create(&timed).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := database.DB.Create(&models.GameSession{GameID: timed.ID, StartedAt: &timed.CreatedAt, EndedAt: timed.FinishedAt}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReopenGame(ctx, timed.ID, "finished too early"); err != nil {
+		t.Fatalf("ReopenGame: %v", err)
+	}
+	if paused, err := isPaused(database.DB, timed.ID); err != nil || paused {
+		t.Errorf("reopened game is paused (%v), want a running session", err)
+	}
+	if _, err := runningSession(database.DB, timed.ID); err != nil {
+		t.Errorf("reopened game has no running session: %v", err)
+	}
+}
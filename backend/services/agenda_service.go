@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -16,21 +17,38 @@ const (
 
 // ApplyPoliticalCensure adjusts agenda score based on whether the player was censured or not.
 // If Gained is false, a point is removed.
-func ApplyPoliticalCensure(input models.PoliticalCensureRequest) error {
+func ApplyPoliticalCensure(ctx context.Context, input models.PoliticalCensureRequest) error {
+	if err := RequirePhase(input.GameID, "resolving Political Censure", models.PhaseAgenda); err != nil {
+		return err
+	}
+	if err := requirePlayerInGame(input.GameID, input.PlayerID, "player_id"); err != nil {
+		return err
+	}
+	roundID, err := resolveRound(input.GameID, input.RoundID)
+	if err != nil {
+		return err
+	}
 	points := 1
 	if !input.Gained {
 		points = -1
 	}
 
-	return helpers.CreateAgendaScore(int(input.GameID), int(input.RoundID), int(input.PlayerID), points, models.AgendaCensure, 0)
+	return helpers.CreateAgendaScore(ctx, int(input.GameID), int(roundID), int(input.PlayerID), points, models.AgendaCensure, 0)
 }
 
 // ApplySeedOfEmpire awards 1 point to the player with most (or fewest) points depending on the vote result.
 // Ties are handled by awarding all tied players.
-func ApplySeedOfEmpire(input models.SeedOfEmpireResolution) error {
+func ApplySeedOfEmpire(ctx context.Context, input models.SeedOfEmpireResolution) error {
+	if err := RequirePhase(input.GameID, "resolving Seed of an Empire", models.PhaseAgenda); err != nil {
+		return err
+	}
+	roundID, err := resolveRound(input.GameID, input.RoundID)
+	if err != nil {
+		return err
+	}
 	// Step 1: Get all players in the game
 	var gamePlayers []models.GamePlayer
-	if err := database.DB.Where("game_id = ?", input.GameID).Find(&gamePlayers).Error; err != nil {
+	if err := database.DB.WithContext(ctx).Where("game_id = ?", input.GameID).Find(&gamePlayers).Error; err != nil {
 		return err
 	}
 
@@ -74,7 +92,7 @@ func ApplySeedOfEmpire(input models.SeedOfEmpireResolution) error {
 	}
 
 	for _, id := range targetPlayerIDs {
-		if err := helpers.CreateAgendaScore(int(input.GameID), int(input.RoundID), int(id), 1, models.AgendaSeed, 0); err != nil {
+		if err := helpers.CreateAgendaScore(ctx, int(input.GameID), int(roundID), int(id), 1, models.AgendaSeed, 0); err != nil {
 			return err
 		}
 	}
@@ -83,7 +101,10 @@ func ApplySeedOfEmpire(input models.SeedOfEmpireResolution) error {
 }
 
 // ApplyMutinyAgenda awards or removes points based on the Mutiny agenda result.
-func ApplyMutinyAgenda(input models.AgendaResolution) error {
+func ApplyMutinyAgenda(ctx context.Context, input models.AgendaResolution) error {
+	if err := RequirePhase(input.GameID, "resolving Mutiny", models.PhaseAgenda); err != nil {
+		return err
+	}
 	exists, err := helpers.AgendaAlreadyResolved(input.GameID, models.AgendaMutiny)
 	if err != nil {
 		return err
@@ -91,11 +112,20 @@ func ApplyMutinyAgenda(input models.AgendaResolution) error {
 	if exists {
 		return fmt.Errorf("mutiny has already been resolved for this game")
 	}
+	roundID, err := resolveRound(input.GameID, input.RoundID)
+	if err != nil {
+		return err
+	}
+	for i, playerID := range input.ForVotes {
+		if err := requirePlayerInGame(input.GameID, playerID, fmt.Sprintf("for_votes[%d]", i)); err != nil {
+			return err
+		}
+	}
 
 	switch input.Result {
 	case "for":
 		for _, playerID := range input.ForVotes {
-			if err := helpers.CreateAgendaScore(int(input.GameID), int(input.RoundID), int(playerID), 1, models.AgendaMutiny, 0); err != nil {
+			if err := helpers.CreateAgendaScore(ctx, int(input.GameID), int(roundID), int(playerID), 1, models.AgendaMutiny, 0); err != nil {
 				return err
 			}
 		}
@@ -106,13 +136,13 @@ func ApplyMutinyAgenda(input models.AgendaResolution) error {
 				return err
 			}
 			if total > 0 {
-				if err := helpers.CreateAgendaScore(int(input.GameID), int(input.RoundID), int(playerID), -1, models.AgendaMutiny, 0); err != nil {
+				if err := helpers.CreateAgendaScore(ctx, int(input.GameID), int(roundID), int(playerID), -1, models.AgendaMutiny, 0); err != nil {
 					return err
 				}
 			}
 		}
 	default:
-		return helpers.CreateAgendaScore(int(input.GameID), int(input.RoundID), 0, 0, models.AgendaMutiny, 0)
+		return helpers.CreateAgendaScore(ctx, int(input.GameID), int(roundID), 0, 0, models.AgendaMutiny, 0)
 	}
 
 	return nil
@@ -120,7 +150,11 @@ func ApplyMutinyAgenda(input models.AgendaResolution) error {
 
 // This converts the scored secret objective to a public one.
 // It also marks that it was originally secret, and records that CDL was used.
-func ApplyClassifiedDocumentLeaks(input models.ClassifiedDocumentLeaksRequest) error {
+func ApplyClassifiedDocumentLeaks(ctx context.Context, input models.ClassifiedDocumentLeaksRequest) error {
+	db := database.DB.WithContext(ctx)
+	if err := RequirePhase(input.GameID, "resolving Classified Document Leaks", models.PhaseAgenda); err != nil {
+		return err
+	}
 	exists, err := helpers.AgendaAlreadyResolved(input.GameID, models.AgendaCDL)
 	if err != nil {
 		return err
@@ -128,10 +162,17 @@ func ApplyClassifiedDocumentLeaks(input models.ClassifiedDocumentLeaksRequest) e
 	if exists {
 		return fmt.Errorf("classified Document Leaks has already been resolved for this game")
 	}
+	if err := requirePlayerInGame(input.GameID, input.PlayerID, "player_id"); err != nil {
+		return err
+	}
+	roundID, err := resolveRound(input.GameID, input.RoundID)
+	if err != nil {
+		return err
+	}
 
 	// Locate the secret score
 	var score models.Score
-	err = database.DB.
+	err = db.
 		Where("game_id = ? AND player_id = ? AND objective_id = ? AND type = ?", input.GameID, input.PlayerID, input.ObjectiveID, models.ScoreTypeSecret).
 		First(&score).Error
 	if err != nil {
@@ -144,13 +185,14 @@ func ApplyClassifiedDocumentLeaks(input models.ClassifiedDocumentLeaksRequest) e
 	// Update the score to public
 	score.Type = models.ScoreTypePublic
 	score.OriginallySecret = true
-	if err := database.DB.Save(&score).Error; err != nil {
+	if err := db.Save(&score).Error; err != nil {
 		return err
 	}
 
 	return helpers.CreateAgendaScore(
+		ctx,
 		int(input.GameID),
-		int(input.RoundID),
+		int(roundID),
 		int(input.PlayerID),
 		0,
 		models.AgendaCDL,
@@ -160,11 +202,15 @@ func ApplyClassifiedDocumentLeaks(input models.ClassifiedDocumentLeaksRequest) e
 
 // Incentive Program reveals the next unrevealed Stage I/II objective
 // depending on the vote outcome: "for" → Stage I, "against" → Stage II
-func ApplyIncentiveProgramEffect(gameID uint, outcome string) error {
+func ApplyIncentiveProgramEffect(ctx context.Context, gameID uint, outcome string) error {
+	db := database.DB.WithContext(ctx)
 	game, err := helpers.GetUnfinishedGame(gameID)
 	if err != nil {
 		return err // handles both not found and already finished
 	}
+	if err := RequirePhase(gameID, "resolving Incentive Program", models.PhaseAgenda); err != nil {
+		return err
+	}
 
 	if !game.UseObjectiveDecks {
 		return nil // Manual mode: do nothing, admin will assign
@@ -181,7 +227,7 @@ func ApplyIncentiveProgramEffect(gameID uint, outcome string) error {
 	}
 
 	var existingObjectiveIDs []uint
-	if err := database.DB.
+	if err := db.
 		Model(&models.GameObjective{}).
 		Where("game_id = ?", gameID).
 		Pluck("objective_id", &existingObjectiveIDs).Error; err != nil {
@@ -189,7 +235,7 @@ func ApplyIncentiveProgramEffect(gameID uint, outcome string) error {
 	}
 
 	var newObjective models.Objective
-	err = database.DB.
+	err = db.
 		Where("stage = ? AND id NOT IN ?", stage, existingObjectiveIDs).
 		Order("id").
 		First(&newObjective).Error
@@ -205,10 +251,10 @@ func ApplyIncentiveProgramEffect(gameID uint, outcome string) error {
 		RoundID:     0,
 		Revealed:    true,
 	}
-	if err := database.DB.Create(&gameObj).Error; err != nil {
+	if err := db.Create(&gameObj).Error; err != nil {
 		return err
 	}
 
-	return helpers.CreateAgendaScore(int(gameID), 0, 0, 0, models.AgendaIncentive, 0)
+	return helpers.CreateAgendaScore(ctx, int(gameID), 0, 0, 0, models.AgendaIncentive, 0)
 
 }
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"encoding/json"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/helpers"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+// recordAudit writes an audit entry in tx, storing before and after as JSON.
+func recordAudit(tx *gorm.DB, entry models.AuditLog, before, after any) error {
+	if before != nil {
+		b, err := json.Marshal(before)
+		if err != nil {
+			return err
+		}
+		entry.Before = string(b)
+	}
+	if after != nil {
+		b, err := json.Marshal(after)
+		if err != nil {
+			return err
+		}
+		entry.After = string(b)
+	}
+	return tx.Create(&entry).Error
+}
+
+// RecordRequestAudit writes the audit entry for a write request.
+func RecordRequestAudit(entry models.AuditLog) error {
+	entry.Action = models.AuditActionRequest
+	return database.DB.Create(&entry).Error
+}
+
+// ListGameAudit returns a game's audit entries, newest first, along with
+// the total number of entries.
+func ListGameAudit(gameID uint, page helpers.Page) ([]models.AuditLog, int64, error) {
+	if err := database.DB.Select("id").First(&models.Game{}, gameID).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := database.DB.Model(&models.AuditLog{}).Where("game_id = ?", gameID)
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []models.AuditLog
+	err := page.Apply(query.Order("created_at DESC, id DESC")).Find(&entries).Error
+	return entries, total, err
+}
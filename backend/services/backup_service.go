@@ -0,0 +1,40 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/config"
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// CreateBackup backs up the database into the configured backup directory
+// and rotates old backups.
+func CreateBackup() (models.Backup, error) {
+	cfg := config.Current().Backup
+	return database.Backup(cfg.Dir, cfg.Keep)
+}
+
+// ListBackups returns the backups in the configured directory, newest first.
+func ListBackups() ([]models.Backup, error) {
+	return database.ListBackups(config.Current().Backup.Dir)
+}
+
+// RunScheduledBackups takes a backup every interval until the process
+// exits. Failures are logged and the next backup is still attempted.
+func RunScheduledBackups(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		backup, err := CreateBackup()
+		if err != nil {
+			log.Printf("Scheduled backup failed: %v", err)
+			continue
+		}
+		log.Printf("Scheduled backup written to %s (%d bytes)", backup.File, backup.Size)
+		for _, file := range backup.Removed {
+			log.Printf("Removed old backup %s", file)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers"
+	"github.com/arphillips06/TI4-stats/helpers/stats"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+var cardKinds = []string{models.CardKindActionCard, models.CardKindAbility, models.CardKindPromissory}
+
+// ListCardEffects returns the card effect registry, seeded cards and
+// homebrew alike.
+func ListCardEffects() ([]models.CardEffect, error) {
+	var out []models.CardEffect
+	err := database.DB.Order("name").Find(&out).Error
+	return out, err
+}
+
+// FindCardEffect looks a card up by name, case-insensitively.
+func FindCardEffect(name string) (models.CardEffect, error) {
+	name = strings.TrimSpace(name)
+	var card models.CardEffect
+	err := database.DB.Where("LOWER(name) = ?", strings.ToLower(name)).First(&card).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return card, handle.Rule("unknown card %q", name)
+	}
+	return card, err
+}
+
+// CreateCardEffect adds a homebrew card to the registry.
+func CreateCardEffect(ctx context.Context, card models.CardEffect) (models.CardEffect, error) {
+	card.ID = 0
+	card.Name = strings.TrimSpace(card.Name)
+	card.Homebrew = true
+	if card.Name == "" {
+		return card, handle.Invalid("name", "is required")
+	}
+	if card.Kind == "" {
+		card.Kind = models.CardKindActionCard
+	}
+	if !slices.Contains(cardKinds, card.Kind) {
+		return card, handle.Invalid("kind", "%q is not one of %s", card.Kind, strings.Join(cardKinds, ", "))
+	}
+	if _, err := FindCardEffect(card.Name); err == nil {
+		return card, handle.Rule("%s is already in the registry", card.Name)
+	}
+
+	err := database.DB.WithContext(ctx).Create(&card).Error
+	return card, err
+}
+
+// RecordCardEffect scores a card for a player in a round. The points come
+// from the registry unless the request overrides them.
+func RecordCardEffect(ctx context.Context, gameID uint, req models.CardEffectRequest) error {
+	card, err := FindCardEffect(req.Card)
+	if err != nil {
+		return err
+	}
+	points := card.VictoryPoints
+	if req.Points != nil {
+		points = *req.Points
+	}
+	if points == 0 {
+		return handle.Rule("%s does not change victory points; pass points to record it", card.Name)
+	}
+
+	game, err := helpers.GetUnfinishedGame(gameID)
+	if err != nil {
+		return err
+	}
+	if err := requirePlayerInGame(gameID, req.PlayerID, "player_id"); err != nil {
+		return err
+	}
+	roundID, err := resolveRound(gameID, req.RoundID)
+	if err != nil {
+		return err
+	}
+
+	if err := helpers.CreateGenericScore(ctx, models.Score{
+		GameID:    gameID,
+		RoundID:   roundID,
+		PlayerID:  req.PlayerID,
+		Points:    points,
+		Type:      models.ScoreTypeActionCard,
+		CardTitle: card.Name,
+	}); err != nil {
+		return err
+	}
+
+	if points > 0 {
+		return MaybeFinishGameFromScore(ctx, game, req.PlayerID)
+	}
+	return nil
+}
+
+// GetCardEffectStats returns how often each card has been recorded and the
+// points it has been worth.
+func GetCardEffectStats() ([]models.CardEffectStats, error) {
+	return stats.CalculateCardEffectStats()
+}
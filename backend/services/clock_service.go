@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers/stats"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+func turnPhase(phase string) (string, error) {
+	phase = strings.ToLower(strings.TrimSpace(phase))
+	if phase == "" {
+		return "", nil
+	}
+	if !slices.Contains(models.Phases, phase) {
+		return "", handle.Invalid("phase", "%q is not one of %s", phase, strings.Join(models.Phases, ", "))
+	}
+	return phase, nil
+}
+
+func runningGame(tx *gorm.DB, gameID uint) (models.Game, error) {
+	var game models.Game
+	if err := tx.First(&game, gameID).Error; err != nil {
+		return game, err
+	}
+	if game.FinishedAt != nil {
+		return game, handle.Rule("game %d is finished", gameID)
+	}
+	if paused, err := isPaused(tx, gameID); err != nil {
+		return game, err
+	} else if paused {
+		return game, handle.Rule("game %d is paused", gameID)
+	}
+	return game, nil
+}
+
+func currentRound(tx *gorm.DB, game models.Game) (models.Round, error) {
+	var round models.Round
+	err := tx.Where("game_id = ? AND number = ?", game.ID, game.CurrentRound).First(&round).Error
+	return round, err
+}
+
+// stopRunningTurn ends the game's running turn, if there is one.
+func stopRunningTurn(tx *gorm.DB, gameID uint, now time.Time) (bool, error) {
+	res := tx.Model(&models.Turn{}).
+		Where("game_id = ? AND ended_at IS NULL", gameID).
+		Update("ended_at", now)
+	return res.RowsAffected > 0, res.Error
+}
+
+// stopGameClock ends the running turn and the current round, when a round
+// is advanced or the game finishes.
+func stopGameClock(tx *gorm.DB, game *models.Game, now time.Time) error {
+	if _, err := stopRunningTurn(tx, game.ID, now); err != nil {
+		return err
+	}
+	return tx.Model(&models.Round{}).
+		Where("game_id = ? AND number = ? AND ended_at IS NULL", game.ID, game.CurrentRound).
+		Update("ended_at", now).Error
+}
+
+// StartTurn stops the running turn, if any, and starts the player's clock.
+// The phase defaults to the round's phase in games that track phases, and
+// to the action phase otherwise. Players who have passed cannot take
+// another action phase turn in the same round.
+func StartTurn(ctx context.Context, gameID uint, req models.TurnRequest) (models.GameClock, error) {
+	phase, err := turnPhase(req.Phase)
+	if err != nil {
+		return models.GameClock{}, err
+	}
+	if err := requirePlayerInGame(gameID, req.PlayerID, "player_id"); err != nil {
+		return models.GameClock{}, err
+	}
+
+	err = database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		game, err := runningGame(tx, gameID)
+		if err != nil {
+			return err
+		}
+		var gp models.GamePlayer
+		err = tx.Preload("Player").Where("game_id = ? AND player_id = ?", gameID, req.PlayerID).First(&gp).Error
+		if err != nil {
+			return err
+		}
+		if gp.Eliminated {
+			return handle.Rule("%s has been eliminated", gp.Player.Name)
+		}
+
+		round, err := currentRound(tx, game)
+		if err != nil {
+			return err
+		}
+		switch {
+		case phase == "" && round.Phase != "":
+			phase = round.Phase
+		case phase == "":
+			phase = models.PhaseAction
+		case round.Phase != "" && phase != round.Phase:
+			return handle.Rule("game %d is in the %s phase", gameID, round.Phase)
+		}
+		if phase == models.PhaseAction {
+			var passed int64
+			if err := tx.Model(&models.Turn{}).
+				Where("round_id = ? AND player_id = ? AND passed = ?", round.ID, req.PlayerID, true).
+				Count(&passed).Error; err != nil {
+				return err
+			}
+			if passed > 0 {
+				return handle.Rule("%s has passed this round", gp.Player.Name)
+			}
+		}
+
+		now := time.Now()
+		if _, err := stopRunningTurn(tx, gameID, now); err != nil {
+			return err
+		}
+		return tx.Create(&models.Turn{
+			GameID:    gameID,
+			RoundID:   round.ID,
+			PlayerID:  req.PlayerID,
+			Phase:     phase,
+			StartedAt: now,
+		}).Error
+	})
+	if err != nil {
+		return models.GameClock{}, err
+	}
+	return GetGameClock(gameID)
+}
+
+// PassTurn ends the running action phase turn and marks its player as
+// passed for the round. A non-zero playerID must be the running player.
+func PassTurn(ctx context.Context, gameID, playerID uint) (models.GameClock, error) {
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := runningGame(tx, gameID); err != nil {
+			return err
+		}
+		var turn models.Turn
+		err := tx.Where("game_id = ? AND ended_at IS NULL", gameID).First(&turn).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return handle.Rule("no turn is running")
+		}
+		if err != nil {
+			return err
+		}
+		if playerID != 0 && turn.PlayerID != playerID {
+			return handle.Rule("it is not player %d's turn", playerID)
+		}
+		if turn.Phase != models.PhaseAction {
+			return handle.Rule("players can only pass in the action phase")
+		}
+		return tx.Model(&turn).Updates(map[string]any{"ended_at": time.Now(), "passed": true}).Error
+	})
+	if err != nil {
+		return models.GameClock{}, err
+	}
+	return GetGameClock(gameID)
+}
+
+// EndTurn stops the running turn without starting another.
+func EndTurn(ctx context.Context, gameID uint) (models.GameClock, error) {
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := runningGame(tx, gameID); err != nil {
+			return err
+		}
+		stopped, err := stopRunningTurn(tx, gameID, time.Now())
+		if err != nil {
+			return err
+		}
+		if !stopped {
+			return handle.Rule("no turn is running")
+		}
+		return nil
+	})
+	if err != nil {
+		return models.GameClock{}, err
+	}
+	return GetGameClock(gameID)
+}
+
+// GetGameClock returns the time each player has spent on their turns in a
+// game and who has passed in the current round.
+func GetGameClock(gameID uint) (models.GameClock, error) {
+	var game models.Game
+	if err := database.DB.First(&game, gameID).Error; err != nil {
+		return models.GameClock{}, err
+	}
+	clock := models.GameClock{Round: game.CurrentRound, Players: []models.PlayerClock{}}
+
+	round, err := currentRound(database.DB, game)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return clock, err
+	}
+	clock.RoundStartedAt = round.StartedAt
+
+	var gamePlayers []models.GamePlayer
+	if err := database.DB.Preload("Player").
+		Where("game_id = ?", gameID).
+		Order("id").
+		Find(&gamePlayers).Error; err != nil {
+		return clock, err
+	}
+	var turns []models.Turn
+	if err := database.DB.Where("game_id = ?", gameID).Order("started_at, id").Find(&turns).Error; err != nil {
+		return clock, err
+	}
+
+	now := time.Now()
+	byPlayer := make(map[uint]*models.PlayerClock, len(gamePlayers))
+	for _, gp := range gamePlayers {
+		clock.Players = append(clock.Players, models.PlayerClock{PlayerID: gp.PlayerID, Player: gp.Player.Name})
+	}
+	for i := range clock.Players {
+		byPlayer[clock.Players[i].PlayerID] = &clock.Players[i]
+	}
+	for _, t := range turns {
+		p := byPlayer[t.PlayerID]
+		if p == nil {
+			continue
+		}
+		end := now
+		if t.EndedAt != nil {
+			end = *t.EndedAt
+		} else {
+			running := t
+			clock.Running = &running
+		}
+		p.Turns++
+		p.Seconds += int64(end.Sub(t.StartedAt).Seconds())
+		if t.Passed && t.RoundID == round.ID {
+			p.Passed = true
+		}
+	}
+	return clock, nil
+}
+
+// GetClockStats returns round lengths, the slowest players and the time
+// spent in each phase across all timed games.
+func GetClockStats() (models.ClockStats, error) {
+	return stats.CalculateClockStats()
+}
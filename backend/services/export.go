@@ -0,0 +1,361 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/helpers/stats"
+	"github.com/arphillips06/TI4-stats/models"
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportTable is a flat dataset ready to be written as CSV or as a
+// spreadsheet sheet.
+type ExportTable struct {
+	Name   string
+	Header []string
+	Rows   [][]string
+}
+
+const exportDateFormat = "2006-01-02"
+
+// exportGame is a game with its players, rounds and scores and the derived
+// per-player totals used by the game and score exports.
+type exportGame struct {
+	Game      models.Game
+	Scores    []models.Score
+	Totals    map[uint]int
+	Placement map[uint]int
+	Factions  map[uint]string
+}
+
+// loadExportGames loads every game with its players, rounds and scores in
+// one query per table.
+func loadExportGames() ([]exportGame, error) {
+	var games []models.Game
+	if err := database.DB.
+		Preload("GamePlayers.Player").
+		Preload("Rounds").
+		Order("game_number, id").
+		Find(&games).Error; err != nil {
+		return nil, fmt.Errorf("loading games: %w", err)
+	}
+	var scores []models.Score
+	if err := database.DB.
+		Preload("Player").
+		Preload("Objective").
+		Order("game_id, id").
+		Find(&scores).Error; err != nil {
+		return nil, fmt.Errorf("loading scores: %w", err)
+	}
+	scoresByGame := make(map[uint][]models.Score, len(games))
+	for _, s := range scores {
+		scoresByGame[s.GameID] = append(scoresByGame[s.GameID], s)
+	}
+
+	out := make([]exportGame, 0, len(games))
+	for _, game := range games {
+		eg := exportGame{
+			Game:     game,
+			Scores:   scoresByGame[game.ID],
+			Totals:   make(map[uint]int),
+			Factions: make(map[uint]string),
+		}
+		for _, gp := range game.GamePlayers {
+			eg.Totals[gp.PlayerID] = 0
+			eg.Factions[gp.PlayerID] = gp.Faction
+		}
+		for _, s := range eg.Scores {
+			eg.Totals[s.PlayerID] += s.Points
+		}
+		eg.Placement = placements(eg.Totals)
+		out = append(out, eg)
+	}
+	return out, nil
+}
+
+// placements ranks players by final points; tied players share a placement.
+func placements(totals map[uint]int) map[uint]int {
+	points := make([]int, 0, len(totals))
+	for _, p := range totals {
+		points = append(points, p)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(points)))
+
+	rankOf := make(map[int]int)
+	rank := 0
+	for i, p := range points {
+		if i == 0 || p != points[i-1] {
+			rank++
+		}
+		if _, ok := rankOf[p]; !ok {
+			rankOf[p] = rank
+		}
+	}
+
+	out := make(map[uint]int, len(totals))
+	for id, p := range totals {
+		out[id] = rankOf[p]
+	}
+	return out
+}
+
+func exportDate(g models.Game) string {
+	if g.FinishedAt != nil {
+		return g.FinishedAt.Format(exportDateFormat)
+	}
+	return g.CreatedAt.Format(exportDateFormat)
+}
+
+// ExportGames returns one row per player per game.
+func ExportGames() (ExportTable, error) {
+	games, err := loadExportGames()
+	if err != nil {
+		return ExportTable{}, err
+	}
+	return gamesTable(games), nil
+}
+
+func gamesTable(games []exportGame) ExportTable {
+	table := ExportTable{
+		Name:   "games",
+		Header: []string{"game_number", "date", "finished", "partial", "winning_points", "rounds", "player", "faction", "final_points", "placement", "won"},
+	}
+	for _, eg := range games {
+		g := eg.Game
+		for _, gp := range g.GamePlayers {
+			table.Rows = append(table.Rows, []string{
+				strconv.Itoa(g.GameNumber),
+				exportDate(g),
+				strconv.FormatBool(g.FinishedAt != nil),
+				strconv.FormatBool(g.Partial),
+				strconv.Itoa(g.WinningPoints),
+				strconv.Itoa(len(g.Rounds)),
+				gp.Player.Name,
+				gp.Faction,
+				strconv.Itoa(eg.Totals[gp.PlayerID]),
+				strconv.Itoa(eg.Placement[gp.PlayerID]),
+				strconv.FormatBool(gp.Won),
+			})
+		}
+	}
+	return table
+}
+
+// ExportScores returns one row per score row recorded in any game.
+func ExportScores() (ExportTable, error) {
+	games, err := loadExportGames()
+	if err != nil {
+		return ExportTable{}, err
+	}
+	return scoresTable(games), nil
+}
+
+func scoresTable(games []exportGame) ExportTable {
+	table := ExportTable{
+		Name:   "scores",
+		Header: []string{"game_number", "date", "round", "player", "faction", "type", "objective", "stage", "agenda", "relic", "points", "originally_secret", "override"},
+	}
+	for _, eg := range games {
+		roundNumbers := make(map[uint]int, len(eg.Game.Rounds))
+		for _, r := range eg.Game.Rounds {
+			roundNumbers[r.ID] = r.Number
+		}
+
+		scores := append([]models.Score(nil), eg.Scores...)
+		sort.SliceStable(scores, func(i, j int) bool {
+			ri, rj := roundNumbers[scores[i].RoundID], roundNumbers[scores[j].RoundID]
+			if ri != rj {
+				return ri < rj
+			}
+			return scores[i].ID < scores[j].ID
+		})
+
+		for _, s := range scores {
+			round := ""
+			if n, ok := roundNumbers[s.RoundID]; ok {
+				round = strconv.Itoa(n)
+			}
+			table.Rows = append(table.Rows, []string{
+				strconv.Itoa(eg.Game.GameNumber),
+				exportDate(eg.Game),
+				round,
+				s.Player.Name,
+				eg.Factions[s.PlayerID],
+				string(s.Type),
+				s.Objective.Name,
+				s.Objective.Stage,
+				s.AgendaTitle,
+				s.RelicTitle,
+				strconv.Itoa(s.Points),
+				strconv.FormatBool(s.OriginallySecret),
+				s.Override,
+			})
+		}
+	}
+	return table
+}
+
+// ExportStats returns per-player and per-faction summary rows.
+func ExportStats() (ExportTable, error) {
+	winRates, err := stats.CalculatePlayerWinRates()
+	if err != nil {
+		return ExportTable{}, err
+	}
+	averages, err := stats.CalculatePlayerAverages()
+	if err != nil {
+		return ExportTable{}, err
+	}
+	stdevs, err := stats.CalculatePointStandardDeviations()
+	if err != nil {
+		return ExportTable{}, err
+	}
+	plays, wins, factionWinRates, _, err := stats.CalculateFactionStats()
+	if err != nil {
+		return ExportTable{}, err
+	}
+	factionAgg, err := stats.GetFactionAggregateStats()
+	if err != nil {
+		return ExportTable{}, err
+	}
+
+	table := ExportTable{
+		Name:   "stats",
+		Header: []string{"kind", "name", "games_played", "games_won", "win_rate", "total_points", "average_points", "points_stdev"},
+	}
+
+	avgByPlayer := make(map[string]models.PlayerAveragePoints, len(averages))
+	for _, a := range averages {
+		avgByPlayer[a.Player] = a
+	}
+	stdevByPlayer := make(map[string]float64, len(stdevs))
+	for _, s := range stdevs {
+		stdevByPlayer[s.Player] = s.Stdev
+	}
+	sort.Slice(winRates, func(i, j int) bool { return winRates[i].Player < winRates[j].Player })
+	for _, wr := range winRates {
+		avg := avgByPlayer[wr.Player]
+		table.Rows = append(table.Rows, []string{
+			"player",
+			wr.Player,
+			strconv.Itoa(wr.GamesPlayed),
+			strconv.Itoa(wr.GamesWon),
+			formatFloat(wr.WinRate),
+			formatFloat(avg.TotalPoints),
+			formatFloat(avg.AveragePoints),
+			formatFloat(stdevByPlayer[wr.Player]),
+		})
+	}
+
+	pointsByFaction := make(map[string]int, len(factionAgg))
+	for _, f := range factionAgg {
+		pointsByFaction[f.Faction] = f.TotalPointsScored
+	}
+	factions := make([]string, 0, len(plays))
+	for f := range plays {
+		factions = append(factions, f)
+	}
+	sort.Strings(factions)
+	for _, f := range factions {
+		avg := 0.0
+		if plays[f] > 0 {
+			avg = float64(pointsByFaction[f]) / float64(plays[f])
+		}
+		table.Rows = append(table.Rows, []string{
+			"faction",
+			f,
+			strconv.Itoa(plays[f]),
+			strconv.Itoa(wins[f]),
+			formatFloat(factionWinRates[f]),
+			strconv.Itoa(pointsByFaction[f]),
+			formatFloat(avg),
+			"",
+		})
+	}
+
+	return table, nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+// ExportWorkbook builds an .xlsx workbook with one sheet per dataset. The
+// games and scores sheets share one load of the games.
+func ExportWorkbook() (*excelize.File, error) {
+	games, err := loadExportGames()
+	if err != nil {
+		return nil, err
+	}
+	summary, err := ExportStats()
+	if err != nil {
+		return nil, err
+	}
+	tables := []ExportTable{gamesTable(games), scoresTable(games), summary}
+
+	f := excelize.NewFile()
+	for i, table := range tables {
+		if i == 0 {
+			if err := f.SetSheetName(f.GetSheetName(0), table.Name); err != nil {
+				f.Close()
+				return nil, err
+			}
+		} else if _, err := f.NewSheet(table.Name); err != nil {
+			f.Close()
+			return nil, err
+		}
+
+		if err := writeSheet(f, table); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func writeSheet(f *excelize.File, table ExportTable) error {
+	sw, err := f.NewStreamWriter(table.Name)
+	if err != nil {
+		return err
+	}
+
+	row := make([]any, len(table.Header))
+	for i, h := range table.Header {
+		row[i] = h
+	}
+	if err := sw.SetRow("A1", row); err != nil {
+		return err
+	}
+
+	for r, values := range table.Rows {
+		row := make([]any, len(values))
+		for i, v := range values {
+			row[i] = sheetValue(v)
+		}
+		cell, err := excelize.CoordinatesToCellName(1, r+2)
+		if err != nil {
+			return err
+		}
+		if err := sw.SetRow(cell, row); err != nil {
+			return err
+		}
+	}
+	return sw.Flush()
+}
+
+// sheetValue keeps numbers and booleans typed so they can be summed and
+// filtered in the spreadsheet.
+func sheetValue(v string) any {
+	if n, err := strconv.Atoi(v); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(v, 64); err == nil {
+		return f
+	}
+	if v == "true" || v == "false" {
+		return v == "true"
+	}
+	return v
+}
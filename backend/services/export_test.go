@@ -0,0 +1,47 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+)
+
+func TestExportTables(t *testing.T) {
+	dbtest.Open(t, "sqlite")
+	dbtest.SeedFinishedGame(t)
+
+	games, err := ExportGames()
+	if err != nil {
+		t.Fatalf("ExportGames: %v", err)
+	}
+	if len(games.Rows) != 3 {
+		t.Fatalf("ExportGames returned %d rows, want one per player", len(games.Rows))
+	}
+	for _, row := range games.Rows {
+		// Columns 6, 8 and 9 are player, final_points and placement.
+		if row[6] == "Alice" && (row[8] != "10" || row[9] != "1") {
+			t.Errorf("Alice's row = %v, want 10 points in first place", row)
+		}
+	}
+
+	scores, err := ExportScores()
+	if err != nil {
+		t.Fatalf("ExportScores: %v", err)
+	}
+	if len(scores.Rows) != 6 {
+		t.Errorf("ExportScores returned %d rows, want 6", len(scores.Rows))
+	}
+
+	f, err := ExportWorkbook()
+	if err != nil {
+		t.Fatalf("ExportWorkbook: %v", err)
+	}
+	defer f.Close()
+	rows, err := f.GetRows("scores")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != len(scores.Rows)+1 {
+		t.Errorf("scores sheet has %d rows, want %d and a header", len(rows), len(scores.Rows))
+	}
+}
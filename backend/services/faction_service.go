@@ -0,0 +1,57 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/factions"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// ErrUnknownFaction is wrapped by errors for faction names that match no
+// catalogue name, code or alias.
+var ErrUnknownFaction = errors.New("invalid faction")
+
+var expansions = []string{models.ExpansionBase, models.ExpansionPoK, models.ExpansionCodex}
+
+// ListFactions returns the faction catalogue, optionally limited to one
+// expansion.
+func ListFactions(expansion string) ([]models.Faction, error) {
+	q := database.DB.Order("name")
+	if expansion = strings.ToLower(strings.TrimSpace(expansion)); expansion != "" {
+		if !slices.Contains(expansions, expansion) {
+			return nil, fmt.Errorf("unknown expansion %q: must be one of %s", expansion, strings.Join(expansions, ", "))
+		}
+		q = q.Where("expansion = ?", expansion)
+	}
+
+	out := []models.Faction{}
+	err := q.Find(&out).Error
+	return out, err
+}
+
+// LoadFactionIndex reads the catalogue into an index for resolving many
+// names at once.
+func LoadFactionIndex() (factions.Index, error) {
+	var all []models.Faction
+	if err := database.DB.Find(&all).Error; err != nil {
+		return nil, err
+	}
+	return factions.NewIndex(all), nil
+}
+
+// ResolveFaction finds a faction by name, code or alias.
+func ResolveFaction(name string) (models.Faction, error) {
+	index, err := LoadFactionIndex()
+	if err != nil {
+		return models.Faction{}, err
+	}
+	faction, ok := index.Lookup(name)
+	if !ok {
+		return faction, fmt.Errorf("%w: %s", ErrUnknownFaction, name)
+	}
+	return faction, nil
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -8,71 +9,95 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/arphillips06/TI4-stats/config"
 	"github.com/arphillips06/TI4-stats/database"
-	"github.com/arphillips06/TI4-stats/database/factions"
+	handle "github.com/arphillips06/TI4-stats/errors"
 	"github.com/arphillips06/TI4-stats/helpers"
 	"github.com/arphillips06/TI4-stats/models"
 	"gorm.io/gorm"
 )
 
 // Validates player input and returns matched players with faction info.
-func ParseAndValidatePlayers(inputPlayers []models.PlayerInput) ([]models.SelectedPlayersWithFaction, error) {
-	var allPlayers []models.Player
-	if err := database.DB.Find(&allPlayers).Error; err != nil {
+// Players are matched by ID, name or alias. A name that matches nobody is
+// created as a new player, unless it looks like a typo of an existing one
+// and the input does not set New; then an *UnknownPlayerError is returned.
+func ParseAndValidatePlayers(ctx context.Context, inputPlayers []models.PlayerInput) ([]models.SelectedPlayersWithFaction, error) {
+	db := database.DB.WithContext(ctx)
+	factionIndex, err := LoadFactionIndex()
+	if err != nil {
 		return nil, err
 	}
-	// Map for quick lookup by ID or lowercase name
-	playerMap := make(map[string]models.Player)
-	for _, p := range allPlayers {
-		playerMap[strconv.Itoa(int(p.ID))] = p
-		playerMap[strings.ToLower(p.Name)] = p
-	}
 
-	var selected []models.SelectedPlayersWithFaction
-	for _, p := range inputPlayers {
-		if strings.TrimSpace(p.Name) == "" {
+	selected := make([]models.SelectedPlayersWithFaction, len(inputPlayers))
+	var missing []int
+	for i, p := range inputPlayers {
+		if strings.TrimSpace(p.Name) == "" && p.ID == "" {
 			return nil, fmt.Errorf("player name cannot be blank")
 		}
-		lookup := strings.ToLower(p.Name)
-		if p.ID != "" {
-			lookup = p.ID
-		}
 
-		player, exists := playerMap[lookup]
-		if !exists {
-			newplayer, err := CreatePlayer(p.Name)
+		var (
+			player models.Player
+			found  bool
+		)
+		if p.ID != "" {
+			id, err := strconv.ParseUint(p.ID, 10, 64)
 			if err != nil {
-				return nil, fmt.Errorf("failed to create player: %s", p.Name)
+				return nil, fmt.Errorf("invalid player ID: %s", p.ID)
+			}
+			found = db.First(&player, id).Error == nil
+		} else if player, found, err = FindPlayerByName(db, p.Name); err != nil {
+			return nil, err
+		}
+		if !found {
+			if strings.TrimSpace(p.Name) == "" {
+				return nil, fmt.Errorf("%w: %s", ErrPlayerNotFound, p.ID)
 			}
-			player = newplayer
+			if !p.New {
+				suggestions, err := suggestPlayers(db, p.Name)
+				if err != nil {
+					return nil, err
+				}
+				if len(suggestions) > 0 {
+					return nil, &UnknownPlayerError{Name: strings.TrimSpace(p.Name), Suggestions: suggestions}
+				}
+			}
+			missing = append(missing, i)
 		}
 
-		if !factions.IsValidFaction(p.Faction) {
-			return nil, fmt.Errorf("invalid faction: %s", p.Faction)
+		faction, ok := factionIndex.Lookup(p.Faction)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFaction, p.Faction)
 		}
+		selected[i] = models.SelectedPlayersWithFaction{Player: player, Faction: faction}
+	}
 
-		selected = append(selected, models.SelectedPlayersWithFaction{
-			Player:  player,
-			Faction: p.Faction,
-		})
+	// Only create players once every entry is known to be valid.
+	for _, i := range missing {
+		name := strings.TrimSpace(inputPlayers[i].Name)
+		newplayer, err := CreatePlayer(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create player: %s", name)
+		}
+		selected[i].Player = newplayer
 	}
 
 	return selected, nil
 }
 
 // Creates a new game and initial round
-func CreateGameAndRound(winningPoints int, useDecks bool) (models.Game, models.Round, error) {
+func CreateGameAndRound(ctx context.Context, winningPoints int, useDecks bool) (models.Game, models.Round, error) {
+	db := database.DB.WithContext(ctx)
 	game := models.Game{
 		WinningPoints:     winningPoints,
 		UseObjectiveDecks: useDecks,
 		CurrentRound:      1,
 	}
-	if err := database.DB.Create(&game).Error; err != nil {
+	if err := db.Create(&game).Error; err != nil {
 		return game, models.Round{}, err
 	}
 
-	round1 := models.Round{GameID: game.ID, Number: 1}
-	if err := database.DB.Create(&round1).Error; err != nil {
+	round1 := models.Round{GameID: game.ID, Number: 1, StartedAt: &game.CreatedAt}
+	if err := db.Create(&round1).Error; err != nil {
 		return game, models.Round{}, err
 	}
 
@@ -81,12 +106,13 @@ func CreateGameAndRound(winningPoints int, useDecks bool) (models.Game, models.R
 
 // Assigns 10 public objectives (5 stage I, 5 stage II) to a game.
 // Two stage I objectives are revealed in round 1.
-func AssignObjectivesToGame(game models.Game, round1 models.Round) error {
+func AssignObjectivesToGame(ctx context.Context, game models.Game, round1 models.Round) error {
+	db := database.DB.WithContext(ctx)
 	var stage1 []models.Objective
 	var stage2 []models.Objective
 
-	database.DB.Where("stage = ?", "I").Find(&stage1)
-	database.DB.Where("stage = ?", "II").Find(&stage2)
+	db.Where("stage = ?", "I").Find(&stage1)
+	db.Where("stage = ?", "II").Find(&stage2)
 
 	rand.Shuffle(len(stage1), func(i, j int) { stage1[i], stage1[j] = stage1[j], stage1[i] })
 	rand.Shuffle(len(stage2), func(i, j int) { stage2[i], stage2[j] = stage2[j], stage2[i] })
@@ -108,7 +134,7 @@ func AssignObjectivesToGame(game models.Game, round1 models.Round) error {
 			Revealed:    i < 2,
 			Position:    i,
 		}
-		if err := database.DB.Create(&gameObj).Error; err != nil {
+		if err := db.Create(&gameObj).Error; err != nil {
 			return err
 		}
 	}
@@ -121,89 +147,147 @@ func AssignObjectivesToGame(game models.Game, round1 models.Round) error {
 			Revealed:    false,
 			Position:    j,
 		}
-		if err := database.DB.Create(&gameObj).Error; err != nil {
+		if err := db.Create(&gameObj).Error; err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func CreateNewGameWithPlayers(input models.CreateGameInput) (models.Game, []models.GameObjective, error) {
+// gameSetup is everything needed to create a game once defaults, or a
+// template, have been applied.
+type gameSetup struct {
+	winningPoints int
+	useDecks      bool
+	trackPhases   bool
+	speakerMode   string
+	players       []models.PlayerInput
+	title         string
+	notes         string
+	location      string
+	houseRules    []string
+	templateID    *uint
+}
+
+func CreateNewGameWithPlayers(ctx context.Context, input models.CreateGameInput) (models.Game, []models.GameObjective, error) {
 	const (
-		DefaultWinningPoints   = 10
+		StandardWinningPoints  = 10
 		AlternateWinningPoints = 14
 	)
-
-	useDecks := true
+	defaults := config.Current().Defaults
+
+	setup := gameSetup{
+		winningPoints: input.WinningPoints,
+		useDecks:      defaults.UseObjectiveDecks,
+		trackPhases:   defaults.TrackPhases,
+		speakerMode:   models.SpeakerModeNone,
+		players:       input.Players,
+		title:         input.Title,
+		notes:         input.Notes,
+		location:      input.Location,
+	}
 	if input.UseObjectiveDecks != nil {
-		useDecks = *input.UseObjectiveDecks
+		setup.useDecks = *input.UseObjectiveDecks
 	}
-	if input.WinningPoints != DefaultWinningPoints && input.WinningPoints != AlternateWinningPoints {
-		input.WinningPoints = DefaultWinningPoints
+	randomSpeaker := defaults.RandomSpeaker
+	if input.UseRandomSpeaker != nil {
+		randomSpeaker = *input.UseRandomSpeaker
+	}
+	if randomSpeaker {
+		setup.speakerMode = models.SpeakerModeRandom
+	}
+	if input.TrackPhases != nil {
+		setup.trackPhases = *input.TrackPhases
+	}
+	if setup.winningPoints != StandardWinningPoints && setup.winningPoints != AlternateWinningPoints {
+		setup.winningPoints = defaults.WinningPoints
 	}
 
-	selected, err := ParseAndValidatePlayers(input.Players)
+	return createGame(ctx, setup)
+}
+
+// createGame creates the game, its first round and players, picks the
+// speaker and deals the objective decks.
+func createGame(ctx context.Context, setup gameSetup) (models.Game, []models.GameObjective, error) {
+	db := database.DB.WithContext(ctx)
+	selected, err := ParseAndValidatePlayers(ctx, setup.players)
 	if err != nil {
 		return models.Game{}, nil, err
 	}
 
 	var maxNumber int
-	if err := database.DB.Model(&models.Game{}).
+	if err := db.Model(&models.Game{}).
 		Select("COALESCE(MAX(game_number), 0)").Scan(&maxNumber).Error; err != nil {
 		return models.Game{}, nil, errors.New("failed to assign game number")
 	}
 
 	game := models.Game{
-		WinningPoints:     input.WinningPoints,
-		UseObjectiveDecks: useDecks,
+		WinningPoints:     setup.winningPoints,
+		UseObjectiveDecks: setup.useDecks,
 		CurrentRound:      1,
 		GameNumber:        maxNumber + 1,
+		Title:             strings.TrimSpace(setup.title),
+		Notes:             setup.notes,
+		Location:          strings.TrimSpace(setup.location),
+		HouseRules:        setup.houseRules,
+		TemplateID:        setup.templateID,
+	}
+	if err := db.Create(&game).Error; err != nil {
+		return models.Game{}, nil, err
 	}
-	if err := database.DB.Create(&game).Error; err != nil {
+	if err := startFirstSession(db, game); err != nil {
 		return models.Game{}, nil, err
 	}
 
 	round1 := models.Round{
-		GameID: game.ID,
-		Number: 1,
+		GameID:    game.ID,
+		Number:    1,
+		StartedAt: &game.CreatedAt,
+	}
+	if setup.trackPhases {
+		round1.Phase = models.PhaseStrategy
 	}
-	if err := database.DB.Create(&round1).Error; err != nil {
+	if err := db.Create(&round1).Error; err != nil {
 		return models.Game{}, nil, err
 	}
 
 	for _, entry := range selected {
-		if err := helpers.CreateGamePlayer(game.ID, entry.Player.ID, entry.Faction); err != nil {
+		if err := helpers.CreateGamePlayer(ctx, game.ID, entry.Player.ID, entry.Faction); err != nil {
 			return models.Game{}, nil, err
 		}
 	}
 
 	var gamePlayers []models.GamePlayer
-	if err := database.DB.Preload("Player").
+	if err := db.Preload("Player").
 		Where("game_id = ?", game.ID).
+		Order("id").
 		Find(&gamePlayers).Error; err != nil {
 		return models.Game{}, nil, errors.New("failed to load game players for speaker assignment")
 	}
 
-	if input.UseRandomSpeaker != nil && *input.UseRandomSpeaker && len(gamePlayers) > 0 {
-		chosen := gamePlayers[rand.Intn(len(gamePlayers))]
+	if len(gamePlayers) > 0 && setup.speakerMode != models.SpeakerModeNone {
+		chosen := gamePlayers[0]
+		if setup.speakerMode == models.SpeakerModeRandom {
+			chosen = gamePlayers[rand.Intn(len(gamePlayers))]
+		}
 		log.Printf("🎙️  Chosen speaker: %v", chosen)
 		game.SpeakerID = &chosen.ID
 
-		if err := AssignSpeaker(game.ID, uint(round1.Number), chosen.ID); err != nil {
+		if err := AssignSpeaker(ctx, game.ID, uint(round1.Number), chosen.ID); err != nil {
 			return models.Game{}, nil, errors.New("failed to create speaker assignment")
 		}
 
-		if err := database.DB.Save(&game).Error; err != nil {
+		if err := db.Save(&game).Error; err != nil {
 			return models.Game{}, nil, errors.New("failed to save speaker assignment")
 		}
 	}
 
 	var revealed []models.GameObjective
 	if game.UseObjectiveDecks {
-		if err := AssignObjectivesToGame(game, round1); err != nil {
+		if err := AssignObjectivesToGame(ctx, game, round1); err != nil {
 			return models.Game{}, nil, err
 		}
-		_ = database.DB.
+		_ = db.
 			Preload("Objective").
 			Joins("JOIN rounds ON rounds.id = game_objectives.round_id").
 			Where("game_objectives.game_id = ?", game.ID).
@@ -213,22 +297,24 @@ func CreateNewGameWithPlayers(input models.CreateGameInput) (models.Game, []mode
 	return game, revealed, nil
 }
 
-func ManuallyAssignObjective(gameID uint, roundNumber uint, objectiveID uint) error {
-	var round models.Round
-	if err := database.DB.
-		Where("game_id = ? AND number = ?", gameID, roundNumber).
-		First(&round).Error; err != nil {
-		return errors.New("round not found")
+func ManuallyAssignObjective(ctx context.Context, gameID uint, roundNumber uint, objectiveID uint) error {
+	db := database.DB.WithContext(ctx)
+	round, err := roundByNumber(gameID, int(roundNumber))
+	if err != nil {
+		return err
 	}
 
 	var obj models.Objective
-	if err := database.DB.
+	if err := db.
 		First(&obj, objectiveID).Error; err != nil {
-		return errors.New("objective not found")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return handle.Invalid("objective_id", "objective %d does not exist", objectiveID)
+		}
+		return err
 	}
 
 	var existing models.GameObjective
-	err := database.DB.
+	err = db.
 		Where("game_id = ? AND objective_id = ?", gameID, obj.ID).
 		First(&existing).Error
 
@@ -240,7 +326,7 @@ func ManuallyAssignObjective(gameID uint, roundNumber uint, objectiveID uint) er
 		return err
 	}
 	var position int64
-	_ = database.DB.Model(&models.GameObjective{}).
+	_ = db.Model(&models.GameObjective{}).
 		Where("game_id = ? AND stage = ?", gameID, obj.Stage).
 		Count(&position)
 
@@ -254,5 +340,5 @@ func ManuallyAssignObjective(gameID uint, roundNumber uint, objectiveID uint) er
 	}
 	log.Printf("Assigned objective %s (ID %d) to game %d round %d", obj.Name, obj.ID, gameID, roundNumber)
 
-	return database.DB.Create(&reveal).Error
+	return db.Create(&reveal).Error
 }
@@ -0,0 +1,540 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/factions"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+// ImportValidationError is returned by ImportGames when any row is invalid.
+// Nothing is written in that case.
+type ImportValidationError struct {
+	Errors []models.ImportError
+}
+
+func (e *ImportValidationError) Error() string {
+	return fmt.Sprintf("%d import rows are invalid", len(e.Errors))
+}
+
+var roundColumnRe = regexp.MustCompile(`^(?:round_?|r)(\d+)$`)
+
+// ParseImportCSV reads one row per player per game. Rows are grouped into
+// games by the game (or game_number) column. Recognised columns:
+//
+//	game, date, title, location, notes, winning_points, rounds,
+//	player, faction, final_points, won, round_1 ... round_N
+//
+// Unknown columns are ignored, so a games.csv export can be imported back.
+// Row numbers in the result count the header as row 1.
+func ParseImportCSV(r io.Reader) ([]models.ImportGame, []models.ImportError) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, []models.ImportError{{Row: 1, Message: "cannot read header: " + err.Error()}}
+	}
+
+	cols := make(map[string]int)
+	roundCols := make(map[int]int)
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(h))
+		if name == "game_number" {
+			name = "game"
+		}
+		if m := roundColumnRe.FindStringSubmatch(name); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			if n > 0 {
+				roundCols[n] = i
+			}
+			continue
+		}
+		cols[name] = i
+	}
+	for _, required := range []string{"game", "date", "player", "faction"} {
+		if _, ok := cols[required]; !ok {
+			return nil, []models.ImportError{{Row: 1, Message: "missing required column " + required}}
+		}
+	}
+
+	maxRound := 0
+	for n := range roundCols {
+		if n > maxRound {
+			maxRound = n
+		}
+	}
+
+	var (
+		games   []models.ImportGame
+		byKey   = make(map[string]int)
+		errs    []models.ImportError
+		lastRow = 1
+	)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		lastRow++
+		if err != nil {
+			errs = append(errs, models.ImportError{Row: lastRow, Message: err.Error()})
+			continue
+		}
+
+		field := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		rowErr := func(msg string) {
+			errs = append(errs, models.ImportError{Row: lastRow, Game: field("game"), Player: field("player"), Message: msg})
+		}
+
+		key := field("game")
+		if key == "" {
+			rowErr("game is required")
+			continue
+		}
+
+		idx, ok := byKey[key]
+		if !ok {
+			g := models.ImportGame{
+				Row:      lastRow,
+				Key:      key,
+				Date:     field("date"),
+				Title:    field("title"),
+				Location: field("location"),
+				Notes:    field("notes"),
+			}
+			if v := field("winning_points"); v != "" {
+				n, err := strconv.Atoi(v)
+				if err != nil {
+					rowErr("winning_points must be a number")
+					continue
+				}
+				g.WinningPoints = n
+			}
+			if v := field("rounds"); v != "" {
+				n, err := strconv.Atoi(v)
+				if err != nil {
+					rowErr("rounds must be a number")
+					continue
+				}
+				g.Rounds = n
+			}
+			games = append(games, g)
+			idx = len(games) - 1
+			byKey[key] = idx
+		} else if d := field("date"); d != "" && d != games[idx].Date {
+			rowErr(fmt.Sprintf("date %q differs from the first row of game %s", d, key))
+			continue
+		}
+
+		p := models.ImportPlayer{
+			Row:     lastRow,
+			Name:    field("player"),
+			Faction: field("faction"),
+		}
+		if v := field("final_points"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				rowErr("final_points must be a number")
+				continue
+			}
+			p.FinalPoints = &n
+		}
+		if v := field("won"); v != "" {
+			won, err := parseImportBool(v)
+			if err != nil {
+				rowErr(err.Error())
+				continue
+			}
+			p.Won = won
+		}
+
+		// A player whose round cells are all blank has no per-round data.
+		var roundPoints []int
+		valid, anyRound := true, false
+		for n := 1; n <= maxRound; n++ {
+			i, ok := roundCols[n]
+			v := ""
+			if ok && i < len(record) {
+				v = strings.TrimSpace(record[i])
+			}
+			if v == "" {
+				roundPoints = append(roundPoints, 0)
+				continue
+			}
+			anyRound = true
+			pts, err := strconv.Atoi(v)
+			if err != nil {
+				rowErr(fmt.Sprintf("round_%d must be a number", n))
+				valid = false
+				break
+			}
+			roundPoints = append(roundPoints, pts)
+		}
+		if !valid {
+			continue
+		}
+		if anyRound {
+			p.RoundPoints = roundPoints
+		}
+
+		games[idx].Players = append(games[idx].Players, p)
+	}
+
+	return games, errs
+}
+
+func parseImportBool(v string) (bool, error) {
+	switch strings.ToLower(v) {
+	case "true", "yes", "y", "1", "x":
+		return true, nil
+	case "false", "no", "n", "0", "":
+		return false, nil
+	}
+	return false, fmt.Errorf("won must be true or false, got %q", v)
+}
+
+func parseImportDate(v string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("date must be YYYY-MM-DD or RFC 3339, got %q", v)
+}
+
+// importPlan is a validated game ready to be written.
+type importPlan struct {
+	src     models.ImportGame
+	date    time.Time
+	rounds  int
+	partial bool
+	winner  int // index into src.Players
+	// factions is aligned with src.Players.
+	factions []models.Faction
+}
+
+// validateImportGame checks a single game and decides how it will be stored.
+// A game is Partial when the round count or any player's per-round points
+// are unknown.
+func validateImportGame(g models.ImportGame, factionIndex factions.Index) (importPlan, []models.ImportError) {
+	var errs []models.ImportError
+	gameErr := func(msg string) {
+		errs = append(errs, models.ImportError{Row: g.Row, Game: g.Key, Message: msg})
+	}
+	playerErr := func(p models.ImportPlayer, msg string) {
+		errs = append(errs, models.ImportError{Row: p.Row, Game: g.Key, Player: p.Name, Message: msg})
+	}
+
+	plan := importPlan{src: g, winner: -1, factions: make([]models.Faction, len(g.Players))}
+
+	if strings.TrimSpace(g.Date) == "" {
+		gameErr("date is required")
+	} else if d, err := parseImportDate(strings.TrimSpace(g.Date)); err != nil {
+		gameErr(err.Error())
+	} else if d.After(time.Now()) {
+		gameErr("date is in the future")
+	} else {
+		plan.date = d
+	}
+
+	switch {
+	case g.WinningPoints == 0:
+		plan.src.WinningPoints = 10
+	case g.WinningPoints < models.MinWinningPoints || g.WinningPoints > models.MaxWinningPoints:
+		gameErr(fmt.Sprintf("winning_points must be from %d to %d", models.MinWinningPoints, models.MaxWinningPoints))
+	}
+	if g.Rounds < 0 {
+		gameErr("rounds cannot be negative")
+	}
+	if len(g.Players) < 2 {
+		gameErr("a game needs at least two players")
+	}
+
+	seenPlayers := make(map[string]bool)
+	seenFactions := make(map[string]bool)
+	plan.rounds = g.Rounds
+	for i, p := range g.Players {
+		name := strings.ToLower(strings.TrimSpace(p.Name))
+		if name == "" {
+			playerErr(p, "player name cannot be blank")
+		} else if seenPlayers[name] {
+			playerErr(p, "player appears more than once in this game")
+		}
+		seenPlayers[name] = true
+
+		if faction, ok := factionIndex.Lookup(p.Faction); !ok {
+			playerErr(p, "invalid faction: "+p.Faction)
+		} else if seenFactions[faction.Name] {
+			playerErr(p, "faction appears more than once in this game")
+		} else {
+			seenFactions[faction.Name] = true
+			plan.factions[i] = faction
+		}
+
+		if p.FinalPoints != nil && *p.FinalPoints < 0 {
+			playerErr(p, "final_points cannot be negative")
+		}
+		if len(p.RoundPoints) > 0 {
+			sum := 0
+			for _, pts := range p.RoundPoints {
+				sum += pts
+			}
+			if p.FinalPoints != nil && sum != *p.FinalPoints {
+				playerErr(p, fmt.Sprintf("round points add up to %d but final_points is %d", sum, *p.FinalPoints))
+			}
+			if g.Rounds > 0 && len(p.RoundPoints) > g.Rounds {
+				playerErr(p, fmt.Sprintf("points given for %d rounds but the game lasted %d", len(p.RoundPoints), g.Rounds))
+			}
+			if len(p.RoundPoints) > plan.rounds && g.Rounds == 0 {
+				plan.rounds = len(p.RoundPoints)
+			}
+		} else {
+			plan.partial = true
+		}
+	}
+	if plan.rounds == 0 {
+		plan.partial = true
+	}
+
+	// Winner: the player marked as won, otherwise the only player who
+	// reached the points target.
+	for i, p := range g.Players {
+		if !p.Won {
+			continue
+		}
+		if plan.winner >= 0 {
+			gameErr("more than one player is marked as the winner")
+			break
+		}
+		plan.winner = i
+	}
+	if plan.winner < 0 {
+		for i, p := range g.Players {
+			if pts, ok := importFinalPoints(p); ok && pts >= plan.src.WinningPoints {
+				if plan.winner >= 0 {
+					plan.winner = -1
+					break
+				}
+				plan.winner = i
+			}
+		}
+		if plan.winner < 0 {
+			gameErr("cannot tell who won: mark one player as won")
+		}
+	}
+	if plan.winner >= 0 {
+		if winPts, ok := importFinalPoints(g.Players[plan.winner]); ok {
+			for i, p := range g.Players {
+				if pts, ok := importFinalPoints(p); ok && i != plan.winner && pts > winPts {
+					playerErr(p, "has more points than the winner")
+				}
+			}
+		}
+	}
+
+	return plan, errs
+}
+
+func importFinalPoints(p models.ImportPlayer) (int, bool) {
+	if p.FinalPoints != nil {
+		return *p.FinalPoints, true
+	}
+	if len(p.RoundPoints) > 0 {
+		sum := 0
+		for _, pts := range p.RoundPoints {
+			sum += pts
+		}
+		return sum, true
+	}
+	return 0, false
+}
+
+// ImportGames validates every game and, if all are valid, creates them in a
+// single transaction. Games are numbered after the existing ones in date
+// order. Players are matched by name and created when missing.
+func ImportGames(ctx context.Context, games []models.ImportGame) ([]models.ImportedGame, error) {
+	if len(games) == 0 {
+		return nil, &ImportValidationError{Errors: []models.ImportError{{Message: "no games to import"}}}
+	}
+
+	factionIndex, err := LoadFactionIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		plans []importPlan
+		errs  []models.ImportError
+	)
+	for _, g := range games {
+		plan, gameErrs := validateImportGame(g, factionIndex)
+		errs = append(errs, gameErrs...)
+		plans = append(plans, plan)
+	}
+	if len(errs) > 0 {
+		return nil, &ImportValidationError{Errors: errs}
+	}
+
+	// Stable sort by date so game numbers follow the order games were played.
+	sort.SliceStable(plans, func(i, j int) bool { return plans[i].date.Before(plans[j].date) })
+
+	var imported []models.ImportedGame
+	err = database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var maxNumber int
+		if err := tx.Model(&models.Game{}).
+			Select("COALESCE(MAX(game_number), 0)").Scan(&maxNumber).Error; err != nil {
+			return errors.New("failed to assign game number")
+		}
+
+		players := make(map[string]models.Player)
+		for _, plan := range plans {
+			maxNumber++
+			created, err := createImportedGame(tx, plan, maxNumber, players)
+			if err != nil {
+				return fmt.Errorf("row %d: %w", plan.src.Row, err)
+			}
+			imported = append(imported, created)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return imported, nil
+}
+
+func createImportedGame(tx *gorm.DB, plan importPlan, number int, players map[string]models.Player) (models.ImportedGame, error) {
+	src := plan.src
+	finished := plan.date
+	rounds := plan.rounds
+	if rounds == 0 {
+		rounds = 1
+	}
+
+	game := models.Game{
+		GameNumber:    number,
+		CreatedAt:     plan.date,
+		FinishedAt:    &finished,
+		WinningPoints: src.WinningPoints,
+		CurrentRound:  rounds,
+		Partial:       plan.partial,
+		Title:         strings.TrimSpace(src.Title),
+		Notes:         src.Notes,
+		Location:      strings.TrimSpace(src.Location),
+	}
+	if err := tx.Create(&game).Error; err != nil {
+		return models.ImportedGame{}, err
+	}
+
+	roundIDs := make([]uint, rounds)
+	for i := range roundIDs {
+		round := models.Round{GameID: game.ID, Number: i + 1}
+		if err := tx.Create(&round).Error; err != nil {
+			return models.ImportedGame{}, err
+		}
+		roundIDs[i] = round.ID
+	}
+
+	for i, p := range src.Players {
+		player, err := importPlayer(tx, p.Name, players)
+		if err != nil {
+			return models.ImportedGame{}, err
+		}
+
+		faction := plan.factions[i]
+		gp := models.GamePlayer{
+			GameID:    game.ID,
+			PlayerID:  player.ID,
+			Faction:   faction.Name,
+			FactionID: &faction.ID,
+			Won:       i == plan.winner,
+		}
+		if err := tx.Create(&gp).Error; err != nil {
+			return models.ImportedGame{}, err
+		}
+		if i == plan.winner {
+			game.WinnerID = &player.ID
+		}
+
+		// Per-round points become one score per round; without them the
+		// final total is recorded against the last round.
+		points := p.RoundPoints
+		if len(points) == 0 {
+			if p.FinalPoints == nil {
+				continue
+			}
+			points = make([]int, rounds)
+			points[rounds-1] = *p.FinalPoints
+		}
+		for r, pts := range points {
+			if pts == 0 {
+				continue
+			}
+			score := models.Score{
+				GameID:    game.ID,
+				RoundID:   roundIDs[r],
+				PlayerID:  player.ID,
+				Points:    pts,
+				Type:      models.ScoreTypeImported,
+				CreatedAt: plan.date,
+			}
+			if err := tx.Create(&score).Error; err != nil {
+				return models.ImportedGame{}, err
+			}
+		}
+	}
+
+	if err := tx.Model(&game).Update("winner_id", game.WinnerID).Error; err != nil {
+		return models.ImportedGame{}, err
+	}
+
+	return models.ImportedGame{
+		Row:        src.Row,
+		Game:       src.Key,
+		GameID:     game.ID,
+		GameNumber: game.GameNumber,
+		Partial:    game.Partial,
+	}, nil
+}
+
+func importPlayer(tx *gorm.DB, name string, cache map[string]models.Player) (models.Player, error) {
+	name = strings.TrimSpace(name)
+	key := strings.ToLower(name)
+	if p, ok := cache[key]; ok {
+		return p, nil
+	}
+
+	player, found, err := FindPlayerByName(tx, name)
+	if err != nil {
+		return models.Player{}, err
+	}
+	if !found {
+		player = models.Player{Name: name}
+		if err := tx.Create(&player).Error; err != nil {
+			return models.Player{}, err
+		}
+	}
+
+	cache[key] = player
+	return player, nil
+}
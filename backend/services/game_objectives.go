@@ -16,7 +16,7 @@ func GetAllPublicObjectivesForGame(gameID string) ([]models.GameObjective, error
 	err := database.DB.
 		Preload("Objective").
 		Preload("Round").
-		Where("game_id = ? AND revealed = true", gameID).
+		Where("game_id = ? AND revealed = ?", gameID, true).
 		Find(&gameObjectives).Error
 	if err != nil {
 		return nil, err
@@ -24,7 +24,7 @@ func GetAllPublicObjectivesForGame(gameID string) ([]models.GameObjective, error
 
 	var scores []models.Score
 	err = database.DB.
-		Where("game_id = ? AND type = ? AND agenda_title = ?", gameID, "agenda", models.AgendaCDL).
+		Where("game_id = ? AND type = ? AND agenda_title = ?", gameID, models.ScoreTypeAgenda, models.AgendaCDL).
 		Find(&scores).Error
 	if err != nil {
 		return nil, err
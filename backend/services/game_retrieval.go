@@ -61,13 +61,7 @@ func BuildGameDetailResponse(gameID string) (models.GameDetailResponse, error) {
 	if game.WinnerID != nil {
 		vp, err := stats.CalculateVictoryPath(game.ID, *game.WinnerID)
 		if err == nil {
-			key := stats.FormatVictoryPathKey(vp)
-			if _, found := CachedVictoryPathCounts[key]; !found {
-				log.Printf("[VictoryPath] New key '%s' not found in cache. Refreshing cache.", key)
-				RefreshVictoryPathCache()
-			}
-
-			freq := CachedVictoryPathCounts[key]
+			freq := VictoryPathFrequency(stats.FormatVictoryPathKey(vp))
 			uniqueness := 100
 			if freq > 1 {
 				uniqueness = int(100.0 / float64(freq))
@@ -78,11 +72,6 @@ func BuildGameDetailResponse(gameID string) (models.GameDetailResponse, error) {
 				Frequency:  freq,
 				Uniqueness: uniqueness,
 			}
-			for k := range CachedVictoryPathCounts {
-				fmt.Println("  ", k)
-			}
-			log.Printf("[VictoryPath] Cache pointer address: %p", &CachedVictoryPathCounts)
-
 		}
 	}
 
@@ -90,7 +79,7 @@ func BuildGameDetailResponse(gameID string) (models.GameDetailResponse, error) {
 	scoreSummaryMap := make(map[uint]models.PlayerScoreSummary)
 
 	for _, s := range scores {
-		if s.Type == "mecatol" {
+		if s.Type == models.ScoreTypeMecatol {
 			custodiansPlayerID = &s.PlayerID
 		}
 		summary := scoreSummaryMap[s.PlayerID]
@@ -117,7 +106,11 @@ func BuildGameDetailResponse(gameID string) (models.GameDetailResponse, error) {
 			Type:             s.Type,
 			AgendaTitle:      s.AgendaTitle,
 			RelicTitle:       s.RelicTitle,
+			CardTitle:        s.CardTitle,
 			OriginallySecret: s.OriginallySecret,
+			Override:         s.Override,
+			OverrideReason:   s.OverrideReason,
+			Phase:            s.Phase,
 			CreatedAt:        s.CreatedAt,
 		})
 	}
@@ -168,6 +161,15 @@ func BuildGameDetailResponse(gameID string) (models.GameDetailResponse, error) {
 	var all []models.SpeakerAssignment
 	database.DB.Find(&all)
 
+	relicHoldings, err := GetRelicHoldings(game.ID)
+	if err != nil {
+		return models.GameDetailResponse{}, err
+	}
+	supportHoldings, err := GetSupportHoldings(game.ID)
+	if err != nil {
+		return models.GameDetailResponse{}, err
+	}
+
 	return models.GameDetailResponse{
 		ID:                 game.ID,
 		GameNumber:         game.GameNumber,
@@ -186,5 +188,12 @@ func BuildGameDetailResponse(gameID string) (models.GameDetailResponse, error) {
 		WinnerVictoryPath:  vpSummary,
 		SpeakerID:          speakerID,
 		SpeakerName:        speakerName,
+		Title:              game.Title,
+		Notes:              game.Notes,
+		Location:           game.Location,
+		HouseRules:         game.HouseRules,
+		TemplateID:         game.TemplateID,
+		Relics:             relicHoldings,
+		Support:            supportHoldings,
 	}, nil
 }
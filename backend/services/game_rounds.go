@@ -1,25 +1,43 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"log"
+	"time"
 
 	"github.com/arphillips06/TI4-stats/database"
 	"github.com/arphillips06/TI4-stats/helpers"
 	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
 )
 
-// Creates and advances to a new round
-func CreateNewRound(game *models.Game) (*models.Round, error) {
+// Creates and advances to a new round. The current round and any running
+// turn end as the new round starts, which begins in the strategy phase if
+// the game tracks phases.
+func CreateNewRound(ctx context.Context, game *models.Game) (*models.Round, error) {
+	db := database.DB.WithContext(ctx)
+	prev, err := currentRound(db, *game)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	now := time.Now()
+	if err := stopGameClock(db, game, now); err != nil {
+		return nil, err
+	}
 	newRound := models.Round{
-		GameID: game.ID,
-		Number: game.CurrentRound + 1,
+		GameID:    game.ID,
+		Number:    game.CurrentRound + 1,
+		StartedAt: &now,
 	}
-	if err := database.DB.Create(&newRound).Error; err != nil {
+	if prev.Phase != "" {
+		newRound.Phase = models.PhaseStrategy
+	}
+	if err := db.Create(&newRound).Error; err != nil {
 		return nil, err
 	}
 	game.CurrentRound = newRound.Number
-	if err := database.DB.Save(&game).Error; err != nil {
+	if err := db.Save(&game).Error; err != nil {
 		return nil, err
 	}
 	return &newRound, nil
@@ -38,10 +56,11 @@ func DetermineStageToReveal(gameID uint) string {
 }
 
 // Marks the next unrevealed objective of the given stage as revealed in the current round
-func RevealNextObjective(gameID, roundID uint, stage string) error {
+func RevealNextObjective(ctx context.Context, gameID, roundID uint, stage string) error {
+	db := database.DB.WithContext(ctx)
 	var obj models.GameObjective
-	err := database.DB.
-		Where("game_id = ? AND round_id = 0 AND stage = ? AND revealed = false", gameID, stage).
+	err := db.
+		Where("game_id = ? AND round_id = 0 AND stage = ? AND revealed = ?", gameID, stage, false).
 		Order("position ASC").
 		First(&obj).Error
 	if err != nil {
@@ -50,7 +69,7 @@ func RevealNextObjective(gameID, roundID uint, stage string) error {
 	obj.RoundID = roundID
 	obj.Revealed = true
 
-	return database.DB.Save(&obj).Error
+	return db.Save(&obj).Error
 }
 
 // Counts total number of revealed public objectives for a game
@@ -62,31 +81,35 @@ func CountRevealedObjectives(gameID uint) int64 {
 	return count
 }
 
-func AdvanceGameRound(gameID uint) (map[string]any, error) {
+func AdvanceGameRound(ctx context.Context, gameID uint) (*models.AdvanceRoundResponse, error) {
+	db := database.DB.WithContext(ctx)
 	game, err := helpers.GetUnfinishedGame(gameID)
 	if err != nil {
 		return nil, err
 	}
+	if err := requireRoundEnd(game); err != nil {
+		return nil, err
+	}
 
 	if game.CurrentRound >= 9 {
-		if err := MaybeFinishGameFromExhaustion(game); err != nil {
+		if err := MaybeFinishGameFromExhaustion(ctx, game); err != nil {
 			return nil, errors.New("failed to finish game")
 		}
-		return map[string]any{
-			"message":       "Game Ended",
-			"round":         game.CurrentRound,
-			"totalRevealed": CountRevealedObjectives(game.ID),
-			"winner_id":     game.WinnerID,
+		return &models.AdvanceRoundResponse{
+			Message:       "Game Ended",
+			Round:         game.CurrentRound,
+			TotalRevealed: CountRevealedObjectives(game.ID),
+			WinnerID:      game.WinnerID,
 		}, nil
 	}
 
-	newRound, err := CreateNewRound(game)
+	newRound, err := CreateNewRound(ctx, game)
 	if err != nil {
 		return nil, errors.New("failed to create new round")
 	}
 
 	var lastAssignment models.SpeakerAssignment
-	err = database.DB.
+	err = db.
 		Where("game_id = ?", gameID).
 		Order("round_id DESC").
 		First(&lastAssignment).Error
@@ -97,7 +120,7 @@ func AdvanceGameRound(gameID uint) (map[string]any, error) {
 			RoundID:  newRound.ID,
 			PlayerID: lastAssignment.PlayerID,
 		}
-		if err := database.DB.Create(&newAssignment).Error; err != nil {
+		if err := db.Create(&newAssignment).Error; err != nil {
 			log.Printf("failed to copy speaker assignment: %v", err)
 		} else {
 			log.Printf("copied speaker assignment: player %d -> round %d", newAssignment.PlayerID, newAssignment.RoundID)
@@ -107,12 +130,12 @@ func AdvanceGameRound(gameID uint) (map[string]any, error) {
 	}
 
 	stage := DetermineStageToReveal(game.ID)
-	_ = RevealNextObjective(game.ID, newRound.ID, stage)
+	_ = RevealNextObjective(ctx, game.ID, newRound.ID, stage)
 
-	return map[string]any{
-		"message":       "round_advanced",
-		"current_round": game.CurrentRound,
-		"revealed":      stage,
-		"totalRevealed": CountRevealedObjectives(game.ID),
+	return &models.AdvanceRoundResponse{
+		Message:       "round_advanced",
+		CurrentRound:  game.CurrentRound,
+		Revealed:      stage,
+		TotalRevealed: CountRevealedObjectives(game.ID),
 	}, nil
 }
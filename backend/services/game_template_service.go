@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/factions"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+// ListGameTemplates returns every saved template by name.
+func ListGameTemplates() ([]models.GameTemplate, error) {
+	out := []models.GameTemplate{}
+	err := database.DB.Order("name").Find(&out).Error
+	return out, err
+}
+
+// GetGameTemplate returns gorm.ErrRecordNotFound for an unknown id.
+func GetGameTemplate(id uint) (models.GameTemplate, error) {
+	var t models.GameTemplate
+	err := database.DB.First(&t, id).Error
+	return t, err
+}
+
+// CreateGameTemplate saves a new template once its factions are known.
+func CreateGameTemplate(ctx context.Context, t models.GameTemplate) (models.GameTemplate, error) {
+	t.ID = 0
+	if err := normaliseGameTemplate(&t); err != nil {
+		return t, err
+	}
+	err := database.DB.WithContext(ctx).Create(&t).Error
+	return t, err
+}
+
+// UpdateGameTemplate replaces a template. Games already created from it
+// keep the setup they were made with.
+func UpdateGameTemplate(ctx context.Context, id uint, t models.GameTemplate) (models.GameTemplate, error) {
+	existing, err := GetGameTemplate(id)
+	if err != nil {
+		return t, err
+	}
+	t.ID = existing.ID
+	t.CreatedAt = existing.CreatedAt
+	if err := normaliseGameTemplate(&t); err != nil {
+		return t, err
+	}
+	err = database.DB.WithContext(ctx).Save(&t).Error
+	return t, err
+}
+
+// DeleteGameTemplate removes a template; games made from it are kept.
+func DeleteGameTemplate(ctx context.Context, id uint) error {
+	res := database.DB.WithContext(ctx).Delete(&models.GameTemplate{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// normaliseGameTemplate trims the name, fills in the speaker mode and
+// stores every faction under its catalogue name.
+func normaliseGameTemplate(t *models.GameTemplate) error {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.SpeakerMode == "" {
+		t.SpeakerMode = models.SpeakerModeRandom
+	}
+
+	var fields []models.FieldError
+	var count int64
+	if err := database.DB.Model(&models.GameTemplate{}).
+		Where("LOWER(name) = ? AND id <> ?", strings.ToLower(t.Name), t.ID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		fields = append(fields, handle.Invalid("name", "a template called %q already exists", t.Name).Fields...)
+	}
+
+	index, err := LoadFactionIndex()
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	for i, name := range t.FactionPool {
+		faction, ok := index.Lookup(name)
+		switch {
+		case !ok:
+			fields = append(fields, handle.Invalid(fmt.Sprintf("faction_pool[%d]", i), "unknown faction %q", name).Fields...)
+		case seen[faction.Name]:
+			fields = append(fields, handle.Invalid(fmt.Sprintf("faction_pool[%d]", i), "%s is already in the pool", faction.Name).Fields...)
+		default:
+			t.FactionPool[i] = faction.Name
+			seen[faction.Name] = true
+		}
+	}
+	fields = append(fields, resolveSeatFactions(index, t.Players, "players")...)
+	for i := range t.HouseRules {
+		t.HouseRules[i] = strings.TrimSpace(t.HouseRules[i])
+	}
+	if len(fields) > 0 {
+		return &handle.ValidationError{Fields: fields}
+	}
+	return nil
+}
+
+// resolveSeatFactions replaces the factions chosen for seats with their
+// catalogue names. Unknown factions, and factions chosen twice, are
+// reported under field.
+func resolveSeatFactions(index factions.Index, seats []models.GameTemplatePlayer, field string) []models.FieldError {
+	var fields []models.FieldError
+	taken := map[string]bool{}
+	for i, seat := range seats {
+		if strings.TrimSpace(seat.Faction) == "" {
+			seats[i].Faction = ""
+			continue
+		}
+		faction, ok := index.Lookup(seat.Faction)
+		switch {
+		case !ok:
+			fields = append(fields, handle.Invalid(fmt.Sprintf("%s[%d].faction", field, i), "unknown faction %q", seat.Faction).Fields...)
+		case taken[faction.Name]:
+			fields = append(fields, handle.Invalid(fmt.Sprintf("%s[%d].faction", field, i), "%s is already taken", faction.Name).Fields...)
+		default:
+			seats[i].Faction = faction.Name
+			taken[faction.Name] = true
+		}
+	}
+	return fields
+}
+
+// CreateGameFromTemplate creates a game with the template's settings.
+// Seats without a faction draw one from the pool that nobody else has.
+func CreateGameFromTemplate(ctx context.Context, id uint, req models.GameFromTemplateRequest) (models.Game, []models.GameObjective, error) {
+	t, err := GetGameTemplate(id)
+	if err != nil {
+		return models.Game{}, nil, err
+	}
+
+	seats, field := t.Players, "players"
+	if len(req.Players) > 0 {
+		seats = req.Players
+	}
+	if len(seats) < 3 || len(seats) > 8 {
+		return models.Game{}, nil, handle.Invalid(field, "a game needs 3 to 8 players, not %d", len(seats))
+	}
+
+	index, err := LoadFactionIndex()
+	if err != nil {
+		return models.Game{}, nil, err
+	}
+	if fields := resolveSeatFactions(index, seats, field); len(fields) > 0 {
+		return models.Game{}, nil, &handle.ValidationError{Fields: fields}
+	}
+
+	pool := t.FactionPool
+	if len(pool) == 0 {
+		all, err := ListFactions("")
+		if err != nil {
+			return models.Game{}, nil, err
+		}
+		for _, f := range all {
+			pool = append(pool, f.Name)
+		}
+	}
+	taken := map[string]bool{}
+	open := 0
+	for _, seat := range seats {
+		if seat.Faction == "" {
+			open++
+		} else {
+			taken[seat.Faction] = true
+		}
+	}
+	var free []string
+	for _, name := range pool {
+		if !taken[name] {
+			free = append(free, name)
+		}
+	}
+	if len(free) < open {
+		return models.Game{}, nil, handle.Invalid("faction_pool", "has %d factions left for %d players without one", len(free), open)
+	}
+	rand.Shuffle(len(free), func(i, j int) { free[i], free[j] = free[j], free[i] })
+
+	players := make([]models.PlayerInput, len(seats))
+	for i, seat := range seats {
+		faction := seat.Faction
+		if faction == "" {
+			faction, free = free[0], free[1:]
+		}
+		players[i] = models.PlayerInput{Name: seat.Name, Faction: faction}
+	}
+
+	return createGame(ctx, gameSetup{
+		winningPoints: t.WinningPoints,
+		useDecks:      t.UseObjectiveDecks,
+		trackPhases:   t.TrackPhases,
+		speakerMode:   t.SpeakerMode,
+		players:       players,
+		title:         req.Title,
+		notes:         req.Notes,
+		location:      req.Location,
+		houseRules:    t.HouseRules,
+		templateID:    &t.ID,
+	})
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// invalidFields returns the fields named by a *handle.ValidationError.
+func invalidFields(t *testing.T, err error) []string {
+	t.Helper()
+	var invalid *handle.ValidationError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("got %v, want a validation error", err)
+	}
+	var out []string
+	for _, f := range invalid.Fields {
+		out = append(out, f.Field)
+	}
+	return out
+}
+
+func TestNormaliseGameTemplate(t *testing.T) {
+	dbtest.Open(t, "sqlite")
+	ctx := context.Background()
+
+	tmpl, err := CreateGameTemplate(ctx, models.GameTemplate{
+		Name:          " Weekly ",
+		WinningPoints: 12,
+		FactionPool:   []string{"hacan", "Arborec"},
+		Players:       []models.GameTemplatePlayer{{Name: "Alice", Faction: "sol"}, {Name: "Bob", Faction: " "}},
+		HouseRules:    []string{" no_custodians "},
+	})
+	if err != nil {
+		t.Fatalf("CreateGameTemplate: %v", err)
+	}
+	if tmpl.Name != "Weekly" || tmpl.SpeakerMode != models.SpeakerModeRandom {
+		t.Errorf("name %q and speaker mode %q, want Weekly and random", tmpl.Name, tmpl.SpeakerMode)
+	}
+	if want := []string{"Emirates of Hacan", "Arborec"}; !reflect.DeepEqual(tmpl.FactionPool, want) {
+		t.Errorf("FactionPool = %v, want %v", tmpl.FactionPool, want)
+	}
+	if tmpl.Players[0].Faction != "Federation of Sol" || tmpl.Players[1].Faction != "" {
+		t.Errorf("Players = %+v, want Alice on Federation of Sol and Bob without a faction", tmpl.Players)
+	}
+	if tmpl.HouseRules[0] != "no_custodians" {
+		t.Errorf("HouseRules = %q, want the rule trimmed", tmpl.HouseRules)
+	}
+
+	_, err = CreateGameTemplate(ctx, models.GameTemplate{
+		Name:          "weekly",
+		WinningPoints: 10,
+		FactionPool:   []string{"Arborec", "nobody", "the arborec"},
+		Players:       []models.GameTemplatePlayer{{Name: "Alice", Faction: "hacan"}, {Name: "Bob", Faction: "Hacan"}, {Name: "Carol", Faction: "nobody"}},
+	})
+	want := []string{"name", "faction_pool[1]", "faction_pool[2]", "players[1].faction", "players[2].faction"}
+	if got := invalidFields(t, err); !reflect.DeepEqual(got, want) {
+		t.Errorf("invalid fields = %v, want %v", got, want)
+	}
+
+	// Saving a template under its own name is not a clash.
+	tmpl.SpeakerMode = models.SpeakerModeNone
+	if _, err := UpdateGameTemplate(ctx, tmpl.ID, tmpl); err != nil {
+		t.Errorf("UpdateGameTemplate: %v", err)
+	}
+}
+
+func TestCreateGameFromTemplate(t *testing.T) {
+	dbtest.Open(t, "sqlite")
+	ctx := context.Background()
+
+	tmpl, err := CreateGameTemplate(ctx, models.GameTemplate{
+		Name:          "Short game",
+		WinningPoints: 6,
+		SpeakerMode:   models.SpeakerModeFirst,
+		FactionPool:   []string{"Emirates of Hacan", "Arborec", "Federation of Sol"},
+		Players:       []models.GameTemplatePlayer{{Name: "Alice", Faction: "Federation of Sol"}, {Name: "Bob"}, {Name: "Carol"}},
+		HouseRules:    []string{"no_custodians"},
+	})
+	if err != nil {
+		t.Fatalf("CreateGameTemplate: %v", err)
+	}
+
+	game, _, err := CreateGameFromTemplate(ctx, tmpl.ID, models.GameFromTemplateRequest{Title: "Friday"})
+	if err != nil {
+		t.Fatalf("CreateGameFromTemplate: %v", err)
+	}
+	if game.WinningPoints != 6 || game.TemplateID == nil || *game.TemplateID != tmpl.ID || game.Title != "Friday" {
+		t.Errorf("game = %d points, template %v, title %q; want 6, %d and Friday", game.WinningPoints, game.TemplateID, game.Title, tmpl.ID)
+	}
+	if !reflect.DeepEqual(game.HouseRules, tmpl.HouseRules) {
+		t.Errorf("HouseRules = %v, want the template's %v", game.HouseRules, tmpl.HouseRules)
+	}
+
+	var seats []models.GamePlayer
+	if err := database.DB.Preload("Player").Where("game_id = ?", game.ID).Order("id").Find(&seats).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(seats) != 3 || seats[0].Player.Name != "Alice" || seats[0].Faction != "Federation of Sol" {
+		t.Fatalf("seats = %+v, want Alice first on Federation of Sol", seats)
+	}
+	drawn := []string{seats[1].Faction, seats[2].Faction}
+	sort.Strings(drawn)
+	if want := []string{"Arborec", "Emirates of Hacan"}; !reflect.DeepEqual(drawn, want) {
+		t.Errorf("Bob and Carol drew %v, want the rest of the pool %v", drawn, want)
+	}
+	if game.SpeakerID == nil || *game.SpeakerID != seats[0].ID {
+		t.Errorf("speaker = %v, want the first seat %d", game.SpeakerID, seats[0].ID)
+	}
+
+	// Players given with the request replace the template's.
+	four := []models.GameTemplatePlayer{{Name: "Alice"}, {Name: "Bob"}, {Name: "Carol"}, {Name: "Dave"}}
+	_, _, err = CreateGameFromTemplate(ctx, tmpl.ID, models.GameFromTemplateRequest{Players: four})
+	if got := invalidFields(t, err); !reflect.DeepEqual(got, []string{"faction_pool"}) {
+		t.Errorf("four players drawing from a pool of three: invalid fields %v, want faction_pool", got)
+	}
+	_, _, err = CreateGameFromTemplate(ctx, tmpl.ID, models.GameFromTemplateRequest{Players: four[:2]})
+	if got := invalidFields(t, err); !reflect.DeepEqual(got, []string{"players"}) {
+		t.Errorf("two players: invalid fields %v, want players", got)
+	}
+
+	// An empty pool draws from every faction; no speaker is picked.
+	tmpl.FactionPool, tmpl.SpeakerMode = nil, models.SpeakerModeNone
+	if _, err := UpdateGameTemplate(ctx, tmpl.ID, tmpl); err != nil {
+		t.Fatalf("UpdateGameTemplate: %v", err)
+	}
+	game, _, err = CreateGameFromTemplate(ctx, tmpl.ID, models.GameFromTemplateRequest{Players: four})
+	if err != nil {
+		t.Fatalf("CreateGameFromTemplate with an empty pool: %v", err)
+	}
+	if game.SpeakerID != nil {
+		t.Errorf("speaker = %d, want none", *game.SpeakerID)
+	}
+	if err := database.DB.Where("game_id = ?", game.ID).Find(&seats).Error; err != nil {
+		t.Fatal(err)
+	}
+	factions := map[string]bool{}
+	for _, s := range seats {
+		factions[s.Faction] = true
+	}
+	if len(seats) != 4 || len(factions) != 4 || factions[""] {
+		t.Errorf("seats = %+v, want four players on four different factions", seats)
+	}
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+// nextPhase is the phase that follows each phase. The agenda phase only
+// follows the status phase once Custodians has been claimed; otherwise the
+// round ends after the status phase.
+var nextPhase = map[string]string{
+	models.PhaseStrategy: models.PhaseAction,
+	models.PhaseAction:   models.PhaseStatus,
+	models.PhaseStatus:   models.PhaseAgenda,
+}
+
+func custodiansClaimed(tx *gorm.DB, gameID uint) (bool, error) {
+	var count int64
+	err := tx.Model(&models.Score{}).
+		Where("game_id = ? AND type = ?", gameID, models.ScoreTypeMecatol).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func phaseState(tx *gorm.DB, game models.Game, round models.Round) (models.PhaseState, error) {
+	claimed, err := custodiansClaimed(tx, game.ID)
+	if err != nil {
+		return models.PhaseState{}, err
+	}
+	state := models.PhaseState{Round: game.CurrentRound, Phase: round.Phase, CustodiansClaimed: claimed}
+	if round.Phase != "" {
+		state.Next = nextPhase[round.Phase]
+		if state.Next == models.PhaseAgenda && !claimed {
+			state.Next = ""
+		}
+	}
+	return state, nil
+}
+
+// GetPhase returns the phase of a game's current round.
+func GetPhase(gameID uint) (models.PhaseState, error) {
+	var game models.Game
+	if err := database.DB.First(&game, gameID).Error; err != nil {
+		return models.PhaseState{}, err
+	}
+	round, err := currentRound(database.DB, game)
+	if err != nil {
+		return models.PhaseState{}, err
+	}
+	return phaseState(database.DB, game, round)
+}
+
+// SetPhase moves the current round to phase, or to the next phase when
+// phase is empty. A game that does not track phases yet can start in any
+// phase. Any running turn is stopped.
+func SetPhase(ctx context.Context, gameID uint, phase string) (models.PhaseState, error) {
+	phase = strings.ToLower(strings.TrimSpace(phase))
+
+	var state models.PhaseState
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var game models.Game
+		if err := tx.First(&game, gameID).Error; err != nil {
+			return err
+		}
+		if game.FinishedAt != nil {
+			return handle.Rule("game %d is finished", gameID)
+		}
+		round, err := currentRound(tx, game)
+		if err != nil {
+			return err
+		}
+
+		next, tracked := nextPhase[round.Phase], round.Phase != ""
+		switch {
+		case phase == "" && !tracked:
+			phase = models.PhaseStrategy
+		case phase == "" && next == "":
+			return handle.Rule("the %s phase is the last in the round; advance the round instead", round.Phase)
+		case phase == "":
+			phase = next
+		case !slices.Contains(models.Phases, phase):
+			return handle.Invalid("phase", "%q is not one of %s", phase, strings.Join(models.Phases, ", "))
+		case tracked && phase != next:
+			return handle.Rule("cannot move from the %s phase to the %s phase", round.Phase, phase)
+		}
+
+		if phase == models.PhaseAgenda {
+			claimed, err := custodiansClaimed(tx, gameID)
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				return handle.Rule("the agenda phase is locked until Custodians is claimed")
+			}
+		}
+
+		if _, err := stopRunningTurn(tx, gameID, time.Now()); err != nil {
+			return err
+		}
+		if err := tx.Model(&round).Update("phase", phase).Error; err != nil {
+			return err
+		}
+		state, err = phaseState(tx, game, round)
+		return err
+	})
+	return state, err
+}
+
+// RequirePhase fails with a *handle.RuleError unless the game's current round is
+// in one of the allowed phases. Games that do not track phases allow
+// everything.
+func RequirePhase(gameID uint, action string, allowed ...string) error {
+	var game models.Game
+	if err := database.DB.First(&game, gameID).Error; err != nil {
+		return err
+	}
+	round, err := currentRound(database.DB, game)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if round.Phase == "" || slices.Contains(allowed, round.Phase) {
+		return nil
+	}
+	return handle.Rule("%s is not allowed in the %s phase (allowed: %s)", action, round.Phase, strings.Join(allowed, ", "))
+}
+
+// requireObjectivePhase checks that an objective can be scored now: public
+// objectives in the status phase and secrets in the phase printed on them.
+func requireObjectivePhase(gameID uint, objective models.Objective) error {
+	phase := models.PhaseStatus
+	if strings.EqualFold(objective.Type, string(models.ScoreTypeSecret)) && objective.Phase != "" {
+		phase = strings.ToLower(objective.Phase)
+	}
+	return RequirePhase(gameID, fmt.Sprintf("scoring %s", objective.Name), phase)
+}
+
+// requireRoundEnd checks that a game that tracks phases has reached the end
+// of its round: the agenda phase once Custodians is claimed, the status
+// phase before.
+func requireRoundEnd(game *models.Game) error {
+	round, err := currentRound(database.DB, *game)
+	if err != nil || round.Phase == "" {
+		return nil
+	}
+	claimed, err := custodiansClaimed(database.DB, game.ID)
+	if err != nil {
+		return err
+	}
+	last := models.PhaseStatus
+	if claimed {
+		last = models.PhaseAgenda
+	}
+	if round.Phase != last {
+		return handle.Rule("round %d is in the %s phase; the round ends after the %s phase", game.CurrentRound, round.Phase, last)
+	}
+	return nil
+}
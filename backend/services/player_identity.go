@@ -0,0 +1,315 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+// ErrPlayerNotFound is returned for a player ID that does not exist. It
+// wraps gorm.ErrRecordNotFound, which Handle answers with 404.
+var ErrPlayerNotFound = fmt.Errorf("player %w", gorm.ErrRecordNotFound)
+
+// UnknownPlayerError is returned when a game names a player that does not
+// exist but is close to one that does, so a typo does not quietly create a
+// second player.
+type UnknownPlayerError struct {
+	Name        string
+	Suggestions []string
+}
+
+func (e *UnknownPlayerError) Error() string {
+	return fmt.Sprintf("unknown player %q; did you mean %s? Set New to create a new player",
+		e.Name, strings.Join(e.Suggestions, " or "))
+}
+
+func playerKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// GetPlayer returns a player with their aliases.
+func GetPlayer(id uint) (models.Player, error) {
+	var player models.Player
+	err := database.DB.Preload("Aliases").First(&player, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return player, ErrPlayerNotFound
+	}
+	return player, err
+}
+
+// FindPlayerByName matches a player's name or one of their aliases,
+// ignoring case.
+func FindPlayerByName(tx *gorm.DB, name string) (models.Player, bool, error) {
+	key := playerKey(name)
+	var player models.Player
+	err := tx.Where("LOWER(name) = ?", key).Order("id").First(&player).Error
+	if err == nil {
+		return player, true, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return player, false, err
+	}
+
+	err = tx.Joins("JOIN player_aliases pa ON pa.player_id = players.id").
+		Where("pa.alias = ?", key).
+		First(&player).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return player, false, nil
+	}
+	return player, err == nil, err
+}
+
+// suggestPlayers returns the names of players whose name or alias is a
+// likely typo of name, closest first.
+func suggestPlayers(tx *gorm.DB, name string) ([]string, error) {
+	var players []models.Player
+	if err := tx.Preload("Aliases").Find(&players).Error; err != nil {
+		return nil, err
+	}
+
+	key := playerKey(name)
+	maxDistance := 1
+	if len([]rune(key)) >= 5 {
+		maxDistance = 2
+	}
+
+	best := make(map[string]int)
+	for _, p := range players {
+		candidates := []string{playerKey(p.Name)}
+		for _, a := range p.Aliases {
+			candidates = append(candidates, a.Alias)
+		}
+		for _, c := range candidates {
+			d := editDistance(key, c)
+			if d > maxDistance {
+				continue
+			}
+			if prev, ok := best[p.Name]; !ok || d < prev {
+				best[p.Name] = d
+			}
+		}
+	}
+
+	out := make([]string, 0, len(best))
+	for n := range best {
+		out = append(out, n)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if best[out[i]] != best[out[j]] {
+			return best[out[i]] < best[out[j]]
+		}
+		return out[i] < out[j]
+	})
+	return out, nil
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// requireNameFree fails if name is used by any player other than exceptID,
+// either as a name or an alias.
+func requireNameFree(tx *gorm.DB, name string, exceptID uint) error {
+	existing, found, err := FindPlayerByName(tx, name)
+	if err != nil {
+		return err
+	}
+	if found && existing.ID != exceptID {
+		return handle.Rule("%q is already used by %s", strings.TrimSpace(name), existing.Name)
+	}
+	return nil
+}
+
+func addAlias(tx *gorm.DB, playerID uint, alias string) error {
+	key := playerKey(alias)
+	var count int64
+	if err := tx.Model(&models.PlayerAlias{}).
+		Where("player_id = ? AND alias = ?", playerID, key).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return tx.Create(&models.PlayerAlias{PlayerID: playerID, Alias: key}).Error
+}
+
+// RenamePlayer changes a player's name, optionally keeping the old one as
+// an alias.
+func RenamePlayer(ctx context.Context, id uint, req models.RenamePlayerRequest) (models.Player, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return models.Player{}, handle.Invalid("name", "is required")
+	}
+	player, err := GetPlayer(id)
+	if err != nil {
+		return player, err
+	}
+
+	err = database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := requireNameFree(tx, name, id); err != nil {
+			return err
+		}
+		// The new name no longer needs to be an alias of its own.
+		if err := tx.Where("player_id = ? AND alias = ?", id, playerKey(name)).
+			Delete(&models.PlayerAlias{}).Error; err != nil {
+			return err
+		}
+		if req.KeepAlias && playerKey(player.Name) != playerKey(name) {
+			if err := addAlias(tx, id, player.Name); err != nil {
+				return err
+			}
+		}
+		return tx.Model(&player).Update("name", name).Error
+	})
+	if err != nil {
+		return player, err
+	}
+
+	return GetPlayer(id)
+}
+
+// playerReferences lists every column that stores a player ID. Speaker
+// columns hold game player IDs and follow game_players.player_id.
+var playerReferences = []struct {
+	model  any
+	column string
+}{
+	{&models.GamePlayer{}, "player_id"},
+	{&models.Score{}, "player_id"},
+	{&models.PlayerAchievement{}, "player_id"},
+	{&models.RelicHolding{}, "player_id"},
+	{&models.SupportHolding{}, "owner_id"},
+	{&models.SupportHolding{}, "holder_id"},
+	{&models.Game{}, "winner_id"},
+	{&models.Turn{}, "player_id"},
+	{&models.SessionRSVP{}, "player_id"},
+	{&models.PlayerAlias{}, "player_id"},
+}
+
+// MergePlayers moves everything recorded against sourceID to targetID in
+// one transaction, then deletes the source player. The source's name is
+// kept as an alias so later lookups find the merged player.
+func MergePlayers(ctx context.Context, sourceID, targetID uint) (models.Player, error) {
+	if sourceID == targetID {
+		return models.Player{}, handle.Rule("cannot merge a player into themselves")
+	}
+	source, err := GetPlayer(sourceID)
+	if err != nil {
+		return source, err
+	}
+	target, err := GetPlayer(targetID)
+	if err != nil {
+		return target, err
+	}
+
+	err = database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var shared int64
+		if err := tx.Model(&models.GamePlayer{}).
+			Where("player_id = ? AND game_id IN (?)", sourceID,
+				tx.Model(&models.GamePlayer{}).Select("game_id").Where("player_id = ?", targetID)).
+			Count(&shared).Error; err != nil {
+			return err
+		}
+		if shared > 0 {
+			return handle.Rule("%s and %s played in the same game; they cannot be the same person", source.Name, target.Name)
+		}
+
+		// A player answers each session once; the target's answer wins.
+		if err := tx.Where("player_id = ? AND session_id IN (?)", sourceID,
+			tx.Model(&models.SessionRSVP{}).Select("session_id").Where("player_id = ?", targetID)).
+			Delete(&models.SessionRSVP{}).Error; err != nil {
+			return err
+		}
+
+		for _, ref := range playerReferences {
+			if err := tx.Model(ref.model).
+				Where(ref.column+" = ?", sourceID).
+				Update(ref.column, targetID).Error; err != nil {
+				return fmt.Errorf("reassign %s: %w", ref.column, err)
+			}
+		}
+		if playerKey(source.Name) != playerKey(target.Name) {
+			if err := addAlias(tx, targetID, source.Name); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&models.Player{}, sourceID).Error
+	})
+	if err != nil {
+		return target, err
+	}
+
+	return GetPlayer(targetID)
+}
+
+// AddPlayerAlias registers another name the player is found by.
+func AddPlayerAlias(ctx context.Context, id uint, alias string) (models.Player, error) {
+	if playerKey(alias) == "" {
+		return models.Player{}, handle.Invalid("alias", "is required")
+	}
+	if _, err := GetPlayer(id); err != nil {
+		return models.Player{}, err
+	}
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := requireNameFree(tx, alias, id); err != nil {
+			return err
+		}
+		return addAlias(tx, id, alias)
+	})
+	if err != nil {
+		return models.Player{}, err
+	}
+	return GetPlayer(id)
+}
+
+// RemovePlayerAlias forgets one of a player's aliases.
+func RemovePlayerAlias(ctx context.Context, id uint, alias string) (models.Player, error) {
+	res := database.DB.WithContext(ctx).Where("player_id = ? AND alias = ?", id, playerKey(alias)).Delete(&models.PlayerAlias{})
+	if res.Error != nil {
+		return models.Player{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return models.Player{}, handle.Rule("%q is not an alias of player %d", alias, id)
+	}
+	return GetPlayer(id)
+}
+
+// SetPlayerActive retires or reactivates a player. Retired players keep
+// their history but are left off leaderboards.
+func SetPlayerActive(ctx context.Context, id uint, active bool) (models.Player, error) {
+	player, err := GetPlayer(id)
+	if err != nil {
+		return player, err
+	}
+	if err := database.DB.WithContext(ctx).Model(&player).Update("active", active).Error; err != nil {
+		return player, err
+	}
+
+	return GetPlayer(id)
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// TestMergePlayers merges Robert, who played one game with Carol, into Bob.
+// Robert has a row behind every entry in playerReferences.
+func TestMergePlayers(t *testing.T) {
+	dbtest.Open(t, "sqlite")
+	db := database.DB
+	ctx := context.Background()
+	dbtest.SeedFinishedGame(t)
+
+	byName := map[string]uint{}
+	var players []models.Player
+	if err := db.Find(&players).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range players {
+		byName[p.Name] = p.ID
+	}
+	robert := models.Player{Name: "Robert"}
+	if err := db.Create(&robert).Error; err != nil {
+		t.Fatal(err)
+	}
+	bob, carol := byName["Bob"], byName["Carol"]
+
+	game := models.Game{GameNumber: 2, WinningPoints: 10, WinnerID: &robert.ID, CurrentRound: 1}
+	if err := db.Create(&game).Error; err != nil {
+		t.Fatal(err)
+	}
+	round := models.Round{GameID: game.ID, Number: 1}
+	if err := db.Create(&round).Error; err != nil {
+		t.Fatal(err)
+	}
+	sessions := []models.GameSession{{GameID: game.ID}, {GameID: game.ID}}
+	if err := db.Create(&sessions).Error; err != nil {
+		t.Fatal(err)
+	}
+	rows := []any{
+		&[]models.GamePlayer{{GameID: game.ID, PlayerID: robert.ID, Won: true}, {GameID: game.ID, PlayerID: carol}},
+		&models.Score{GameID: game.ID, RoundID: round.ID, PlayerID: robert.ID, Points: 1, Type: models.ScoreTypeImperial},
+		&models.PlayerAchievement{PlayerID: robert.ID, AchievementID: 1, GameID: &game.ID, AwardedAt: time.Now()},
+		&models.RelicHolding{GameID: game.ID, RelicID: 1, PlayerID: robert.ID, RoundID: round.ID},
+		&models.SupportHolding{GameID: game.ID, OwnerID: &robert.ID, HolderID: carol, RoundID: round.ID},
+		&models.SupportHolding{GameID: game.ID, OwnerID: &carol, HolderID: robert.ID, RoundID: round.ID},
+		&models.Turn{GameID: game.ID, RoundID: round.ID, PlayerID: robert.ID, StartedAt: time.Now()},
+		&[]models.SessionRSVP{
+			{SessionID: sessions[0].ID, PlayerID: robert.ID, Status: "yes"},
+			{SessionID: sessions[1].ID, PlayerID: robert.ID, Status: "no"},
+			{SessionID: sessions[1].ID, PlayerID: bob, Status: "yes"},
+		},
+		&models.PlayerAlias{PlayerID: robert.ID, Alias: "bert"},
+	}
+	for _, row := range rows {
+		if err := db.Create(row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := MergePlayers(ctx, bob, bob); !handle.IsRule(err) {
+		t.Errorf("merging Bob into himself: got %v, want a rule error", err)
+	}
+	// Carol played in both games, so she cannot be Robert.
+	if _, err := MergePlayers(ctx, robert.ID, carol); !handle.IsRule(err) {
+		t.Errorf("merging players from the same game: got %v, want a rule error", err)
+	}
+	var stillRobert int64
+	if err := db.Model(&models.Score{}).Where("player_id = ?", robert.ID).Count(&stillRobert).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stillRobert != 1 {
+		t.Errorf("a refused merge moved Robert's score")
+	}
+
+	merged, err := MergePlayers(ctx, robert.ID, bob)
+	if err != nil {
+		t.Fatalf("MergePlayers: %v", err)
+	}
+
+	for _, ref := range playerReferences {
+		var left, moved int64
+		if err := db.Model(ref.model).Where(ref.column+" = ?", robert.ID).Count(&left).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Model(ref.model).Where(ref.column+" = ?", bob).Count(&moved).Error; err != nil {
+			t.Fatal(err)
+		}
+		if left != 0 || moved == 0 {
+			t.Errorf("%T.%s: %d rows left with Robert and %d with Bob, want none and some", ref.model, ref.column, left, moved)
+		}
+	}
+
+	var answers []models.SessionRSVP
+	if err := db.Where("session_id = ?", sessions[1].ID).Find(&answers).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(answers) != 1 || answers[0].PlayerID != bob || answers[0].Status != "yes" {
+		t.Errorf("answers to a session both had answered = %+v, want Bob's yes", answers)
+	}
+
+	if _, err := GetPlayer(robert.ID); !errors.Is(err, ErrPlayerNotFound) {
+		t.Errorf("Robert after the merge: got %v, want ErrPlayerNotFound", err)
+	}
+	var aliases []string
+	for _, a := range merged.Aliases {
+		aliases = append(aliases, a.Alias)
+	}
+	sort.Strings(aliases)
+	if len(aliases) != 2 || aliases[0] != "bert" || aliases[1] != "robert" {
+		t.Errorf("Bob's aliases = %v, want bert and robert", aliases)
+	}
+}
@@ -1,13 +1,19 @@
 package services
 
 import (
+	"context"
+	"errors"
+
 	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers"
 	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
 )
 
-func CreatePlayer(name string) (models.Player, error) {
+func CreatePlayer(ctx context.Context, name string) (models.Player, error) {
 	player := models.Player{Name: name}
-	err := database.DB.Create(&player).Error
+	err := database.DB.WithContext(ctx).Create(&player).Error
 	return player, err
 }
 
@@ -17,27 +23,65 @@ func GetPlayersInGame(gameID string) ([]models.GamePlayer, error) {
 	return gamePlayers, err
 }
 
-func GetGamesForPlayer(playerID string) (models.Player, error) {
+// GetGamesForPlayer returns the player and the requested page of their games,
+// along with the total number of games they have played.
+func GetGamesForPlayer(playerID string, opts helpers.ListOptions) (models.Player, []models.GamePlayer, int64, error) {
 	var player models.Player
-	err := database.DB.
-		Preload("Games.Game").
-		Preload("Games.Game.GamePlayers.Player").
-		First(&player, playerID).Error
-	return player, err
+	if err := database.DB.First(&player, playerID).Error; err != nil {
+		return player, nil, 0, err
+	}
+
+	query := database.DB.Model(&models.GamePlayer{}).
+		Joins("JOIN games ON games.id = game_players.game_id").
+		Where("game_players.player_id = ?", player.ID)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return player, nil, 0, err
+	}
+
+	var games []models.GamePlayer
+	err := opts.Apply(query, "game_players.id ASC").
+		Preload("Game").
+		Preload("Game.GamePlayers.Player").
+		Find(&games).Error
+	return player, games, total, err
 }
 
-func ListAllPlayers() ([]models.Player, error) {
+// ListAllPlayers returns the requested page of players and the total count.
+func ListAllPlayers(opts helpers.ListOptions) ([]models.Player, int64, error) {
+	var total int64
+	if err := database.DB.Model(&models.Player{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
 	var players []models.Player
-	err := database.DB.Find(&players).Error
-	return players, err
+	err := opts.Apply(database.DB.Model(&models.Player{}), "players.id ASC").Find(&players).Error
+	return players, total, err
 }
 
-func AssignPlayerToGame(gameID, playerID uint, faction string) (models.GamePlayer, error) {
+func AssignPlayerToGame(ctx context.Context, gameID, playerID uint, factionName string) (models.GamePlayer, error) {
+	db := database.DB.WithContext(ctx)
+	if err := requireGameExists(gameID); err != nil {
+		return models.GamePlayer{}, err
+	}
+	var player models.Player
+	if err := db.First(&player, playerID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.GamePlayer{}, handle.Invalid("player_id", "player %d does not exist", playerID)
+		}
+		return models.GamePlayer{}, err
+	}
+	faction, err := ResolveFaction(factionName)
+	if err != nil {
+		return models.GamePlayer{}, err
+	}
 	gp := models.GamePlayer{
-		GameID:   gameID,
-		PlayerID: playerID,
-		Faction:  faction,
+		GameID:    gameID,
+		PlayerID:  playerID,
+		Faction:   faction.Name,
+		FactionID: &faction.ID,
 	}
-	err := database.DB.Create(&gp).Error
+	err = db.Create(&gp).Error
 	return gp, err
 }
@@ -1,73 +1,272 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/relics"
+	handle "github.com/arphillips06/TI4-stats/errors"
 	"github.com/arphillips06/TI4-stats/helpers"
 	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
 )
 
-func ApplyShardOfTheThrone(gameID uint, newHolderID uint) error {
-	var game models.Game
-	if err := database.DB.First(&game, gameID).Error; err != nil {
-		return err
+const (
+	RelicActionGain     = "gain"
+	RelicActionLose     = "lose"
+	RelicActionTransfer = "transfer"
+)
+
+// FindRelic looks a relic up by catalogue name, case-insensitively. Titles
+// used by older releases are accepted too.
+func FindRelic(name string) (models.Relic, error) {
+	name = strings.TrimSpace(name)
+	if canonical, ok := relics.LegacyTitles[name]; ok {
+		name = canonical
 	}
 
-	var lastShardScore models.Score
-	err := database.DB.
-		Where("game_id = ? AND type = ? AND relic_title = ?", gameID, "relic", "Shard of the Throne").
-		Order("created_at desc").
-		First(&lastShardScore).Error
+	var relic models.Relic
+	err := database.DB.Where("LOWER(name) = ?", strings.ToLower(name)).First(&relic).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return relic, handle.Rule("unknown relic %q", name)
+	}
+	return relic, err
+}
 
-	if err == nil && lastShardScore.PlayerID != newHolderID {
-		if err := helpers.CreateRelicScore(gameID, lastShardScore.PlayerID, -1, "Shard of the Throne"); err != nil {
-			return err
-		}
+// ListRelics returns the relic catalogue.
+func ListRelics() ([]models.Relic, error) {
+	var out []models.Relic
+	err := database.DB.Order("name").Find(&out).Error
+	return out, err
+}
+
+// GetRelicHoldings returns every relic holding in a game, current and past,
+// in the order they happened.
+func GetRelicHoldings(gameID uint) ([]models.RelicHoldingDTO, error) {
+	var holdings []models.RelicHolding
+	if err := database.DB.
+		Preload("Relic").
+		Preload("Player").
+		Where("game_id = ?", gameID).
+		Order("created_at, id").
+		Find(&holdings).Error; err != nil {
+		return nil, err
 	}
 
-	if err := helpers.CreateRelicScore(gameID, newHolderID, 1, "Shard of the Throne"); err != nil {
-		return err
+	out := make([]models.RelicHoldingDTO, 0, len(holdings))
+	for _, h := range holdings {
+		out = append(out, models.RelicHoldingDTO{
+			RelicID:    h.RelicID,
+			Relic:      h.Relic.Name,
+			PlayerID:   h.PlayerID,
+			PlayerName: h.Player.Name,
+			RoundID:    h.RoundID,
+			GainedAt:   h.CreatedAt,
+			LostAt:     h.LostAt,
+		})
 	}
+	return out, nil
+}
 
-	return MaybeFinishGameFromScore(&game, newHolderID)
+// SecretObjectiveCap returns how many secret objectives a player may score,
+// including modifiers from relics they currently hold.
+func SecretObjectiveCap(gameID, playerID uint) (int, error) {
+	var modifier int
+	err := database.DB.
+		Table("relic_holdings").
+		Joins("JOIN relics ON relics.id = relic_holdings.relic_id").
+		Where("relic_holdings.game_id = ? AND relic_holdings.player_id = ? AND relic_holdings.lost_at IS NULL", gameID, playerID).
+		Select("COALESCE(SUM(relics.secret_cap_modifier), 0)").
+		Scan(&modifier).Error
+	return 3 + modifier, err
 }
 
-func ApplyCrownOfEmphidia(gameID, playerID uint) error {
+// ApplyRelicAction gains, loses or transfers a relic. Victory points are
+// recorded as relic scores in the current round and can finish the game.
+func ApplyRelicAction(ctx context.Context, gameID uint, req models.RelicActionRequest) error {
 	var game models.Game
-	if err := database.DB.First(&game, gameID).Error; err != nil {
+	if err := database.DB.WithContext(ctx).First(&game, gameID).Error; err != nil {
+		return err
+	}
+	if game.FinishedAt != nil {
+		return handle.Rule("game is already finished")
+	}
+	relic, err := FindRelic(req.Relic)
+	if err != nil {
+		return err
+	}
+	if err := requirePlayerInGame(gameID, req.PlayerID, "player_id"); err != nil {
+		return err
+	}
+	if strings.EqualFold(req.Action, RelicActionTransfer) {
+		if err := requirePlayerInGame(gameID, req.ToPlayerID, "to_player_id"); err != nil {
+			return err
+		}
+	}
+	roundID, err := helpers.GetCurrentRoundID(gameID)
+	if err != nil {
 		return err
 	}
 
-	if err := helpers.CreateRelicScore(gameID, playerID, 1, "The Crown of Emphidia"); err != nil {
+	var gainer uint
+	switch strings.ToLower(req.Action) {
+	case RelicActionGain:
+		gainer = req.PlayerID
+		err = gainRelic(ctx, gameID, roundID, relic, req.PlayerID)
+	case RelicActionLose:
+		err = loseRelic(ctx, gameID, roundID, relic, req.PlayerID)
+	case RelicActionTransfer:
+		gainer = req.ToPlayerID
+		err = transferRelic(ctx, gameID, roundID, relic, req.PlayerID, req.ToPlayerID)
+	default:
+		return handle.Invalid("action", "%q is not gain, lose or transfer", req.Action)
+	}
+	if err != nil {
 		return err
 	}
 
-	return MaybeFinishGameFromScore(&game, playerID)
+	if gainer != 0 && relic.VictoryPoints > 0 {
+		return MaybeFinishGameFromScore(ctx, &game, gainer)
+	}
+	return nil
+}
 
+func currentHolding(tx *gorm.DB, gameID, relicID uint) (*models.RelicHolding, error) {
+	var h models.RelicHolding
+	err := tx.Where("game_id = ? AND relic_id = ? AND lost_at IS NULL", gameID, relicID).First(&h).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
 }
 
-func ApplyObsidian(gameID, playerID uint) error {
-	score := models.Score{
+func relicScore(tx *gorm.DB, gameID, roundID, playerID uint, points int, relic models.Relic) error {
+	return tx.Create(&models.Score{
 		GameID:     gameID,
+		RoundID:    roundID,
 		PlayerID:   playerID,
-		Points:     0,
-		Type:       "relic",
-		RelicTitle: "The Obsidian",
-		CreatedAt:  time.Now(),
+		Points:     points,
+		Type:       models.ScoreTypeRelic,
+		RelicTitle: relic.Name,
+	}).Error
+}
+
+func gainRelic(ctx context.Context, gameID, roundID uint, relic models.Relic, playerID uint) error {
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+
+		// There is one copy of each relic, so it can only enter a game once.
+		var previous int64
+		if err := tx.Model(&models.RelicHolding{}).
+			Where("game_id = ? AND relic_id = ?", gameID, relic.ID).
+			Count(&previous).Error; err != nil {
+			return err
+		}
+		if previous > 0 {
+			if relic.Transferable {
+				return handle.Rule("%s is already in play; transfer it instead", relic.Name)
+			}
+			return handle.Rule("%s has already been gained this game", relic.Name)
+		}
+
+		if err := tx.Create(&models.RelicHolding{
+			GameID:   gameID,
+			RelicID:  relic.ID,
+			PlayerID: playerID,
+			RoundID:  roundID,
+		}).Error; err != nil {
+			return err
+		}
+		if relic.VictoryPoints != 0 {
+			return relicScore(tx, gameID, roundID, playerID, relic.VictoryPoints, relic)
+		}
+		return nil
+	})
+}
+
+func loseRelic(ctx context.Context, gameID, roundID uint, relic models.Relic, playerID uint) error {
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		held, err := currentHolding(tx, gameID, relic.ID)
+		if err != nil {
+			return err
+		}
+		if held == nil || held.PlayerID != playerID {
+			return handle.Rule("player %d does not hold %s", playerID, relic.Name)
+		}
+
+		now := time.Now()
+		if err := tx.Model(held).Update("lost_at", &now).Error; err != nil {
+			return err
+		}
+		if relic.PointsFollowHolder && relic.VictoryPoints != 0 {
+			return relicScore(tx, gameID, roundID, playerID, -relic.VictoryPoints, relic)
+		}
+		return nil
+	})
+}
+
+func transferRelic(ctx context.Context, gameID, roundID uint, relic models.Relic, fromID, toID uint) error {
+	if !relic.Transferable {
+		return handle.Rule("%s cannot be transferred", relic.Name)
 	}
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		held, err := currentHolding(tx, gameID, relic.ID)
+		if err != nil {
+			return err
+		}
+		if held == nil {
+			return handle.Rule("nobody holds %s", relic.Name)
+		}
+		if fromID != 0 && held.PlayerID != fromID {
+			return handle.Rule("player %d does not hold %s", fromID, relic.Name)
+		}
+		if held.PlayerID == toID {
+			return handle.Rule("player %d already holds %s", toID, relic.Name)
+		}
+
+		now := time.Now()
+		if err := tx.Model(held).Update("lost_at", &now).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&models.RelicHolding{
+			GameID:   gameID,
+			RelicID:  relic.ID,
+			PlayerID: toID,
+			RoundID:  roundID,
+		}).Error; err != nil {
+			return err
+		}
 
-	return database.DB.Create(&score).Error
+		if relic.PointsFollowHolder && relic.VictoryPoints != 0 {
+			if err := relicScore(tx, gameID, roundID, held.PlayerID, -relic.VictoryPoints, relic); err != nil {
+				return err
+			}
+			return relicScore(tx, gameID, roundID, toID, relic.VictoryPoints, relic)
+		}
+		return nil
+	})
 }
 
-func ApplyBookOfLatvina(gameID, playerID uint) error {
-	score := models.Score{
-		GameID:     gameID,
-		PlayerID:   playerID,
-		Points:     1,
-		Type:       "relic",
-		RelicTitle: "Book Of Latvina",
-		CreatedAt:  time.Now(),
+// GainOrTransferRelic gives a relic to a player, taking it from its current
+// holder if there is one. It backs the legacy single-relic endpoints.
+func GainOrTransferRelic(ctx context.Context, gameID uint, relicName string, playerID uint) error {
+	relic, err := FindRelic(relicName)
+	if err != nil {
+		return err
+	}
+	held, err := currentHolding(database.DB.WithContext(ctx), gameID, relic.ID)
+	if err != nil {
+		return err
+	}
+
+	req := models.RelicActionRequest{Action: RelicActionGain, Relic: relic.Name, PlayerID: playerID}
+	if held != nil {
+		req = models.RelicActionRequest{Action: RelicActionTransfer, Relic: relic.Name, PlayerID: held.PlayerID, ToPlayerID: playerID}
 	}
-	return database.DB.Create(&score).Error
+	return ApplyRelicAction(ctx, gameID, req)
 }
@@ -1,19 +1,20 @@
 package services
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/arphillips06/TI4-stats/database"
-	"github.com/arphillips06/TI4-stats/helpers/stats"
 	"github.com/arphillips06/TI4-stats/models"
 )
 
-func MaybeFinishGameFromScore(game *models.Game, scoringPlayerID uint) error {
+func MaybeFinishGameFromScore(ctx context.Context, game *models.Game, scoringPlayerID uint) error {
+	db := database.DB.WithContext(ctx)
 	log.Printf("Checking if game %d is finished after scoring by player %d", game.ID, scoringPlayerID)
 
 	var totalPoints int
-	err := database.DB.Model(&models.Score{}).
+	err := db.Model(&models.Score{}).
 		Where("game_id = ? AND player_id = ?", game.ID, scoringPlayerID).
 		Select("SUM(points)").Scan(&totalPoints).Error
 	if err != nil {
@@ -24,55 +25,57 @@ func MaybeFinishGameFromScore(game *models.Game, scoringPlayerID uint) error {
 		now := time.Now()
 		game.FinishedAt = &now
 		game.WinnerID = &scoringPlayerID
+		if err := stopGameClock(db, game, now); err != nil {
+			return err
+		}
+		if err := endRunningSession(db, game.ID, now); err != nil {
+			return err
+		}
 
-		err := database.DB.Model(&models.GamePlayer{}).
+		err := db.Model(&models.GamePlayer{}).
 			Where("game_id = ? AND player_id = ?", game.ID, scoringPlayerID).
 			Update("won", true).Error
 		if err != nil {
 			return err
 		}
 
-		RefreshVictoryPathCache()
-
-		return database.DB.Save(game).Error
+		if err := db.Save(game).Error; err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func RefreshVictoryPathCache() {
-	pathCounts, err := stats.CalculateCommonVictoryPaths()
-	if err != nil {
-		log.Printf("Failed to refresh victory paths: %v", err)
-		return
-	}
-	CachedVictoryPathCounts = pathCounts
-	log.Printf("[VictoryPath] Cache pointer address: %p", &CachedVictoryPathCounts)
-
-}
-
-func MaybeFinishGameFromExhaustion(game *models.Game) error {
+func MaybeFinishGameFromExhaustion(ctx context.Context, game *models.Game) error {
+	db := database.DB.WithContext(ctx)
 	now := time.Now()
 	game.FinishedAt = &now
+	if err := stopGameClock(db, game, now); err != nil {
+		return err
+	}
+	if err := endRunningSession(db, game.ID, now); err != nil {
+		return err
+	}
 
-	if err := WinnerByScore(game); err != nil {
+	if err := WinnerByScore(ctx, game); err != nil {
 		return err
 	}
-	if err := database.DB.Save(game).Error; err != nil {
+	if err := db.Save(game).Error; err != nil {
 		return err
 	}
 	log.Printf("[Achievements] Evaluating for game %d", game.ID)
-
-	return database.DB.Save(game).Error
+	return nil
 }
 
-func WinnerByScore(game *models.Game) error {
+func WinnerByScore(ctx context.Context, game *models.Game) error {
+	db := database.DB.WithContext(ctx)
 	var topScore struct {
 		PlayerID uint
 		Points   int
 	}
 
-	err := database.DB.
+	err := db.
 		Table("scores").
 		Select("player_id, SUM(points) as points").
 		Where("game_id = ?", game.ID).
@@ -88,7 +91,7 @@ func WinnerByScore(game *models.Game) error {
 	if topScore.PlayerID != 0 {
 		game.WinnerID = &topScore.PlayerID
 
-		err := database.DB.Model(&models.GamePlayer{}).
+		err := db.Model(&models.GamePlayer{}).
 			Where("game_id = ? AND player_id = ?", game.ID, topScore.PlayerID).
 			Update("won", true).Error
 		if err != nil {
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/arphillips06/TI4-stats/database"
 	"github.com/arphillips06/TI4-stats/models"
 )
@@ -13,8 +14,11 @@ func GetGameAndRounds(gameID uint) (*models.Game, error) {
 	return &game, nil
 }
 
-func RemoveScore(gameID, playerID, objectiveID int) error {
-	return database.DB.
+func RemoveScore(ctx context.Context, gameID, playerID, objectiveID int) error {
+	if err := requirePlayerInGame(uint(gameID), uint(playerID), "player_id"); err != nil {
+		return err
+	}
+	return database.DB.WithContext(ctx).
 		Table("scores").
 		Where("game_id = ? AND player_id = ? AND objective_id = ?", gameID, playerID, objectiveID).
 		Delete(nil).Error
@@ -1,46 +1,77 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
 	"github.com/arphillips06/TI4-stats/helpers"
 	"github.com/arphillips06/TI4-stats/models"
 	"gorm.io/gorm"
 )
 
-func SubmitScore(gameID, playerID, objectiveID uint) (map[string]any, error) {
+// SubmitScore scores an objective for a player in the game's current
+// round. Public objectives, and secrets leaked by Classified Document Leaks,
+// go through ValidatePublicScoringRules; other secrets through
+// ValidateSecretScoringRules.
+func SubmitScore(ctx context.Context, input models.ScoreRequest) (*models.ScoreResponse, error) {
+	db := database.DB.WithContext(ctx)
+	gameID, playerID := input.GameID, input.PlayerID
 	game, err := helpers.GetUnfinishedGame(gameID)
 	if err != nil {
 		return nil, err
 	}
+	if err := requirePlayerInGame(gameID, playerID, "player_id"); err != nil {
+		return nil, err
+	}
 
 	var objective models.Objective
-	if err := database.DB.First(&objective, objectiveID).Error; err != nil {
+	if err := db.First(&objective, input.ObjectiveID).Error; err != nil {
 		return nil, errors.New("objective not found")
 	}
 
 	var round models.Round
-	if err := database.DB.Where("game_id = ? AND number = ?", gameID, game.CurrentRound).First(&round).Error; err != nil {
+	if err := db.Where("game_id = ? AND number = ?", gameID, game.CurrentRound).First(&round).Error; err != nil {
 		return nil, errors.New("current round not found")
 	}
 
-	if err := ValidateSecretScoringRules(gameID, playerID, round.ID, objectiveID); err != nil {
+	scoreType, err := objectiveScoreType(gameID, objective)
+	if err != nil {
+		return nil, err
+	}
+	if scoreType == models.ScoreTypePublic {
+		err = ValidatePublicScoringRules(game, playerID, round, objective, input.Override)
+	} else if input.Override != "" {
+		err = handle.Invalid("override", "only applies to public objectives")
+	} else if err = requireObjectivePhase(gameID, objective); err == nil {
+		err = ValidateSecretScoringRules(gameID, playerID, round.ID, objective.ID)
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	exists, err := CheckIfScoreExists(gameID, playerID, objectiveID)
+	exists, err := CheckIfScoreExists(gameID, playerID, objective.ID)
 	if err != nil {
 		return nil, err
 	}
 	if exists {
-		return nil, errors.New("objective already scored by this player")
-	}
-
-	if err := helpers.CreateObjectiveScore(gameID, round.ID, playerID, objectiveID, objective.Points); err != nil {
+		return nil, ErrScoreExists
+	}
+
+	if err := helpers.CreateGenericScore(ctx, models.Score{
+		GameID:         gameID,
+		RoundID:        round.ID,
+		PlayerID:       playerID,
+		ObjectiveID:    objective.ID,
+		Points:         objective.Points,
+		Type:           scoreType,
+		Override:       input.Override,
+		OverrideReason: strings.TrimSpace(input.OverrideReason),
+	}); err != nil {
 		return nil, fmt.Errorf("failed to add score: %v", err)
 	}
 
@@ -49,49 +80,73 @@ func SubmitScore(gameID, playerID, objectiveID uint) (map[string]any, error) {
 		return nil, err
 	}
 
-	if err := MaybeFinishGameFromScore(game, playerID); err != nil {
+	if err := MaybeFinishGameFromScore(ctx, game, playerID); err != nil {
 		return nil, err
 	}
 
-	resp := map[string]any{
-		"message":      "Score added",
-		"objective":    objective.Name,
-		"points":       objective.Points,
-		"round":        game.CurrentRound,
-		"total_points": totalPoints,
+	resp := &models.ScoreResponse{
+		Message:     "Score added",
+		Objective:   objective.Name,
+		Points:      objective.Points,
+		Round:       game.CurrentRound,
+		TotalPoints: totalPoints,
 	}
 	if game.FinishedAt != nil {
-		resp["message"] = "Game finished"
-		resp["winner"] = game.WinnerID
+		resp.Message = "Game finished"
+		resp.Winner = game.WinnerID
 	}
 
 	return resp, nil
 }
 
-func AddScoreToGame(gameID, playerID uint, objectiveName string) (*models.Score, int, error) {
+// objectiveScoreType is how scoring objective is recorded: secret, or
+// public for public objectives and secrets leaked by Classified Document
+// Leaks.
+func objectiveScoreType(gameID uint, objective models.Objective) (models.ScoreSource, error) {
+	if !strings.EqualFold(objective.Type, string(models.ScoreTypeSecret)) {
+		return models.ScoreTypePublic, nil
+	}
+	cdl, err := isCDLObjective(gameID, objective.ID)
+	if cdl {
+		return models.ScoreTypePublic, err
+	}
+	return models.ScoreTypeSecret, err
+}
+
+func AddScoreToGame(ctx context.Context, gameID, playerID uint, objectiveName string) (*models.Score, int, error) {
+	db := database.DB.WithContext(ctx)
 	var game models.Game
-	if err := database.DB.Preload("Rounds").First(&game, gameID).Error; err != nil {
+	if err := db.Preload("Rounds").First(&game, gameID).Error; err != nil {
 		return nil, 0, errors.New("game not found")
 	}
 
 	if game.FinishedAt != nil {
 		return nil, 0, errors.New("game is already finished")
 	}
+	if err := requirePlayerInGame(gameID, playerID, "player_id"); err != nil {
+		return nil, 0, err
+	}
 
 	var obj models.Objective
-	if err := database.DB.Where("LOWER(name) = ?", strings.ToLower(objectiveName)).First(&obj).Error; err != nil {
+	if err := db.Where("LOWER(name) = ?", strings.ToLower(objectiveName)).First(&obj).Error; err != nil {
 		return nil, 0, errors.New("objective not found")
 	}
-
 	var round models.Round
-	if err := database.DB.Where("game_id = ? AND number = ?", game.ID, game.CurrentRound).First(&round).Error; err != nil {
+	if err := db.Where("game_id = ? AND number = ?", game.ID, game.CurrentRound).First(&round).Error; err != nil {
 		return nil, 0, errors.New("current round not found")
 	}
 
-	if obj.Type == "Secret" {
-		if err := ValidateSecretScoringRules(gameID, playerID, round.ID, obj.ID); err != nil {
-			return nil, 0, err
-		}
+	scoreType, err := objectiveScoreType(gameID, obj)
+	if err != nil {
+		return nil, 0, err
+	}
+	if scoreType == models.ScoreTypePublic {
+		err = ValidatePublicScoringRules(&game, playerID, round, obj, "")
+	} else if err = requireObjectivePhase(gameID, obj); err == nil {
+		err = ValidateSecretScoringRules(gameID, playerID, round.ID, obj.ID)
+	}
+	if err != nil {
+		return nil, 0, err
 	}
 
 	exists, err := CheckIfScoreExists(game.ID, playerID, obj.ID)
@@ -108,19 +163,20 @@ func AddScoreToGame(gameID, playerID uint, objectiveName string) (*models.Score,
 		ObjectiveID: obj.ID,
 		Points:      obj.Points,
 		RoundID:     round.ID,
+		Type:        scoreType,
 	}
 
-	if err := database.DB.Create(&score).Error; err != nil {
+	if err := db.Create(&score).Error; err != nil {
 		return nil, 0, err
 	}
 
 	var total int
-	database.DB.Model(&models.Score{}).
+	db.Model(&models.Score{}).
 		Where("game_id = ? AND player_id = ?", game.ID, playerID).
 		Select("SUM(points)").Scan(&total)
 
 	if total >= game.WinningPoints {
-		if err := MaybeFinishGameFromScore(&game, playerID); err != nil {
+		if err := MaybeFinishGameFromScore(ctx, &game, playerID); err != nil {
 			return &score, total, err
 		}
 	}
@@ -128,15 +184,22 @@ func AddScoreToGame(gameID, playerID uint, objectiveName string) (*models.Score,
 	return &score, total, nil
 }
 
-func ScoreMecatolPoint(gameID, playerID uint) error {
+func ScoreMecatolPoint(ctx context.Context, gameID, playerID uint) error {
+	db := database.DB.WithContext(ctx)
 	roundID, err := helpers.GetCurrentRoundID(gameID)
 	if err != nil {
 		log.Printf("[ScoreMecatolPoint] Failed to get round ID for game %d: %v", gameID, err)
 		return err
 	}
+	if err := requirePlayerInGame(gameID, playerID, "player_id"); err != nil {
+		return err
+	}
+	if err := RequirePhase(gameID, "claiming Custodians", models.PhaseAction); err != nil {
+		return err
+	}
 
 	var existing models.Score
-	err = database.DB.
+	err = db.
 		Where("game_id = ? AND type = ?", gameID, models.ScoreTypeMecatol).
 		First(&existing).Error
 	if err == nil {
@@ -151,13 +214,13 @@ func ScoreMecatolPoint(gameID, playerID uint) error {
 	log.Printf("[ScoreMecatolPoint] No existing Mecatol score found for game %d. Creating one for player %d", gameID, playerID)
 
 	var game models.Game
-	if err := database.DB.First(&game, gameID).Error; err != nil {
+	if err := db.First(&game, gameID).Error; err != nil {
 		log.Printf("[ScoreMecatolPoint] Failed to load game %d: %v", gameID, err)
 		return err
 	}
 
 	log.Printf("[ScoreMecatolPoint] Creating Mecatol score: Game %d, Player %d, Round %d", gameID, playerID, roundID)
-	if err := helpers.CreateGenericScore(models.Score{
+	if err := helpers.CreateGenericScore(ctx, models.Score{
 		GameID:   gameID,
 		RoundID:  roundID,
 		PlayerID: playerID,
@@ -169,15 +232,21 @@ func ScoreMecatolPoint(gameID, playerID uint) error {
 	}
 
 	log.Printf("[ScoreMecatolPoint] Mecatol score created. Checking if game is finished.")
-	return MaybeFinishGameFromScore(&game, playerID)
+	return MaybeFinishGameFromScore(ctx, &game, playerID)
 }
 
-func ScoreImperialPoint(gameID, playerID uint) error {
+func ScoreImperialPoint(ctx context.Context, gameID, playerID uint) error {
 	roundID, err := helpers.GetCurrentRoundID(gameID)
 	if err != nil {
 		log.Printf("[ScoreImperialPoint] Failed to get round ID for game %d: %v", gameID, err)
 		return err
 	}
+	if err := requirePlayerInGame(gameID, playerID, "player_id"); err != nil {
+		return err
+	}
+	if err := RequirePhase(gameID, "scoring Imperial", models.PhaseAction); err != nil {
+		return err
+	}
 
 	game, err := helpers.GetUnfinishedGame(gameID)
 	if err != nil {
@@ -186,136 +255,26 @@ func ScoreImperialPoint(gameID, playerID uint) error {
 	}
 
 	log.Printf("[ScoreImperialPoint] Creating Imperial score: Game %d, Player %d, Round %d", gameID, playerID, roundID)
-	if err := helpers.CreateGenericScore(models.Score{
+	if err := helpers.CreateGenericScore(ctx, models.Score{
 		GameID:   gameID,
 		RoundID:  roundID,
 		PlayerID: playerID,
 		Points:   1,
-		Type:     "imperial",
+		Type:     models.ScoreTypeImperial,
 	}); err != nil {
 		log.Printf("[ScoreImperialPoint] Failed to create Imperial score: %v", err)
 		return err
 	}
 
 	log.Printf("[ScoreImperialPoint] Imperial score created. Checking if game is finished.")
-	return MaybeFinishGameFromScore(game, playerID)
+	return MaybeFinishGameFromScore(ctx, game, playerID)
 }
 
-func ScoreSupportPoint(gameID, playerID uint) error {
-	roundID, err := helpers.GetCurrentRoundID(gameID)
-	if err != nil {
-		return err
-	}
-
-	if _, err := helpers.GetUnfinishedGame(gameID); err != nil {
-		return err
-	}
-
-	var playerCount int64
-	if err := database.DB.
-		Model(&models.GamePlayer{}).
-		Where("game_id = ?", gameID).
-		Count(&playerCount).Error; err != nil {
-		return err
-	}
-
-	var playerSupportPoints int64
-	if err := database.DB.
-		Model(&models.Score{}).
-		Where("game_id = ? AND player_id = ? AND type = ?", gameID, playerID, "Support").
-		Select("COALESCE(SUM(points), 0)").
-		Scan(&playerSupportPoints).Error; err != nil {
-		return err
-	}
-
-	if playerSupportPoints >= playerCount-1 {
-		return fmt.Errorf(
-			"player %d already has the maximum %d Support for the Throne points in a %d-player game",
-			playerID, playerCount-1, playerCount,
-		)
-	}
-
-	var totalSupportPoints int64
-	if err := database.DB.
-		Model(&models.Score{}).
-		Where("game_id = ? AND type = ?", gameID, "Support").
-		Select("COALESCE(SUM(points), 0)").
-		Scan(&totalSupportPoints).Error; err != nil {
-		return err
-	}
-
-	if totalSupportPoints >= playerCount {
-		return fmt.Errorf(
-			"support for the Throne can only be scored %d times in a %d-player game (all cards have been given away)",
-			playerCount, playerCount,
-		)
-	}
-
-	return helpers.CreateGenericScore(models.Score{
-		GameID:   gameID,
-		RoundID:  roundID,
+// ScoreImperialRiderPoint backs the legacy Imperial Rider endpoint.
+func ScoreImperialRiderPoint(ctx context.Context, gameID, roundID, playerID uint) error {
+	return RecordCardEffect(ctx, gameID, models.CardEffectRequest{
+		Card:     models.ActionCardImperialRider,
 		PlayerID: playerID,
-		Points:   1,
-		Type:     models.SFTT,
-	})
-}
-
-func LoseOneSupportPoint(gameID, playerID uint) error {
-	roundID, err := helpers.GetCurrentRoundID(gameID)
-	if err != nil {
-		return err
-	}
-
-	if _, err := helpers.GetUnfinishedGame(gameID); err != nil {
-		return err
-	}
-
-	// Create a negative support score record
-	helpers.CreateGenericScore(models.Score{
-		GameID:   gameID,
 		RoundID:  roundID,
-		PlayerID: playerID,
-		Points:   -1,
-		Type:     models.SFTT,
 	})
-
-	return nil
-}
-
-func HandleSupportForTheThrone(gameID, playerID uint, action string) error {
-	switch action {
-	case "score":
-		return ScoreSupportPoint(gameID, playerID)
-	case "unscore":
-		return LoseOneSupportPoint(gameID, playerID)
-	default:
-		return errors.New("invalid action: must be 'score' or 'unscore'")
-	}
-}
-
-func ScoreImperialRiderPoint(gameID, roundID, playerID uint) error {
-	if roundID == 0 {
-		var err error
-		roundID, err = helpers.GetCurrentRoundID(gameID)
-		if err != nil {
-			return err
-		}
-	}
-
-	game, err := helpers.GetUnfinishedGame(gameID)
-	if err != nil {
-		return err
-	}
-
-	if err := helpers.CreateGenericScore(models.Score{
-		GameID:   gameID,
-		RoundID:  roundID,
-		PlayerID: playerID,
-		Points:   1,
-		Type:     "imperial_rider", // Distinct type
-	}); err != nil {
-		return err
-	}
-
-	return MaybeFinishGameFromScore(game, playerID)
 }
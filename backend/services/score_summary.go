@@ -1,6 +1,8 @@
 package services
 
 import (
+	"sort"
+
 	"github.com/arphillips06/TI4-stats/database"
 	"github.com/arphillips06/TI4-stats/models"
 )
@@ -69,26 +71,21 @@ func GetScoreSummaryByPlayer(gameID string) ([]models.PlayerScoreSummary, error)
 }
 
 // GetScoresGroupedByRound returns scores grouped by round for a specific game
-func GetScoresGroupedByRound(gameID string) ([]map[string]any, error) {
-	type rawScore struct {
-		Round  int    `json:"round"`
-		Player string `json:"player"`
-		Source string `json:"source"`
-		Points int    `json:"points"`
-	}
-
-	var results []rawScore
+func GetScoresGroupedByRound(gameID string) ([]models.RoundScoresGroup, error) {
+	var results []models.RoundScore
 
 	err := database.DB.
 		Table("scores").
 		Select(`
 			COALESCE(rounds.number, 0) AS round,
 			players.name AS player,
-			COALESCE(objectives.name, scores.agenda_title, scores.relic_title,
+			COALESCE(objectives.name, NULLIF(scores.agenda_title, ''), NULLIF(scores.relic_title, ''),
+				NULLIF(scores.card_title, ''),
 				CASE
 					WHEN scores.type = 'imperial' THEN 'Imperial Point'
 					WHEN scores.type = 'mecatol' THEN 'Custodians'
-					WHEN scores.type = 'Support' THEN 'Support for the Throne'
+					WHEN scores.type = 'support' THEN 'Support for the Throne'
+					WHEN scores.type = 'imported' THEN 'Imported'
 					ELSE 'Unknown'
 				END
 			) AS source,
@@ -105,18 +102,98 @@ func GetScoresGroupedByRound(gameID string) ([]map[string]any, error) {
 		return nil, err
 	}
 
-	grouped := make(map[int][]rawScore)
+	// Results are ordered by round, so each round's scores are contiguous.
+	var response []models.RoundScoresGroup
 	for _, r := range results {
-		grouped[r.Round] = append(grouped[r.Round], r)
+		if n := len(response); n == 0 || response[n-1].Round != r.Round {
+			response = append(response, models.RoundScoresGroup{Round: r.Round})
+		}
+		last := &response[len(response)-1]
+		last.Scores = append(last.Scores, r)
+	}
+
+	return response, nil
+}
+
+// GetVPBreakdown returns each player's points in a game grouped by source,
+// with the card or agenda behind every award.
+func GetVPBreakdown(gameID string) ([]models.PlayerVPBreakdown, error) {
+	game, scores, err := GetGameAndScores(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	roundNumbers := make(map[uint]int, len(game.Rounds))
+	for _, r := range game.Rounds {
+		roundNumbers[r.ID] = r.Number
 	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		ri, rj := roundNumbers[scores[i].RoundID], roundNumbers[scores[j].RoundID]
+		if ri != rj {
+			return ri < rj
+		}
+		return scores[i].ID < scores[j].ID
+	})
 
-	var response []map[string]any
-	for round, scores := range grouped {
-		response = append(response, map[string]any{
-			"round":  round,
-			"scores": scores,
+	bySource := make(map[uint]map[models.ScoreSource]*models.VPSourceBreakdown)
+	totals := make(map[uint]int)
+	for _, s := range scores {
+		source, ok := models.ParseScoreSource(string(s.Type))
+		if !ok {
+			source = s.Type
+		}
+		if bySource[s.PlayerID] == nil {
+			bySource[s.PlayerID] = make(map[models.ScoreSource]*models.VPSourceBreakdown)
+		}
+		entry := bySource[s.PlayerID][source]
+		if entry == nil {
+			entry = &models.VPSourceBreakdown{Source: source, Label: source.Label()}
+			bySource[s.PlayerID][source] = entry
+		}
+		entry.Points += s.Points
+		entry.Items = append(entry.Items, models.VPProvenance{
+			Title:  scoreProvenance(s, source),
+			Round:  roundNumbers[s.RoundID],
+			Points: s.Points,
 		})
+		totals[s.PlayerID] += s.Points
 	}
 
-	return response, nil
+	out := make([]models.PlayerVPBreakdown, 0, len(game.GamePlayers))
+	for _, gp := range game.GamePlayers {
+		pb := models.PlayerVPBreakdown{
+			PlayerID:   gp.PlayerID,
+			PlayerName: gp.Player.Name,
+			Faction:    gp.Faction,
+			Total:      totals[gp.PlayerID],
+			Sources:    []models.VPSourceBreakdown{},
+		}
+		for _, src := range models.ScoreSources {
+			if entry := bySource[gp.PlayerID][src]; entry != nil {
+				pb.Sources = append(pb.Sources, *entry)
+				delete(bySource[gp.PlayerID], src)
+			}
+		}
+		// Rows with a type outside the enum are still reported.
+		for _, entry := range bySource[gp.PlayerID] {
+			pb.Sources = append(pb.Sources, *entry)
+		}
+		out = append(out, pb)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Total > out[j].Total })
+	return out, nil
+}
+
+func scoreProvenance(s models.Score, source models.ScoreSource) string {
+	switch {
+	case s.Objective.Name != "":
+		return s.Objective.Name
+	case s.AgendaTitle != "":
+		return s.AgendaTitle
+	case s.RelicTitle != "":
+		return s.RelicTitle
+	case s.CardTitle != "":
+		return s.CardTitle
+	}
+	return source.Label()
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
 	"github.com/arphillips06/TI4-stats/models"
 	"gorm.io/gorm"
 )
@@ -18,23 +19,26 @@ func ValidateSecretScoringRules(gameID, playerID, roundID, objectiveID uint) err
 		return errors.New("objective not found")
 	}
 
-	if strings.ToLower(objective.Type) != models.ScoreTypeSecret {
+	if strings.ToLower(objective.Type) != string(models.ScoreTypeSecret) {
 		return nil
 	}
 
-	// Phase-specific limit check
-	var countThisPhase int64
-	if err := database.DB.
+	// Phase-specific limit check. Games that track phases know the phase
+	// each secret was scored in; otherwise go by the phase printed on it.
+	var roundPhase string
+	if err := database.DB.Model(&models.Round{}).Where("id = ?", roundID).Select("phase").Scan(&roundPhase).Error; err != nil {
+		return errors.New("failed to validate secret scoring rules")
+	}
+	secretsThisPhase := database.DB.
 		Model(&models.Score{}).
-		Where(`
-			player_id = ? AND 
-			round_id = ? AND 
-			LOWER(type) = 'secret' AND 
-			objective_id IN (
-				SELECT id FROM objectives WHERE LOWER(phase) = ?
-			)`,
-			playerID, roundID, strings.ToLower(objective.Phase)).
-		Count(&countThisPhase).Error; err != nil {
+		Where("player_id = ? AND round_id = ? AND LOWER(type) = 'secret'", playerID, roundID)
+	if roundPhase != "" {
+		secretsThisPhase = secretsThisPhase.Where("phase = ?", roundPhase)
+	} else {
+		secretsThisPhase = secretsThisPhase.Where("objective_id IN (SELECT id FROM objectives WHERE LOWER(phase) = ?)", strings.ToLower(objective.Phase))
+	}
+	var countThisPhase int64
+	if err := secretsThisPhase.Count(&countThisPhase).Error; err != nil {
 		return errors.New("failed to validate secret scoring rules")
 	}
 
@@ -52,27 +56,23 @@ func ValidateSecretScoringRules(gameID, playerID, roundID, objectiveID uint) err
 		return errors.New("failed to count total secret objectives")
 	}
 
-	// Obsidian check
-	var obsidianUsed int64
-	if err := database.DB.
-		Model(&models.Score{}).
-		Where("game_id = ? AND player_id = ? AND LOWER(type) = 'relic' AND LOWER(relic_title) = 'the obsidian'", gameID, playerID).
-		Count(&obsidianUsed).Error; err != nil {
-		return errors.New("failed to check Obsidian use")
-	}
-
-	maxSecrets := int64(3)
-	if obsidianUsed > 0 {
-		maxSecrets = 4
+	// Relics such as The Obsidian raise the cap
+	maxSecrets, err := SecretObjectiveCap(gameID, playerID)
+	if err != nil {
+		return errors.New("failed to check relic secret objective modifiers")
 	}
 
-	if totalSecrets >= maxSecrets {
+	if totalSecrets >= int64(maxSecrets) {
 		return fmt.Errorf("player has already scored the maximum of %d secret objectives", maxSecrets)
 	}
 
 	return nil
 }
 
+// ErrScoreExists is returned when a player already holds the objective a
+// score would give them.
+var ErrScoreExists = errors.New("objective already scored by this player")
+
 func CheckIfScoreExists(gameID, playerID, objectiveID uint) (bool, error) {
 	var existing models.Score
 	err := database.DB.
@@ -88,3 +88,103 @@ func CheckIfScoreExists(gameID, playerID, objectiveID uint) (bool, error) {
 
 	return true, nil
 }
+
+// isCDLObjective reports whether Classified Document Leaks made a secret
+// objective public in the game, so that every player can score it.
+func isCDLObjective(gameID, objectiveID uint) (bool, error) {
+	var count int64
+	err := database.DB.Model(&models.Score{}).
+		Where("game_id = ? AND type = ? AND agenda_title = ? AND objective_id = ?", gameID, models.ScoreTypeAgenda, models.AgendaCDL, objectiveID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ValidatePublicScoringRules checks that a player may score a public
+// objective, or a secret made public by Classified Document Leaks, in the
+// game's current round. The objective must be face up in the game: revealed
+// from the decks, assigned by hand when the game does not use them, or
+// leaked. Without an override a player scores one public objective per
+// status phase; overrides are checked in validateScoreOverride.
+func ValidatePublicScoringRules(game *models.Game, playerID uint, round models.Round, objective models.Objective, override string) error {
+	cdl, err := isCDLObjective(game.ID, objective.ID)
+	if err != nil {
+		return err
+	}
+	if !cdl {
+		if err := requirePublicObjectiveInGame(game, objective); err != nil {
+			return err
+		}
+	}
+
+	if override != "" {
+		return validateScoreOverride(game.ID, round, objective, override)
+	}
+	// A leaked secret is public now, so it too is scored in the status
+	// phase rather than the phase printed on it.
+	if err := RequirePhase(game.ID, fmt.Sprintf("scoring %s", objective.Name), models.PhaseStatus); err != nil {
+		return err
+	}
+
+	var scored int64
+	if err := database.DB.Model(&models.Score{}).
+		Where("game_id = ? AND player_id = ? AND round_id = ? AND type = ?", game.ID, playerID, round.ID, models.ScoreTypePublic).
+		Where("originally_secret = ? AND (override = '' OR override IS NULL)", false).
+		Count(&scored).Error; err != nil {
+		return err
+	}
+	if scored > 0 {
+		return handle.Invalid("override", "player %d has already scored a public objective in round %d; "+
+			"set override to imperial for the Imperial strategy card, or to other with a reason", playerID, round.Number)
+	}
+	return nil
+}
+
+// requirePublicObjectiveInGame checks that objective was dealt to the game
+// as the stage it is printed with and has been revealed by now.
+func requirePublicObjectiveInGame(game *models.Game, objective models.Objective) error {
+	var dealt models.GameObjective
+	err := database.DB.Preload("Round").
+		Where("game_id = ? AND objective_id = ?", game.ID, objective.ID).
+		First(&dealt).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound) && !game.UseObjectiveDecks:
+		return handle.Invalid("objective_id", "%s has not been assigned to game %d; assign it to the round it was revealed in first", objective.Name, game.ID)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return handle.Invalid("objective_id", "%s is not one of the objectives in game %d", objective.Name, game.ID)
+	case err != nil:
+		return err
+	}
+
+	if !dealt.Revealed {
+		return handle.Invalid("objective_id", "%s has not been revealed yet", objective.Name)
+	}
+	if dealt.Stage != "" && dealt.Stage != objective.Stage {
+		return handle.Invalid("objective_id", "%s is a Stage %s objective but was dealt as Stage %s", objective.Name, objective.Stage, dealt.Stage)
+	}
+	// Objectives revealed by Incentive Program have no round.
+	if dealt.RoundID != 0 && dealt.Round.Number > game.CurrentRound {
+		return handle.Invalid("objective_id", "%s is revealed in round %d", objective.Name, dealt.Round.Number)
+	}
+	return nil
+}
+
+// validateScoreOverride checks an override of the one public objective per
+// status phase limit. Imperial is played in the action phase, once a round.
+func validateScoreOverride(gameID uint, round models.Round, objective models.Objective, override string) error {
+	if override != models.ScoreOverrideImperial {
+		return nil
+	}
+	if err := RequirePhase(gameID, fmt.Sprintf("scoring %s with Imperial", objective.Name), models.PhaseAction); err != nil {
+		return err
+	}
+	var used int64
+	if err := database.DB.Model(&models.Score{}).
+		Where("game_id = ? AND round_id = ? AND override = ?", gameID, round.ID, models.ScoreOverrideImperial).
+		Count(&used).Error; err != nil {
+		return err
+	}
+	if used > 0 {
+		return handle.Invalid("override", "Imperial has already been used to score an objective in round %d", round.Number)
+	}
+	return nil
+}
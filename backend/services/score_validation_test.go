@@ -0,0 +1,238 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// scoringFixture is a game in round 2 with three stage I objectives revealed
+// in round 1, one dealt but not revealed, one never dealt, and a secret
+// leaked by Classified Document Leaks.
+type scoringFixture struct {
+	game            models.Game
+	earlier, round  models.Round
+	alice, bob      uint
+	revealed        []models.Objective
+	hidden, undealt models.Objective
+	leaked          models.Objective
+}
+
+func newScoringFixture(t *testing.T, phase string) scoringFixture {
+	t.Helper()
+	db := database.DB
+	var f scoringFixture
+
+	var stageI []models.Objective
+	if err := db.Where("stage = ?", "I").Order("id").Limit(5).Find(&stageI).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Where("LOWER(type) = ?", "secret").Order("id").First(&f.leaked).Error; err != nil {
+		t.Fatal(err)
+	}
+	f.revealed, f.hidden, f.undealt = stageI[:3], stageI[3], stageI[4]
+
+	players := []models.Player{{Name: "Alice"}, {Name: "Bob"}}
+	if err := db.Create(&players).Error; err != nil {
+		t.Fatal(err)
+	}
+	f.alice, f.bob = players[0].ID, players[1].ID
+
+	f.game = models.Game{GameNumber: 1, WinningPoints: 10, CurrentRound: 2, UseObjectiveDecks: true}
+	if err := db.Create(&f.game).Error; err != nil {
+		t.Fatal(err)
+	}
+	f.earlier = models.Round{GameID: f.game.ID, Number: 1}
+	f.round = models.Round{GameID: f.game.ID, Number: 2, Phase: phase}
+	if err := db.Create(&f.earlier).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Create(&f.round).Error; err != nil {
+		t.Fatal(err)
+	}
+	gamePlayers := []models.GamePlayer{{GameID: f.game.ID, PlayerID: f.alice}, {GameID: f.game.ID, PlayerID: f.bob}}
+	if err := db.Create(&gamePlayers).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range append(f.revealed, f.hidden) {
+		dealt := models.GameObjective{GameID: f.game.ID, ObjectiveID: o.ID, RoundID: f.earlier.ID, Stage: "I", Revealed: o.ID != f.hidden.ID}
+		if err := db.Create(&dealt).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	leak := models.Score{GameID: f.game.ID, RoundID: f.earlier.ID, PlayerID: f.bob, ObjectiveID: f.leaked.ID,
+		Type: models.ScoreTypeAgenda, AgendaTitle: models.AgendaCDL}
+	if err := db.Create(&leak).Error; err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestValidatePublicScoringRules(t *testing.T) {
+	const (
+		ok      = ""
+		invalid = "invalid"
+		rule    = "rule"
+	)
+	tests := []struct {
+		name      string
+		phase     string
+		prior     func(f scoringFixture) []models.Score
+		bob       bool // score as Bob rather than Alice
+		objective func(f scoringFixture) models.Objective
+		override  string
+		want      string
+	}{
+		{
+			name:  "first public in the status phase",
+			phase: models.PhaseStatus,
+			want:  ok,
+		},
+		{
+			name:  "phases not tracked",
+			phase: "",
+			want:  ok,
+		},
+		{
+			name:  "outside the status phase",
+			phase: models.PhaseAction,
+			want:  rule,
+		},
+		{
+			name:  "second public in the same status phase",
+			phase: models.PhaseStatus,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.alice, RoundID: f.round.ID, ObjectiveID: f.revealed[1].ID}}
+			},
+			want: invalid,
+		},
+		{
+			name:  "public scored in an earlier round",
+			phase: models.PhaseStatus,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.alice, RoundID: f.earlier.ID, ObjectiveID: f.revealed[1].ID}}
+			},
+			want: ok,
+		},
+		{
+			name:  "another player's public this round",
+			phase: models.PhaseStatus,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.bob, RoundID: f.round.ID, ObjectiveID: f.revealed[1].ID}}
+			},
+			want: ok,
+		},
+		{
+			name:  "Imperial in the action phase after a public this round",
+			phase: models.PhaseAction,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.alice, RoundID: f.round.ID, ObjectiveID: f.revealed[1].ID}}
+			},
+			override: models.ScoreOverrideImperial,
+			want:     ok,
+		},
+		{
+			name:     "Imperial outside the action phase",
+			phase:    models.PhaseStatus,
+			override: models.ScoreOverrideImperial,
+			want:     rule,
+		},
+		{
+			name:  "Imperial used twice in a round",
+			phase: models.PhaseAction,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.bob, RoundID: f.round.ID, ObjectiveID: f.revealed[1].ID, Override: models.ScoreOverrideImperial}}
+			},
+			override: models.ScoreOverrideImperial,
+			want:     invalid,
+		},
+		{
+			name:  "Imperial used in an earlier round",
+			phase: models.PhaseAction,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.bob, RoundID: f.earlier.ID, ObjectiveID: f.revealed[1].ID, Override: models.ScoreOverrideImperial}}
+			},
+			override: models.ScoreOverrideImperial,
+			want:     ok,
+		},
+		{
+			name:  "an Imperial score leaves the status phase score",
+			phase: models.PhaseStatus,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.alice, RoundID: f.round.ID, ObjectiveID: f.revealed[1].ID, Override: models.ScoreOverrideImperial}}
+			},
+			want: ok,
+		},
+		{
+			name:  "other override after a public this round",
+			phase: models.PhaseStatus,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.alice, RoundID: f.round.ID, ObjectiveID: f.revealed[1].ID}}
+			},
+			override: models.ScoreOverrideOther,
+			want:     ok,
+		},
+		{
+			name:      "objective not revealed yet",
+			phase:     models.PhaseStatus,
+			objective: func(f scoringFixture) models.Objective { return f.hidden },
+			want:      invalid,
+		},
+		{
+			name:      "objective not dealt to the game",
+			phase:     models.PhaseStatus,
+			objective: func(f scoringFixture) models.Objective { return f.undealt },
+			want:      invalid,
+		},
+		{
+			name:      "secret leaked by Classified Document Leaks",
+			phase:     models.PhaseStatus,
+			objective: func(f scoringFixture) models.Objective { return f.leaked },
+			want:      ok,
+		},
+		{
+			name:  "the leaked secret does not use up its owner's status phase score",
+			phase: models.PhaseStatus,
+			prior: func(f scoringFixture) []models.Score {
+				return []models.Score{{PlayerID: f.bob, RoundID: f.round.ID, ObjectiveID: f.leaked.ID, OriginallySecret: true}}
+			},
+			bob:  true,
+			want: ok,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtest.Open(t, "sqlite")
+			f := newScoringFixture(t, tt.phase)
+			if tt.prior != nil {
+				for _, s := range tt.prior(f) {
+					s.GameID, s.Type, s.Points = f.game.ID, models.ScoreTypePublic, 1
+					if err := database.DB.Create(&s).Error; err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			player, objective := f.alice, f.revealed[0]
+			if tt.bob {
+				player = f.bob
+			}
+			if tt.objective != nil {
+				objective = tt.objective(f)
+			}
+
+			err := ValidatePublicScoringRules(&f.game, player, f.round, objective, tt.override)
+			switch {
+			case tt.want == ok && err != nil:
+				t.Errorf("got %v, want no error", err)
+			case tt.want == invalid && !handle.IsValidation(err):
+				t.Errorf("got %v, want a validation error", err)
+			case tt.want == rule && !handle.IsRule(err):
+				t.Errorf("got %v, want a rule error", err)
+			}
+		})
+	}
+}
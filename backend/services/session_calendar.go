@@ -0,0 +1,105 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// scheduledSessionLength is how long a scheduled session is shown for in
+// calendars; sessions only record their real length once played.
+const scheduledSessionLength = 4 * time.Hour
+
+const icalTime = "20060102T150405Z"
+
+// UpcomingSessionsICal writes the upcoming sessions as an iCalendar feed,
+// with who is coming in each event's description.
+func UpcomingSessionsICal() (string, error) {
+	sessions, err := UpcomingSessions()
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	line := func(name, value string) {
+		writeICalLine(&b, name+":"+value)
+	}
+	stamp := time.Now().UTC().Format(icalTime)
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//TI4 Stats//Game sessions//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("X-WR-CALNAME", "Twilight Imperium sessions")
+	for _, u := range sessions {
+		s := u.Session
+		start := s.StartsAt.UTC()
+		summary := fmt.Sprintf("Twilight Imperium game #%d", u.GameNumber)
+		if u.GameTitle != "" {
+			summary += ": " + u.GameTitle
+		}
+
+		line("BEGIN", "VEVENT")
+		line("UID", fmt.Sprintf("session-%d@ti4-stats", s.ID))
+		line("DTSTAMP", stamp)
+		line("DTSTART", start.Format(icalTime))
+		line("DTEND", start.Add(scheduledSessionLength).Format(icalTime))
+		line("SUMMARY", icalText(summary))
+		if s.Location != "" {
+			line("LOCATION", icalText(s.Location))
+		}
+		if desc := sessionDescription(s); desc != "" {
+			line("DESCRIPTION", icalText(desc))
+		}
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+	return b.String(), nil
+}
+
+// sessionDescription lists the RSVPs by answer, then the notes.
+func sessionDescription(s models.GameSession) string {
+	byStatus := map[string][]string{}
+	for _, r := range s.RSVPs {
+		byStatus[r.Status] = append(byStatus[r.Status], r.Player)
+	}
+	var lines []string
+	for _, group := range []struct{ status, label string }{
+		{models.RSVPYes, "Coming"},
+		{models.RSVPMaybe, "Maybe"},
+		{models.RSVPNo, "Not coming"},
+	} {
+		if names := byStatus[group.status]; len(names) > 0 {
+			lines = append(lines, group.label+": "+strings.Join(names, ", "))
+		}
+	}
+	if notes := strings.TrimSpace(s.Notes); notes != "" {
+		lines = append(lines, notes)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// icalText escapes a TEXT value (RFC 5545 section 3.3.11).
+func icalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeICalLine folds a content line at 75 octets, without splitting a
+// UTF-8 character, and ends it with CRLF.
+func writeICalLine(b *strings.Builder, s string) {
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		limit = 74 // the leading space counts
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers/stats"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+// runningSession returns the game's running session, or
+// gorm.ErrRecordNotFound when it is paused.
+func runningSession(tx *gorm.DB, gameID uint) (models.GameSession, error) {
+	var s models.GameSession
+	err := tx.Where("game_id = ? AND started_at IS NOT NULL AND ended_at IS NULL", gameID).First(&s).Error
+	return s, err
+}
+
+// isPaused reports whether a game that is timed by sessions has none
+// running. Games recorded before sessions were kept are never paused.
+func isPaused(tx *gorm.DB, gameID uint) (bool, error) {
+	var started, running int64
+	if err := tx.Model(&models.GameSession{}).
+		Where("game_id = ? AND started_at IS NOT NULL", gameID).
+		Count(&started).Error; err != nil {
+		return false, err
+	}
+	if err := tx.Model(&models.GameSession{}).
+		Where("game_id = ? AND started_at IS NOT NULL AND ended_at IS NULL", gameID).
+		Count(&running).Error; err != nil {
+		return false, err
+	}
+	return started > 0 && running == 0, nil
+}
+
+// startFirstSession starts timing a new game from its creation.
+func startFirstSession(tx *gorm.DB, game models.Game) error {
+	return tx.Create(&models.GameSession{
+		GameID:    game.ID,
+		StartedAt: &game.CreatedAt,
+		Location:  game.Location,
+	}).Error
+}
+
+// endRunningSession ends the game's running session, if there is one, when
+// the game is paused or finishes.
+func endRunningSession(tx *gorm.DB, gameID uint, now time.Time) error {
+	return tx.Model(&models.GameSession{}).
+		Where("game_id = ? AND started_at IS NOT NULL AND ended_at IS NULL", gameID).
+		Update("ended_at", now).Error
+}
+
+// fillRSVPPlayers sets the player names on the sessions' RSVPs.
+func fillRSVPPlayers(sessions []models.GameSession) error {
+	var ids []uint
+	for _, s := range sessions {
+		for _, r := range s.RSVPs {
+			ids = append(ids, r.PlayerID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	var players []models.Player
+	if err := database.DB.Where("id IN ?", ids).Find(&players).Error; err != nil {
+		return err
+	}
+	names := make(map[uint]string, len(players))
+	for _, p := range players {
+		names[p.ID] = p.Name
+	}
+	for i := range sessions {
+		for j := range sessions[i].RSVPs {
+			sessions[i].RSVPs[j].Player = names[sessions[i].RSVPs[j].PlayerID]
+		}
+	}
+	return nil
+}
+
+func loadSession(gameID, sessionID uint) (models.GameSession, error) {
+	var s models.GameSession
+	err := database.DB.Preload("RSVPs", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Where("id = ? AND game_id = ?", sessionID, gameID).
+		First(&s).Error
+	if err != nil {
+		return s, err
+	}
+	err = fillRSVPPlayers([]models.GameSession{s})
+	return s, err
+}
+
+// GetGameSessions lists a game's sessions in the order they were played or
+// are scheduled, with the time played so far.
+func GetGameSessions(gameID uint) (models.GameSessions, error) {
+	out := models.GameSessions{Sessions: []models.GameSession{}}
+	var game models.Game
+	if err := database.DB.First(&game, gameID).Error; err != nil {
+		return out, err
+	}
+	if err := database.DB.
+		Preload("RSVPs", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Where("game_id = ?", gameID).
+		Order("COALESCE(started_at, starts_at), id").
+		Find(&out.Sessions).Error; err != nil {
+		return out, err
+	}
+	if err := fillRSVPPlayers(out.Sessions); err != nil {
+		return out, err
+	}
+
+	now := time.Now()
+	var active time.Duration
+	for i, s := range out.Sessions {
+		switch {
+		case s.StartedAt == nil:
+		case s.EndedAt == nil:
+			out.Running = &out.Sessions[i]
+			active += now.Sub(*s.StartedAt)
+		default:
+			active += s.EndedAt.Sub(*s.StartedAt)
+		}
+	}
+	out.ActiveSeconds = int64(active.Seconds())
+	out.Active = stats.FormatDuration(active)
+	return out, nil
+}
+
+// ScheduleSession plans the next sitting of an unfinished game.
+func ScheduleSession(ctx context.Context, gameID uint, req models.ScheduleSessionRequest) (models.GameSession, error) {
+	db := database.DB.WithContext(ctx)
+	var game models.Game
+	if err := db.First(&game, gameID).Error; err != nil {
+		return models.GameSession{}, err
+	}
+	if game.FinishedAt != nil {
+		return models.GameSession{}, handle.Rule("game %d is finished", gameID)
+	}
+	location := strings.TrimSpace(req.Location)
+	if location == "" {
+		location = game.Location
+	}
+	startsAt := req.StartsAt
+	s := models.GameSession{
+		GameID:   gameID,
+		StartsAt: &startsAt,
+		Location: location,
+		Notes:    req.Notes,
+		RSVPs:    []models.SessionRSVP{},
+	}
+	err := db.Create(&s).Error
+	return s, err
+}
+
+// CancelSession deletes a scheduled session that has not started.
+func CancelSession(ctx context.Context, gameID, sessionID uint) error {
+	s, err := loadSession(gameID, sessionID)
+	if err != nil {
+		return err
+	}
+	if s.StartedAt != nil {
+		return handle.Rule("session %d has already started", sessionID)
+	}
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("session_id = ?", s.ID).Delete(&models.SessionRSVP{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&s).Error
+	})
+}
+
+// RespondToSession records whether a player in the game will come to a
+// session, replacing any earlier answer.
+func RespondToSession(ctx context.Context, gameID, sessionID uint, req models.RSVPRequest) (models.GameSession, error) {
+	db := database.DB.WithContext(ctx)
+	s, err := loadSession(gameID, sessionID)
+	if err != nil {
+		return s, err
+	}
+	if s.EndedAt != nil {
+		return s, handle.Rule("session %d is over", sessionID)
+	}
+	if err := requirePlayerInGame(gameID, req.PlayerID, "player_id"); err != nil {
+		return s, err
+	}
+
+	var rsvp models.SessionRSVP
+	err = db.Where("session_id = ? AND player_id = ?", s.ID, req.PlayerID).First(&rsvp).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		rsvp = models.SessionRSVP{SessionID: s.ID, PlayerID: req.PlayerID, Status: req.Status}
+		err = db.Create(&rsvp).Error
+	case err == nil:
+		err = db.Model(&rsvp).Update("status", req.Status).Error
+	}
+	if err != nil {
+		return s, err
+	}
+	return loadSession(gameID, sessionID)
+}
+
+// PauseGame ends the running session and the running turn, for a game that
+// carries on another evening.
+func PauseGame(ctx context.Context, gameID uint) (models.GameSession, error) {
+	var s models.GameSession
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var game models.Game
+		if err := tx.First(&game, gameID).Error; err != nil {
+			return err
+		}
+		var err error
+		if s, err = runningSession(tx, gameID); errors.Is(err, gorm.ErrRecordNotFound) {
+			return handle.Rule("game %d is not running", gameID)
+		} else if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if _, err := stopRunningTurn(tx, gameID, now); err != nil {
+			return err
+		}
+		return tx.Model(&s).Update("ended_at", now).Error
+	})
+	if err != nil {
+		return s, err
+	}
+	return loadSession(gameID, s.ID)
+}
+
+// ResumeGame starts the next session: the earliest scheduled one that has
+// not started, or a new one.
+func ResumeGame(ctx context.Context, gameID uint) (models.GameSession, error) {
+	var s models.GameSession
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var game models.Game
+		if err := tx.First(&game, gameID).Error; err != nil {
+			return err
+		}
+		if game.FinishedAt != nil {
+			return handle.Rule("game %d is finished", gameID)
+		}
+		if _, err := runningSession(tx, gameID); err == nil {
+			return handle.Rule("game %d is already running", gameID)
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		var err error
+		s, err = startNextSession(tx, game, time.Now())
+		return err
+	})
+	if err != nil {
+		return s, err
+	}
+	return loadSession(gameID, s.ID)
+}
+
+// startNextSession starts the earliest scheduled session that has not
+// started, or a new one when none is scheduled.
+func startNextSession(tx *gorm.DB, game models.Game, now time.Time) (models.GameSession, error) {
+	var s models.GameSession
+	err := tx.Where("game_id = ? AND started_at IS NULL", game.ID).Order("starts_at, id").First(&s).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		s = models.GameSession{GameID: game.ID, StartedAt: &now, Location: game.Location}
+		return s, tx.Create(&s).Error
+	case err != nil:
+		return s, err
+	}
+	return s, tx.Model(&s).Update("started_at", now).Error
+}
+
+// UpcomingSessions lists scheduled sessions of unfinished games that have
+// not yet started, soonest first.
+func UpcomingSessions() ([]models.UpcomingSession, error) {
+	var sessions []models.GameSession
+	if err := database.DB.
+		Preload("RSVPs", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Select("game_sessions.*").
+		Joins("JOIN games ON games.id = game_sessions.game_id").
+		Where("games.finished_at IS NULL AND game_sessions.started_at IS NULL AND game_sessions.starts_at >= ?", time.Now()).
+		Order("game_sessions.starts_at, game_sessions.id").
+		Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	if err := fillRSVPPlayers(sessions); err != nil {
+		return nil, err
+	}
+
+	var gameIDs []uint
+	for _, s := range sessions {
+		gameIDs = append(gameIDs, s.GameID)
+	}
+	var games []models.Game
+	if len(gameIDs) > 0 {
+		if err := database.DB.Where("id IN ?", gameIDs).Find(&games).Error; err != nil {
+			return nil, err
+		}
+	}
+	byID := make(map[uint]models.Game, len(games))
+	for _, g := range games {
+		byID[g.ID] = g
+	}
+
+	out := make([]models.UpcomingSession, len(sessions))
+	for i, s := range sessions {
+		g := byID[s.GameID]
+		out[i] = models.UpcomingSession{Session: s, GameNumber: g.GameNumber, GameTitle: g.Title}
+	}
+	return out, nil
+}
@@ -1,37 +1,39 @@
 package services
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"log"
 	"math/rand"
 
 	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
 	"github.com/arphillips06/TI4-stats/models"
 	"gorm.io/gorm"
 )
 
-func AssignSpeaker(gameID, roundNumber, playerID uint) error {
-	var round models.Round
-	if err := database.DB.
-		Where("game_id = ? AND number = ?", gameID, roundNumber).
-		First(&round).Error; err != nil {
-		return fmt.Errorf("could not find round %d for game %d: %w", roundNumber, gameID, err)
+func AssignSpeaker(ctx context.Context, gameID, roundNumber, playerID uint) error {
+	db := database.DB.WithContext(ctx)
+	round, err := roundByNumber(gameID, int(roundNumber))
+	if err != nil {
+		return err
 	}
 
+	// playerID is the game player's ID here, not the player's.
 	var player models.GamePlayer
-	if err := database.DB.First(&player, playerID).Error; err != nil {
-		return err
+	err = db.First(&player, playerID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && player.GameID != gameID) {
+		return handle.Invalid("player_id", "game player %d is not in game %d", playerID, gameID)
 	}
-	if player.GameID != gameID {
-		return fmt.Errorf("player does not belong to this game")
+	if err != nil {
+		return err
 	}
 
 	var existing models.SpeakerAssignment
-	err := database.DB.Where("game_id = ? AND round_id = ?", gameID, round.ID).First(&existing).Error
+	err = db.Where("game_id = ? AND round_id = ?", gameID, round.ID).First(&existing).Error
 	if err == nil {
 		existing.PlayerID = playerID
-		return database.DB.Save(&existing).Error
+		return db.Save(&existing).Error
 	}
 
 	sa := models.SpeakerAssignment{
@@ -39,12 +41,15 @@ func AssignSpeaker(gameID, roundNumber, playerID uint) error {
 		RoundID:  round.ID,
 		PlayerID: playerID,
 	}
-	return database.DB.Create(&sa).Error
+	return db.Create(&sa).Error
 }
 
-func RandomiseSpeaker(gameID uint) (*models.Player, error) {
-	var players []models.Player
-	if err := database.DB.Where("game_id = ?", gameID).Find(&players).Error; err != nil {
+func RandomiseSpeaker(ctx context.Context, gameID uint) (*models.Player, error) {
+	db := database.DB.WithContext(ctx)
+	var players []models.GamePlayer
+	if err := db.Preload("Player").
+		Where("game_id = ?", gameID).
+		Find(&players).Error; err != nil {
 		return nil, errors.New("failed to fetch players")
 	}
 
@@ -52,21 +57,22 @@ func RandomiseSpeaker(gameID uint) (*models.Player, error) {
 		return nil, errors.New("no players found for this game")
 	}
 
+	// Speaker columns hold game player IDs.
 	chosen := players[rand.Intn(len(players))]
 
 	var game models.Game
-	if err := database.DB.First(&game, gameID).Error; err != nil {
+	if err := db.First(&game, gameID).Error; err != nil {
 		return nil, errors.New("failed to fetch game")
 	}
 
 	var round models.Round
-	err := database.DB.
+	err := db.
 		Where("game_id = ?", gameID).
 		Order("number ASC").
 		First(&round).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		round = models.Round{GameID: gameID, Number: 1}
-		if err := database.DB.Create(&round).Error; err != nil {
+		if err := db.Create(&round).Error; err != nil {
 			return nil, errors.New("failed to create round 1")
 		}
 		log.Println("🆕 Created round 1 for game", gameID)
@@ -79,14 +85,14 @@ func RandomiseSpeaker(gameID uint) (*models.Player, error) {
 		RoundID:  round.ID,
 		PlayerID: chosen.ID,
 	}
-	if err := database.DB.Create(&assignment).Error; err != nil {
+	if err := db.Create(&assignment).Error; err != nil {
 		return nil, errors.New("failed to create speaker assignment")
 	}
 
-	if err := database.DB.Model(&models.Game{}).Where("id = ?", gameID).
+	if err := db.Model(&models.Game{}).Where("id = ?", gameID).
 		Update("speaker_id", chosen.ID).Error; err != nil {
 		return nil, errors.New("failed to update game speaker")
 	}
 
-	return &chosen, nil
+	return &chosen.Player, nil
 }
@@ -1,6 +1,8 @@
 package services
 
 import (
+	"time"
+
 	"github.com/arphillips06/TI4-stats/helpers/stats"
 	"github.com/arphillips06/TI4-stats/models"
 )
@@ -36,10 +38,9 @@ type StatsOverview struct {
 	GameLengthDistribution     map[int]int                                 `json:"gameLengthDistribution"`
 	CommonVictoryPaths         map[string]int                              `json:"commonVictoryPaths"`
 	FactionObjectiveStats      map[string]map[string]models.ObjectiveStats `json:"factionObjectiveStats"`
+	GeneratedAt                time.Time                                   `json:"generatedAt" format:"date-time"`
 }
 
-var CachedVictoryPathCounts = map[string]int{}
-
 func CalculateStatsOverview() (*StatsOverview, error) {
 	totalGames, err := stats.CountTotalGames()
 	if err != nil {
@@ -17,7 +17,7 @@ type PlayerCustodiansStats struct {
 
 func GetPlayerCustodiansStats() ([]PlayerCustodiansStats, error) {
 	var players []models.Player
-	if err := database.DB.Find(&players).Error; err != nil {
+	if err := database.DB.Where("active = ?", true).Find(&players).Error; err != nil {
 		return nil, err
 	}
 
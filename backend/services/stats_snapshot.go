@@ -0,0 +1,115 @@
+package services
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// statsSnapshot holds the materialised stats overview. It is rebuilt after
+// every write, so overview requests are served from memory instead of
+// re-running every aggregate query.
+type statsSnapshot struct {
+	mu           sync.RWMutex
+	overview     *StatsOverview
+	victoryPaths map[string]int
+	generation   uint64 // bumped on every invalidation
+	builtFrom    uint64 // generation the current overview was built from
+
+	rebuildMu sync.Mutex // serialises rebuilds
+}
+
+var snapshot = &statsSnapshot{generation: 1}
+
+// GetStatsSnapshot returns the current stats overview, rebuilding it first if
+// it has been invalidated. The returned value is shared and must be treated
+// as read-only.
+func GetStatsSnapshot() (*StatsOverview, error) {
+	snapshot.mu.RLock()
+	overview, fresh := snapshot.overview, snapshot.builtFrom == snapshot.generation
+	snapshot.mu.RUnlock()
+
+	if overview != nil && fresh {
+		return overview, nil
+	}
+	if err := RebuildStatsSnapshot(); err != nil {
+		return nil, err
+	}
+
+	snapshot.mu.RLock()
+	defer snapshot.mu.RUnlock()
+	return snapshot.overview, nil
+}
+
+// RebuildStatsSnapshot recomputes every aggregate and swaps the result in.
+// Concurrent callers wait for the rebuild already in flight rather than
+// starting another one.
+func RebuildStatsSnapshot() error {
+	snapshot.rebuildMu.Lock()
+	defer snapshot.rebuildMu.Unlock()
+
+	snapshot.mu.RLock()
+	gen := snapshot.generation
+	upToDate := snapshot.overview != nil && snapshot.builtFrom == gen
+	snapshot.mu.RUnlock()
+	if upToDate {
+		return nil
+	}
+
+	start := time.Now()
+	overview, err := CalculateStatsOverview()
+	if err != nil {
+		return err
+	}
+	custodians, err := GetPlayerCustodiansStats()
+	if err != nil {
+		return err
+	}
+	overview.CustodiansStats = custodians
+	overview.GeneratedAt = time.Now()
+
+	snapshot.mu.Lock()
+	snapshot.overview = overview
+	snapshot.victoryPaths = overview.CommonVictoryPaths
+	snapshot.builtFrom = gen
+	snapshot.mu.Unlock()
+
+	log.Printf("[StatsSnapshot] rebuilt generation %d in %s", gen, time.Since(start))
+	return nil
+}
+
+// InvalidateStatsSnapshot marks the snapshot as out of date and starts a
+// background rebuild. Call it once a change to games, players or scores has
+// been committed; a rebuild that starts earlier would not see it.
+func InvalidateStatsSnapshot() {
+	snapshot.mu.Lock()
+	snapshot.generation++
+	snapshot.mu.Unlock()
+
+	go func() {
+		if err := RebuildStatsSnapshot(); err != nil {
+			log.Printf("[StatsSnapshot] background rebuild failed: %v", err)
+		}
+	}()
+}
+
+// VictoryPathFrequency returns how many finished games were won via the given
+// victory path key.
+func VictoryPathFrequency(key string) int {
+	snapshot.mu.RLock()
+	freq, found := snapshot.victoryPaths[key]
+	fresh := snapshot.builtFrom == snapshot.generation
+	snapshot.mu.RUnlock()
+
+	if found && fresh {
+		return freq
+	}
+
+	if err := RebuildStatsSnapshot(); err != nil {
+		log.Printf("[StatsSnapshot] rebuild for victory path lookup failed: %v", err)
+		return freq
+	}
+	snapshot.mu.RLock()
+	defer snapshot.mu.RUnlock()
+	return snapshot.victoryPaths[key]
+}
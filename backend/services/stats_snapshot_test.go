@@ -0,0 +1,70 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// TestStatsSnapshotGenerations checks that the snapshot is served from
+// memory until it is invalidated, and rebuilt from the data afterwards.
+func TestStatsSnapshotGenerations(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T) {
+		dbtest.SeedFinishedGame(t)
+		// The snapshot may have been built from another test's database.
+		if err := rebuildNow(); err != nil {
+			t.Fatal(err)
+		}
+
+		first, err := GetStatsSnapshot()
+		if err != nil {
+			t.Fatalf("GetStatsSnapshot: %v", err)
+		}
+		if first.TotalGames != 1 {
+			t.Fatalf("TotalGames = %d, want 1", first.TotalGames)
+		}
+		again, err := GetStatsSnapshot()
+		if err != nil {
+			t.Fatalf("GetStatsSnapshot: %v", err)
+		}
+		if again != first {
+			t.Error("the snapshot was rebuilt without being invalidated")
+		}
+
+		// A game that is still running counts as soon as it is invalidated.
+		if err := database.DB.Create(&models.Game{GameNumber: 2, WinningPoints: 10, CurrentRound: 1}).Error; err != nil {
+			t.Fatal(err)
+		}
+		if stale, _ := GetStatsSnapshot(); stale.TotalGames != 1 {
+			t.Errorf("TotalGames = %d before invalidating, want the snapshot's 1", stale.TotalGames)
+		}
+
+		snapshot.mu.RLock()
+		before := snapshot.generation
+		snapshot.mu.RUnlock()
+		InvalidateStatsSnapshot()
+
+		rebuilt, err := GetStatsSnapshot()
+		if err != nil {
+			t.Fatalf("GetStatsSnapshot: %v", err)
+		}
+		if rebuilt.TotalGames != 2 {
+			t.Errorf("TotalGames = %d after invalidating, want 2", rebuilt.TotalGames)
+		}
+		snapshot.mu.RLock()
+		defer snapshot.mu.RUnlock()
+		if snapshot.generation != before+1 || snapshot.builtFrom != snapshot.generation {
+			t.Errorf("generation %d built from %d, want both %d", snapshot.generation, snapshot.builtFrom, before+1)
+		}
+	})
+}
+
+// rebuildNow invalidates the snapshot and waits for it to be rebuilt.
+func rebuildNow() error {
+	snapshot.mu.Lock()
+	snapshot.generation++
+	snapshot.mu.Unlock()
+	return RebuildStatsSnapshot()
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arphillips06/TI4-stats/database"
+	"github.com/arphillips06/TI4-stats/database/dbtest"
+	"github.com/arphillips06/TI4-stats/helpers/stats"
+	"github.com/arphillips06/TI4-stats/models"
+)
+
+// TestStatsQueries runs the stats queries, raw SQL included, on each
+// driver.
+func TestStatsQueries(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T) {
+		game := dbtest.SeedFinishedGame(t)
+
+		overview, err := CalculateStatsOverview()
+		if err != nil {
+			t.Fatalf("CalculateStatsOverview: %v", err)
+		}
+		if overview.TotalGames != 1 {
+			t.Errorf("TotalGames = %d, want 1", overview.TotalGames)
+		}
+		if got := overview.GamesWonByFaction["Arborec"]; got != 1 {
+			t.Errorf("GamesWonByFaction[Arborec] = %d, want 1", got)
+		}
+
+		finishes := map[string]int{}
+		for _, f := range overview.PlayerMostCommonFinishes {
+			finishes[f.Player] = f.Position
+		}
+		for name, want := range map[string]int{"Alice": 1, "Bob": 2, "Carol": 2} {
+			if finishes[name] != want {
+				t.Errorf("%s's most common finish = %d, want %d", name, finishes[name], want)
+			}
+		}
+
+		var arborec *models.FactionAggregateStats
+		for i, f := range overview.FactionAggregateStats {
+			if f.Faction == "Arborec" {
+				arborec = &overview.FactionAggregateStats[i]
+			}
+		}
+		if arborec == nil {
+			t.Errorf("no aggregate stats for Arborec in %+v", overview.FactionAggregateStats)
+		} else if arborec.TotalPointsScored != 10 || arborec.WonCount != 1 || len(arborec.VPHistogram) != 1 {
+			t.Errorf("Arborec aggregate = %+v, want 10 points, 1 win and one histogram bucket", *arborec)
+		}
+
+		custodians, err := GetPlayerCustodiansStats()
+		if err != nil {
+			t.Fatalf("GetPlayerCustodiansStats: %v", err)
+		}
+		for _, c := range custodians {
+			if c.PlayerName == "Bob" && (c.CustodiansTaken != 1 || c.CustodiansWins != 0) {
+				t.Errorf("Bob's custodians stats = %+v, want 1 taken and no wins", c)
+			}
+		}
+
+		if _, err := stats.CalculateFactionObjectiveStats(); err != nil {
+			t.Errorf("CalculateFactionObjectiveStats: %v", err)
+		}
+		summary, err := GetObjectiveScoreSummary(game.ID)
+		if err != nil {
+			t.Fatalf("GetObjectiveScoreSummary: %v", err)
+		}
+		if len(summary) != 3 {
+			t.Errorf("GetObjectiveScoreSummary returned %d objectives, want 3", len(summary))
+		}
+		if _, err := CalculateObjectiveDifficulty(context.Background(), database.DB, ObjectiveDifficultyOptions{}); err != nil {
+			t.Errorf("CalculateObjectiveDifficulty: %v", err)
+		}
+	})
+}
@@ -0,0 +1,313 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers"
+	"github.com/arphillips06/TI4-stats/helpers/stats"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+const (
+	SupportActionGive      = "give"
+	SupportActionReturn    = "return"
+	SupportActionEliminate = "eliminate"
+)
+
+// ApplySupportAction gives or returns a Support for the Throne note, or
+// eliminates a player, updating victory points to match.
+func ApplySupportAction(ctx context.Context, gameID uint, req models.SupportActionRequest) error {
+	switch req.Action {
+	case SupportActionGive:
+		return GiveSupport(ctx, gameID, req.OwnerID, req.HolderID)
+	case SupportActionReturn:
+		return ReturnSupport(ctx, gameID, req.OwnerID, req.HolderID)
+	case SupportActionEliminate:
+		return EliminatePlayer(ctx, gameID, req.PlayerID)
+	default:
+		return handle.Invalid("action", "must be one of give, return or eliminate")
+	}
+}
+
+// HandleSupportForTheThrone backs the older per-player endpoint, where
+// "score" gives the holder a note and "unscore" returns their latest one.
+// ownerID may be 0 when only one other player's note is still available.
+func HandleSupportForTheThrone(ctx context.Context, gameID, playerID, ownerID uint, action string) error {
+	if err := requirePlayerInGame(gameID, playerID, "player_id"); err != nil {
+		return err
+	}
+	switch action {
+	case "score":
+		if ownerID == 0 {
+			owner, err := onlyAvailableSupportOwner(gameID, playerID)
+			if err != nil {
+				return err
+			}
+			ownerID = owner
+		}
+		return GiveSupport(ctx, gameID, ownerID, playerID)
+	case "unscore":
+		return ReturnSupport(ctx, gameID, ownerID, playerID)
+	default:
+		return handle.Invalid("action", "must be one of score or unscore")
+	}
+}
+
+// unfinishedGame loads a game that is still being played.
+func unfinishedGame(ctx context.Context, gameID uint) (*models.Game, error) {
+	var game models.Game
+	if err := database.DB.WithContext(ctx).First(&game, gameID).Error; err != nil {
+		return nil, err
+	}
+	if game.FinishedAt != nil {
+		return nil, handle.Rule("game is already finished")
+	}
+	return &game, nil
+}
+
+// activeGamePlayer loads a player already checked with requirePlayerInGame
+// and refuses one who has been eliminated.
+func activeGamePlayer(tx *gorm.DB, gameID, playerID uint) (models.GamePlayer, error) {
+	var gp models.GamePlayer
+	if err := tx.Preload("Player").
+		Where("game_id = ? AND player_id = ?", gameID, playerID).
+		First(&gp).Error; err != nil {
+		return gp, err
+	}
+	if gp.Eliminated {
+		return gp, handle.Rule("%s has been eliminated", gp.Player.Name)
+	}
+	return gp, nil
+}
+
+func supportNoteTitle(owner models.Player) string {
+	if owner.Name == "" {
+		return models.SupportForTheThrone // backfilled note, owner unknown
+	}
+	return fmt.Sprintf("%s (%s)", models.SupportForTheThrone, owner.Name)
+}
+
+func supportScore(tx *gorm.DB, gameID, roundID, holderID uint, points int, owner models.Player) error {
+	return tx.Create(&models.Score{
+		GameID:    gameID,
+		RoundID:   roundID,
+		PlayerID:  holderID,
+		Points:    points,
+		Type:      models.ScoreTypeSupport,
+		CardTitle: supportNoteTitle(owner),
+	}).Error
+}
+
+// GiveSupport puts ownerID's Support for the Throne in holderID's play area
+// and gives the holder 1 VP.
+func GiveSupport(ctx context.Context, gameID, ownerID, holderID uint) error {
+	if ownerID == holderID {
+		return handle.Rule("a player cannot hold their own Support for the Throne")
+	}
+	game, err := unfinishedGame(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	if err := requirePlayerInGame(gameID, ownerID, "owner_id"); err != nil {
+		return err
+	}
+	if err := requirePlayerInGame(gameID, holderID, "holder_id"); err != nil {
+		return err
+	}
+	roundID, err := helpers.GetCurrentRoundID(gameID)
+	if err != nil {
+		return err
+	}
+
+	err = database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		owner, err := activeGamePlayer(tx, gameID, ownerID)
+		if err != nil {
+			return err
+		}
+		holder, err := activeGamePlayer(tx, gameID, holderID)
+		if err != nil {
+			return err
+		}
+
+		var current models.SupportHolding
+		err = tx.Preload("Holder").
+			Where("game_id = ? AND owner_id = ? AND ended_at IS NULL", gameID, ownerID).
+			First(&current).Error
+		if err == nil {
+			return handle.Rule("%s's Support for the Throne is already held by %s", owner.Player.Name, current.Holder.Name)
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err := tx.Create(&models.SupportHolding{
+			GameID:   gameID,
+			OwnerID:  &ownerID,
+			HolderID: holder.PlayerID,
+			RoundID:  roundID,
+		}).Error; err != nil {
+			return err
+		}
+		return supportScore(tx, gameID, roundID, holderID, 1, owner.Player)
+	})
+	if err != nil {
+		return err
+	}
+
+	return MaybeFinishGameFromScore(ctx, game, holderID)
+}
+
+// ReturnSupport returns a note to its owner and removes the holder's VP.
+// Either side may be 0: with only the holder given, their most recently
+// received note is returned.
+func ReturnSupport(ctx context.Context, gameID, ownerID, holderID uint) error {
+	if ownerID == 0 && holderID == 0 {
+		return handle.Invalid("owner_id", "or holder_id is required")
+	}
+	if _, err := unfinishedGame(ctx, gameID); err != nil {
+		return err
+	}
+	roundID, err := helpers.GetCurrentRoundID(gameID)
+	if err != nil {
+		return err
+	}
+
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := tx.Preload("Owner").Where("game_id = ? AND ended_at IS NULL", gameID)
+		if ownerID != 0 {
+			q = q.Where("owner_id = ?", ownerID)
+		}
+		if holderID != 0 {
+			q = q.Where("holder_id = ?", holderID)
+		}
+
+		var holding models.SupportHolding
+		err := q.Order("created_at DESC, id DESC").First(&holding).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return handle.Rule("no matching Support for the Throne is held")
+		}
+		if err != nil {
+			return err
+		}
+		return endSupportHolding(tx, holding, roundID, models.SupportReturned)
+	})
+}
+
+func endSupportHolding(tx *gorm.DB, holding models.SupportHolding, roundID uint, reason string) error {
+	now := time.Now()
+	if err := tx.Model(&holding).Updates(map[string]any{
+		"ended_at":   &now,
+		"end_reason": reason,
+	}).Error; err != nil {
+		return err
+	}
+	if reason == models.SupportHolderEliminated {
+		return nil
+	}
+	return supportScore(tx, holding.GameID, roundID, holding.HolderID, -1, holding.Owner)
+}
+
+// EliminatePlayer marks a player as eliminated. Their Support for the Throne
+// is purged, costing its holder 1 VP, and any notes they hold go back to
+// their owners.
+func EliminatePlayer(ctx context.Context, gameID, playerID uint) error {
+	if _, err := unfinishedGame(ctx, gameID); err != nil {
+		return err
+	}
+	if err := requirePlayerInGame(gameID, playerID, "player_id"); err != nil {
+		return err
+	}
+	roundID, err := helpers.GetCurrentRoundID(gameID)
+	if err != nil {
+		return err
+	}
+
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		gp, err := activeGamePlayer(tx, gameID, playerID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&gp).Update("eliminated", true).Error; err != nil {
+			return err
+		}
+
+		var holdings []models.SupportHolding
+		if err := tx.Preload("Owner").
+			Where("game_id = ? AND ended_at IS NULL AND (owner_id = ? OR holder_id = ?)", gameID, playerID, playerID).
+			Find(&holdings).Error; err != nil {
+			return err
+		}
+		for _, h := range holdings {
+			reason := models.SupportOwnerEliminated
+			if h.HolderID == playerID {
+				reason = models.SupportHolderEliminated
+			}
+			if err := endSupportHolding(tx, h, roundID, reason); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// onlyAvailableSupportOwner finds the owner whose note the holder could
+// receive when the client did not say whose it is.
+func onlyAvailableSupportOwner(gameID, holderID uint) (uint, error) {
+	var owners []uint
+	err := database.DB.Model(&models.GamePlayer{}).
+		Where("game_id = ? AND player_id <> ? AND eliminated = ?", gameID, holderID, false).
+		Where("player_id NOT IN (?)", database.DB.Model(&models.SupportHolding{}).
+			Select("owner_id").
+			Where("game_id = ? AND ended_at IS NULL", gameID)).
+		Pluck("player_id", &owners).Error
+	if err != nil {
+		return 0, err
+	}
+	switch len(owners) {
+	case 0:
+		return 0, handle.Rule("no Support for the Throne is available to give")
+	case 1:
+		return owners[0], nil
+	}
+	return 0, handle.Invalid("owner_id", "is required: more than one player's Support for the Throne is available")
+}
+
+// GetSupportHoldings returns every Support for the Throne given in a game,
+// current and past.
+func GetSupportHoldings(gameID uint) ([]models.SupportHoldingDTO, error) {
+	var holdings []models.SupportHolding
+	if err := database.DB.
+		Preload("Owner").
+		Preload("Holder").
+		Where("game_id = ?", gameID).
+		Order("created_at, id").
+		Find(&holdings).Error; err != nil {
+		return nil, err
+	}
+
+	out := make([]models.SupportHoldingDTO, 0, len(holdings))
+	for _, h := range holdings {
+		out = append(out, models.SupportHoldingDTO{
+			OwnerID:    h.OwnerID,
+			OwnerName:  h.Owner.Name,
+			HolderID:   h.HolderID,
+			HolderName: h.Holder.Name,
+			RoundID:    h.RoundID,
+			GivenAt:    h.CreatedAt,
+			EndedAt:    h.EndedAt,
+			EndReason:  h.EndReason,
+		})
+	}
+	return out, nil
+}
+
+// GetSupportStats returns Support for the Throne pairings across all games.
+func GetSupportStats() (models.SupportStats, error) {
+	return stats.CalculateSupportStats()
+}
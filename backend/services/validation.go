@@ -0,0 +1,74 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/arphillips06/TI4-stats/database"
+	handle "github.com/arphillips06/TI4-stats/errors"
+	"github.com/arphillips06/TI4-stats/helpers"
+	"github.com/arphillips06/TI4-stats/models"
+	"gorm.io/gorm"
+)
+
+// The checks here catch requests whose fields are well formed but name
+// something outside the game. They return a handle.ValidationError for the
+// field, which the API answers with 422.
+
+// requireGameExists checks that gameID names a game.
+func requireGameExists(gameID uint) error {
+	var count int64
+	if err := database.DB.Model(&models.Game{}).Where("id = ?", gameID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return handle.Invalid("game_id", "game %d does not exist", gameID)
+	}
+	return nil
+}
+
+// requirePlayerInGame checks that field holds a player seated in the game.
+func requirePlayerInGame(gameID, playerID uint, field string) error {
+	if playerID == 0 {
+		return handle.Invalid(field, "is required")
+	}
+	var count int64
+	if err := database.DB.Model(&models.GamePlayer{}).
+		Where("game_id = ? AND player_id = ?", gameID, playerID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return handle.Invalid(field, "player %d is not in game %d", playerID, gameID)
+	}
+	return nil
+}
+
+// resolveRound returns the round a request refers to: the current round
+// when roundID is 0, otherwise roundID once it is known to be one of the
+// game's rounds.
+func resolveRound(gameID, roundID uint) (uint, error) {
+	if roundID == 0 {
+		return helpers.GetCurrentRoundID(gameID)
+	}
+	var count int64
+	if err := database.DB.Model(&models.Round{}).
+		Where("id = ? AND game_id = ?", roundID, gameID).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, handle.Invalid("round_id", "round %d is not part of game %d", roundID, gameID)
+	}
+	return roundID, nil
+}
+
+// roundByNumber finds the game's round with the given number, for requests
+// that name rounds as players see them.
+func roundByNumber(gameID uint, number int) (models.Round, error) {
+	var round models.Round
+	err := database.DB.Where("game_id = ? AND number = ?", gameID, number).First(&round).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return round, handle.Invalid("round_id", "game %d has no round %d", gameID, number)
+	}
+	return round, err
+}
@@ -112,7 +112,7 @@ export default function GameNavbar({
                   onClick={() => setShowLatvinaModal(true)}
                   disabled={bookUsed}
                 >
-                  Book Of Latvina {bookUsed ? "(Used)" : ""}
+                  Book of Latvinia {bookUsed ? "(Used)" : ""}
                 </button>
               </li>
             </ul>
@@ -146,14 +146,14 @@ export default function GameModals({
       <RelicModal
         show={modals.latvina}
         onClose={() => toggleModal("latvina", false)}
-        title="Book of Latvina"
+        title="Book of Latvinia"
         players={playersSorted}
         onSubmit={(playerId) =>
           handleLatvina(playerId, gameId, refreshGameState, () =>
             toggleModal("latvina", false)
           )
         }
-        description='Choose a player to gain a point for having 4 tech specialty planets (Book of Latvina)'
+        description='Choose a player to gain a point for having 4 tech specialty planets (Book of Latvinia)'
       />
     </>
   );
@@ -1,5 +1,6 @@
 import React from "react";
 import API_BASE_URL from "../../config";
+import { jsonHeaders } from "../../utils/api";
 import "./playersidebar.css";
 
 export default function PlayerSidebar({
@@ -42,15 +43,23 @@ export default function PlayerSidebar({
     return !cdlRevealedObjectiveIds.has(score.ObjectiveID);
   };
 
+  // Support for the Throne notes currently in someone's play area
+  const heldSupport = (game?.support || []).filter((h) => !h.ended_at);
+  const supportOwnersFor = (playerId) =>
+    (playersSorted || []).filter(
+      (p) => p.player_id !== playerId && !p.eliminated && !heldSupport.some((h) => h.owner_id === p.player_id)
+    );
+
   // Reusable SFTT action
-  async function postSupportAction(playerId, action) {
+  async function postSupportAction(playerId, action, ownerId) {
     try {
       const res = await fetch(`${API_BASE_URL}/games/${gameId}/support/${playerId}`, {
         method: "POST",
-        headers: { "Content-Type": "application/json" },
+        headers: jsonHeaders(),
         body: JSON.stringify({
           round_id: game?.current_round_id,
           action, // "score" | "unscore"
+          owner_id: ownerId,
         }),
       });
       if (!res.ok) {
@@ -136,11 +145,7 @@ export default function PlayerSidebar({
                     <div className="d-flex align-items-center gap-2 mb-3">
                       <button
                         className="btn btn-sm btn-outline-danger"
-                        disabled={
-                          !allScores?.some(
-                            (s) => (s?.Type || s?.type) === "Support" && s.PlayerID === entry.player_id
-                          )
-                        }
+                        disabled={!heldSupport.some((h) => h.holder_id === entry.player_id)}
                         onClick={() => postSupportAction(entry.player_id, "unscore")}
                       >
                         −
@@ -148,28 +153,29 @@ export default function PlayerSidebar({
 
                       <span className="small">
                         {(() => {
-                          const total =
-                            allScores
-                              ?.filter((s) => (s?.Type || s?.type) === "Support" && s.PlayerID === entry.player_id)
-                              .reduce((sum, s) => sum + (s.Points || 0), 0) || 0;
-                          return `${total} Support point${total === 1 ? "" : "s"}`;
+                          const held = heldSupport
+                            .filter((h) => h.holder_id === entry.player_id)
+                            .map((h) => h.owner_name);
+                          return held.length ? held.join(", ") : "None held";
                         })()}
                       </span>
 
-                      <button
-                        className="btn btn-sm btn-outline-success"
-                        disabled={(() => {
-                          const n = playersSorted?.length || 0;
-                          const playerSupportPoints =
-                            allScores
-                              ?.filter((s) => (s?.Type || s?.type) === "Support" && s.PlayerID === entry.player_id)
-                              .reduce((acc, s) => acc + (s.Points || 0), 0) || 0;
-                          return playerSupportPoints >= Math.max(0, n - 1);
-                        })()}
-                        onClick={() => postSupportAction(entry.player_id, "score")}
+                      <select
+                        className="form-select form-select-sm w-auto"
+                        value=""
+                        disabled={supportOwnersFor(entry.player_id).length === 0}
+                        onChange={(e) =>
+                          e.target.value &&
+                          postSupportAction(entry.player_id, "score", Number(e.target.value))
+                        }
                       >
-                        +
-                      </button>
+                        <option value="">+ from…</option>
+                        {supportOwnersFor(entry.player_id).map((p) => (
+                          <option key={p.player_id} value={p.player_id}>
+                            {p.name}
+                          </option>
+                        ))}
+                      </select>
                     </div>
 
                     {/* ===== Secrets ===== */}
@@ -304,7 +310,7 @@ export default function PlayerSidebar({
                             try {
                               const res = await fetch(`${API_BASE_URL}/score/mecatol`, {
                                 method: "POST",
-                                headers: { "Content-Type": "application/json" },
+                                headers: jsonHeaders(),
                                 body: JSON.stringify({
                                   game_id: parseInt(gameId),
                                   player_id: entry.player_id,
@@ -372,7 +378,7 @@ export default function PlayerSidebar({
                               try {
                                 const res = await fetch(`${API_BASE_URL}/score/imperial`, {
                                   method: "POST",
-                                  headers: { "Content-Type": "application/json" },
+                                  headers: jsonHeaders(),
                                   body: JSON.stringify({
                                     game_id: parseInt(gameId),
                                     player_id: entry.player_id,
@@ -3,6 +3,7 @@ import { getJSON } from "../utils/helpers";
 import {
   isAgendaScore,
   isRelicScore,
+  relicHolderId,
 } from "../utils/selectors";
 
 const normalizeScore = (s) => ({
@@ -71,8 +72,7 @@ export default function useGameData(gameId) {
     setObjectives(normalizedObjectives);
     setObjectiveScores(scoreMap);
 
-    const obsidianScore = normalizedScores.find((s) => isRelicScore(s, "The Obsidian"));
-    setObsidianHolderId(obsidianScore?.PlayerID || null);
+    setObsidianHolderId(relicHolderId(gameData, "The Obsidian"));
   };
   useEffect(() => {
     (async () => {
@@ -106,7 +106,7 @@ export default function useGameData(gameId) {
       setMutinyUsed(normalizedScores.some((s) => s.AgendaTitle === "Mutiny"));
       setCdlUsed(normalizedScores.some((s) => s.AgendaTitle === "Classified Document Leaks"));
       setCrownUsed(normalizedScores.some((s) => isRelicScore(s, "The Crown of Emphidia")));
-      setObsidianHolderId(normalizedScores.find((s) => isRelicScore(s, "The Obsidian"))?.PlayerID || null);
+      setObsidianHolderId(relicHolderId(gameData, "The Obsidian"));
 
       const initialSecrets = {};
       (gameData.players || []).forEach((p) => {
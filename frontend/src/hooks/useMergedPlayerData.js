@@ -20,6 +20,7 @@ export default function useMergedPlayerData(game, sort = true) {
       factionKey,
       color: gp.color || "#000",
       points: scoreMap.get(p.ID)?.points || 0,
+      eliminated: !!gp.Eliminated,
     };
   });
 
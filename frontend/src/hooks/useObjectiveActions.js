@@ -1,5 +1,25 @@
 import { useCallback } from "react";
 import API_BASE_URL from "../config"
+import { jsonHeaders } from "../utils/api";
+
+// A second public objective in a round needs an override: the Imperial
+// strategy card, or another reason the player gives.
+function askForOverride(errorText) {
+  let fields = [];
+  try {
+    fields = JSON.parse(errorText).fields || [];
+  } catch {
+    return null;
+  }
+  const limit = fields.find((f) => f.field === "override");
+  if (!limit) return null;
+  const reason = window.prompt(
+    `${limit.message}\n\nType "imperial" if it was scored with the Imperial strategy card, or give another reason to record it anyway.`
+  );
+  if (!reason || !reason.trim()) return null;
+  if (reason.trim().toLowerCase() === "imperial") return { override: "imperial" };
+  return { override: "other", override_reason: reason.trim() };
+}
 
 export default function useObjectiveActions(gameId, refreshGameState, setLocalScored) {
   const scoreObjective = useCallback(
@@ -9,16 +29,24 @@ export default function useObjectiveActions(gameId, refreshGameState, setLocalSc
         player_id: playerId,
         objective_id: objectiveId,
       };
-
-      try {
-        const res = await fetch(`${API_BASE_URL}/score`, {
+      const post = (body) =>
+        fetch(`${API_BASE_URL}/score`, {
           method: "POST",
-          headers: { "Content-Type": "application/json" },
-          body: JSON.stringify(payload),
+          headers: jsonHeaders(),
+          body: JSON.stringify(body),
         });
 
+      try {
+        let res = await post(payload);
+        let errorText = res.ok ? "" : await res.text();
+
+        const override = res.status === 422 && askForOverride(errorText);
+        if (override) {
+          res = await post({ ...payload, ...override });
+          errorText = res.ok ? "" : await res.text();
+        }
+
         if (!res.ok) {
-          const errorText = await res.text();
           console.error("Scoring rejected:", errorText);
           alert("Scoring rejected by backend: " + errorText);
           return false;
@@ -48,7 +76,7 @@ export default function useObjectiveActions(gameId, refreshGameState, setLocalSc
       try {
         const res = await fetch(`${API_BASE_URL}/unscore`, {
           method: "POST",
-          headers: { "Content-Type": "application/json" },
+          headers: jsonHeaders(),
           body: JSON.stringify({
             game_id: parseInt(gameId),
             player_id: playerId,
@@ -79,7 +107,7 @@ export default function useObjectiveActions(gameId, refreshGameState, setLocalSc
       try {
         const res = await fetch(`${API_BASE_URL}/assign_objective`, {
           method: "POST",
-          headers: { "Content-Type": "application/json" },
+          headers: jsonHeaders(),
           body: JSON.stringify({
             game_id: parseInt(gameIdParam),
             round_id: roundId,
@@ -105,6 +133,7 @@ export default function useObjectiveActions(gameId, refreshGameState, setLocalSc
     try {
       const res = await fetch(`${API_BASE_URL}/games/${gameId}/advance-round`, {
         method: "POST",
+        headers: jsonHeaders(),
       });
 
       if (!res.ok) {
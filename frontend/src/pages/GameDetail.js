@@ -76,7 +76,7 @@ console.log("DEBUG: game object", game);
   );
 
   const obsidianUsed = isRelicUsed(game, "The Obsidian");
-  const bookUsed = isRelicUsed(game, "Book Of Latvina");
+  const bookUsed = isRelicUsed(game, "Book of Latvinia");
   const shardUsed = isRelicUsed(game, "The Crown of Emphidia");
 
   const [showScoreGraph, setShowScoreGraph] = useState(false);
@@ -100,10 +100,12 @@ export default function GameList() {
           />
           <div id="games-search-help" className="help-popover" role="tooltip">
             <div className="mb-1"><strong>Supported:</strong></div>
-            <div><code>w: - winner</code> · <code>p: - player</code> · <code>f: - faction</code></div>
-            <div><code>rounds&gt;=8</code> · <code>after:2025-07-01</code> · <code>before:2025-08-01</code></div>
+            <div><code>w: - winner</code> · <code>wf: - winner faction</code> · <code>p: - player</code> · <code>f: - faction</code></div>
+            <div><code>o: - objective scored</code> · <code>s: - secret scored</code></div>
+            <div><code>rounds&gt;=8</code> · <code>players=4</code> · <code>vp=14</code> · <code>after:2025-07-01</code> · <code>before:2025-08-01</code></div>
             <div><code>a: - agenda name</code> · <code>r: - relic name</code> · <code>c: - custodians true or false</code></div>
-            <div>Free text matches title/notes.</div>
+            <div>Prefix a term with <code>-</code> to exclude it, e.g. <code>-p:bob</code>.</div>
+            <div>Free text matches title/notes/location.</div>
             <div className="mt-1"><em>Press Enter to search immediately.</em></div>
           </div>
         </div>
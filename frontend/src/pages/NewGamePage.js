@@ -5,7 +5,8 @@ import PlayerInputRow from "../components/players/PlayerInputRow";
 import factionColors from "../data/factionColors";
 import '../pages/NewGamePage.css';
 import '../pages/stats.css';
-import { postJSON } from "../utils/helpers";
+import API_BASE_URL from "../config";
+import { jsonHeaders } from "../utils/api";
 
 const FACTIONS = Object.entries(factionColors).map(([key, data]) => ({
   key,
@@ -58,25 +59,49 @@ export default function NewGamePage() {
   };
 
 
+  // Names that look like a typo of an existing player come back as a 409
+  // with suggestions; ask before creating them as someone new.
   const startGame = async () => {
+    const entries = players.map((p) => ({ name: p.name, faction: p.faction, new: false }));
     try {
-      const payload = {
-        winning_points: winningPoints,
-        use_objective_decks: useObjectives,
-        players: players.map((p) => ({
-          name: p.name,
-          faction: p.faction,
-        })),
-        use_random_speaker: randomiseSpeaker,
-        speaker_id: randomiseSpeaker ? null : selectedSpeakerId,
-      };
-      const data = await postJSON("/games", payload);
-      const newGameId = data?.game?.id;
-      if (!newGameId) {
-        throw new Error("No game ID returned from backend");
-      }
-      navigate(`/games/${newGameId}`);
+      for (;;) {
+        const payload = {
+          winning_points: winningPoints,
+          use_objective_decks: useObjectives,
+          players: entries,
+          use_random_speaker: randomiseSpeaker,
+          speaker_id: randomiseSpeaker ? null : selectedSpeakerId,
+        };
+        const res = await fetch(`${API_BASE_URL}/games`, {
+          method: "POST",
+          headers: jsonHeaders(),
+          body: JSON.stringify(payload),
+        });
+        const data = await res.json().catch(() => ({}));
+
+        if (res.status === 409 && data.suggestions?.length) {
+          const entry = entries.find(
+            (e) => e.name.trim().toLowerCase() === (data.player || "").toLowerCase()
+          );
+          if (!entry) throw new Error(data.error);
+          const [suggestion] = data.suggestions;
+          const useExisting = window.confirm(
+            `"${data.player}" is not a known player. Did you mean ${suggestion}?\n\n` +
+              `OK to use ${suggestion}, Cancel to add "${data.player}" as a new player.`
+          );
+          if (useExisting) entry.name = suggestion;
+          else entry.new = true;
+          continue;
+        }
+        if (!res.ok) throw new Error(data.error || `HTTP ${res.status}`);
 
+        const newGameId = data?.game?.id;
+        if (!newGameId) {
+          throw new Error("No game ID returned from backend");
+        }
+        navigate(`/games/${newGameId}`);
+        return;
+      }
     } catch (error) {
       console.error("Error starting game:", error);
       alert("Failed to start game. See console for details.");
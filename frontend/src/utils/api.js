@@ -1,7 +1,23 @@
+const CLIENT_ID_KEY = "ti4-client-id";
+
+// Identifies this browser in the server's audit log.
+export function clientId() {
+  let id = localStorage.getItem(CLIENT_ID_KEY);
+  if (!id) {
+    id = Date.now().toString(36) + Math.random().toString(36).slice(2, 10);
+    localStorage.setItem(CLIENT_ID_KEY, id);
+  }
+  return id;
+}
+
+export function jsonHeaders() {
+  return { "Content-Type": "application/json", "X-Client-ID": clientId() };
+}
+
 export async function postJSON(url, body) {
   const res = await fetch(url, {
     method: "POST",
-    headers: { "Content-Type": "application/json" },
+    headers: jsonHeaders(),
     body: JSON.stringify(body),
   });
 
@@ -1,4 +1,5 @@
 import API_BASE_URL from "../config";
+import { jsonHeaders } from "./api";
 import { isAgendaScore } from "./selectors";
 
 export async function postJSON(url, payload) {
@@ -8,7 +9,7 @@ export async function postJSON(url, payload) {
 
   const res = await fetch(finalUrl, {
     method: "POST",
-    headers: { "Content-Type": "application/json" },
+    headers: jsonHeaders(),
     body: JSON.stringify(payload),
   });
 
@@ -5,8 +5,12 @@ export const isAgendaUsed = (game, title) =>
     byType(game?.AllScores, "agenda").some(s => s.AgendaTitle === title || s.agenda_title === title);
 
 export const isRelicUsed = (game, title) =>
+    (game?.relics || []).some(h => h.relic === title) ||
     byType(game?.AllScores, "relic").some(s => s.RelicTitle === title || s.relic_title === title);
 
+export const relicHolderId = (game, title) =>
+    (game?.relics || []).find(h => h.relic === title && !h.lost_at)?.player_id ?? null;
+
 export const custodiansScorerId = (game) =>
     byType(game?.AllScores, "mecatol").find(Boolean)?.PlayerID
     ?? byType(game?.AllScores, "mecatol").find(Boolean)?.player_id